@@ -0,0 +1,207 @@
+package ovirtclient
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// AuthenticationProvider supplies the OAuth access token the client uses to authenticate against the oVirt Engine.
+// Use PasswordAuth, AccessTokenAuth, TokenProviderAuth or NegotiateAuth to create one.
+type AuthenticationProvider interface {
+	// AccessToken returns an access token for the oVirt Engine. It is called whenever the client connects, including
+	// the reconnects triggered by the engine reporting an expired token (EInvalidGrant). The httpClient is configured
+	// with the TLS and proxy settings of the oVirt client and can be used to contact the engine SSO at engineURL.
+	AccessToken(engineURL string, httpClient *http.Client) (string, error)
+}
+
+// PasswordAuth creates an AuthenticationProvider that logs in with a username and password. The username must
+// contain the profile separated with an @ sign, for example admin@internal. This is the authentication used by New.
+func PasswordAuth(username string, password string) AuthenticationProvider {
+	return &passwordAuthenticationProvider{
+		username: username,
+		password: password,
+	}
+}
+
+// AccessTokenAuth creates an AuthenticationProvider that uses a pre-issued access token. The token cannot be renewed,
+// so once it expires all calls fail with an EInvalidGrant error.
+func AccessTokenAuth(token string) AuthenticationProvider {
+	return &accessTokenAuthenticationProvider{
+		token: token,
+	}
+}
+
+// TokenProviderAuth creates an AuthenticationProvider that calls the provider function whenever the client needs a
+// new access token, that is on connecting and after the engine reported the previous token as expired. This avoids
+// keeping a password in memory.
+func TokenProviderAuth(provider func() (string, error)) AuthenticationProvider {
+	return &tokenProviderAuthenticationProvider{
+		provider: provider,
+	}
+}
+
+// NegotiateAuth creates an AuthenticationProvider that logs in via Kerberos/Negotiate SSO. The engine must have an
+// external authentication profile with HTTP Negotiate enabled. The spnegoToken function is called with the host name
+// of the engine and must return a raw SPNEGO token for the HTTP/host service principal, for example produced by a
+// Kerberos library using the credentials cache of the current user.
+func NegotiateAuth(spnegoToken func(host string) ([]byte, error)) AuthenticationProvider {
+	return &negotiateAuthenticationProvider{
+		spnegoToken: spnegoToken,
+	}
+}
+
+type passwordAuthenticationProvider struct {
+	username string
+	password string
+}
+
+func (p *passwordAuthenticationProvider) AccessToken(engineURL string, httpClient *http.Client) (string, error) {
+	return requestSSOAccessToken(
+		engineURL,
+		httpClient,
+		"token",
+		url.Values{
+			"grant_type": {"password"},
+			"username":   {p.username},
+			"password":   {p.password},
+		},
+		nil,
+	)
+}
+
+type accessTokenAuthenticationProvider struct {
+	token string
+}
+
+func (a *accessTokenAuthenticationProvider) AccessToken(_ string, _ *http.Client) (string, error) {
+	if a.token == "" {
+		return "", newError(EBadArgument, "the access token must not be empty")
+	}
+	return a.token, nil
+}
+
+type tokenProviderAuthenticationProvider struct {
+	provider func() (string, error)
+}
+
+func (t *tokenProviderAuthenticationProvider) AccessToken(_ string, _ *http.Client) (string, error) {
+	token, err := t.provider()
+	if err != nil {
+		return "", wrap(err, EAccessDenied, "the token provider failed to supply an access token")
+	}
+	if token == "" {
+		return "", newError(EAccessDenied, "the token provider returned an empty access token")
+	}
+	return token, nil
+}
+
+type negotiateAuthenticationProvider struct {
+	spnegoToken func(host string) ([]byte, error)
+}
+
+func (n *negotiateAuthenticationProvider) AccessToken(engineURL string, httpClient *http.Client) (string, error) {
+	u, err := url.Parse(engineURL)
+	if err != nil {
+		return "", wrap(err, EBadArgument, "failed to parse engine URL %s", engineURL)
+	}
+	spnegoToken, err := n.spnegoToken(u.Hostname())
+	if err != nil {
+		return "", wrap(err, EAccessDenied, "failed to create SPNEGO token for %s", u.Hostname())
+	}
+	return requestSSOAccessToken(
+		engineURL,
+		httpClient,
+		"token-http-auth",
+		url.Values{
+			"grant_type": {"urn:ovirt:params:oauth:grant-type:http"},
+		},
+		http.Header{
+			"Authorization": {fmt.Sprintf("Negotiate %s", base64.StdEncoding.EncodeToString(spnegoToken))},
+		},
+	)
+}
+
+type ssoTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	Error       string `json:"error"`
+	ErrorCode   string `json:"error_code"`
+}
+
+// requestSSOAccessToken requests an access token from the specified OAuth entry point of the engine SSO.
+func requestSSOAccessToken(
+	engineURL string,
+	httpClient *http.Client,
+	entryPoint string,
+	params url.Values,
+	header http.Header,
+) (string, error) {
+	ssoURL, err := url.Parse(engineURL)
+	if err != nil {
+		return "", wrap(err, EBadArgument, "failed to parse engine URL %s", engineURL)
+	}
+	ssoURL.Path = fmt.Sprintf("/ovirt-engine/sso/oauth/%s", entryPoint)
+	ssoURL.RawQuery = ""
+	params.Set("scope", "ovirt-app-api")
+
+	req, err := http.NewRequest(http.MethodPost, ssoURL.String(), strings.NewReader(params.Encode()))
+	if err != nil {
+		return "", wrap(err, EBug, "failed to create SSO request")
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", wrap(err, EUnidentified, "failed to send SSO request to %s", ssoURL)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", wrap(err, EConnection, "failed to read SSO response from %s", ssoURL)
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		return "", newError(EAccessDenied, "the engine SSO rejected the credentials")
+	}
+
+	var response ssoTokenResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return "", wrap(
+			err,
+			ENotAnOVirtEngine,
+			"invalid SSO response from %s, check if the URL points to an oVirt Engine",
+			ssoURL,
+		)
+	}
+	if response.Error != "" {
+		return "", newError(EAccessDenied, "SSO authentication failed: %s (%s)", response.Error, response.ErrorCode)
+	}
+	if response.AccessToken == "" {
+		return "", newError(EFieldMissing, "the SSO response from %s contained no access token", ssoURL)
+	}
+	return response.AccessToken, nil
+}
+
+// setSDKAccessToken sets the access token on an SDK connection. The SDK only supports obtaining a token with a
+// password itself and offers no way to pass in an existing one. Once the field is set, the SDK uses the token as-is
+// and never contacts the SSO.
+func setSDKAccessToken(conn *ovirtsdk4.Connection, token string) error {
+	field, err := sdkConnectionField(conn, "ssoToken", reflect.TypeOf(token))
+	if err != nil {
+		return err
+	}
+	field.SetString(token)
+	return nil
+}
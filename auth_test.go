@@ -0,0 +1,307 @@
+package ovirtclient_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestTokenProviderAuthReconnectsOnExpiry(t *testing.T) {
+	t.Parallel()
+	engine := &fakeSSOEngine{validTokens: map[string]bool{}}
+	url, tlsProvider := startFakeSSOEngine(t, engine)
+
+	providerCalls := 0
+	client, err := ovirtclient.NewWithAuthentication(
+		url,
+		ovirtclient.TokenProviderAuth(func() (string, error) {
+			providerCalls++
+			return engine.issueToken(), nil
+		}),
+		tlsProvider,
+		ovirtclientlog.NewTestLogger(t),
+		nil,
+		func(connection ovirtclient.Client) error {
+			return connection.Test()
+		},
+	)
+	if err != nil {
+		t.Fatalf("Failed to connect with a token provider (%v)", err)
+	}
+	if providerCalls != 1 {
+		t.Fatalf("The token provider was called %d times on connect instead of once.", providerCalls)
+	}
+
+	engine.expireTokens()
+	if err := client.Test(); err != nil {
+		t.Fatalf("The client did not recover from an expired token (%v)", err)
+	}
+	if providerCalls != 2 {
+		t.Fatalf("The token provider was called %d times instead of twice after the token expired.", providerCalls)
+	}
+}
+
+func TestConcurrentCallsReconnectOnExpiry(t *testing.T) {
+	t.Parallel()
+	engine := &fakeSSOEngine{validTokens: map[string]bool{}}
+	url, tlsProvider := startFakeSSOEngine(t, engine)
+
+	client, err := ovirtclient.NewWithAuthentication(
+		url,
+		ovirtclient.TokenProviderAuth(func() (string, error) {
+			// The slow token provider makes sure the reconnect is still running when the other calls fail.
+			time.Sleep(1500 * time.Millisecond)
+			return engine.issueToken(), nil
+		}),
+		tlsProvider,
+		ovirtclientlog.NewTestLogger(t),
+		nil,
+		func(connection ovirtclient.Client) error {
+			return connection.Test()
+		},
+	)
+	if err != nil {
+		t.Fatalf("Failed to connect with a token provider (%v)", err)
+	}
+
+	engine.expireTokens()
+	const calls = 10
+	wg := &sync.WaitGroup{}
+	errs := make(chan error, calls)
+	for i := 0; i < calls; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Two tries only leave room for a single reconnect, the calls must wait for the running reconnect.
+			errs <- client.Test(ovirtclient.MaxTries(2), ovirtclient.ReconnectStrategy(client))
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("A concurrent call did not recover from an expired token (%v)", err)
+		}
+	}
+}
+
+func TestAccessTokenAuth(t *testing.T) {
+	t.Parallel()
+	engine := &fakeSSOEngine{validTokens: map[string]bool{}}
+	url, tlsProvider := startFakeSSOEngine(t, engine)
+
+	client, err := ovirtclient.NewWithAuthentication(
+		url,
+		ovirtclient.AccessTokenAuth(engine.issueToken()),
+		tlsProvider,
+		ovirtclientlog.NewTestLogger(t),
+		nil,
+		func(connection ovirtclient.Client) error {
+			return connection.Test()
+		},
+	)
+	if err != nil {
+		t.Fatalf("Failed to connect with an access token (%v)", err)
+	}
+
+	// A pre-issued token cannot be renewed, so the reconnect must fail instead of looping or deadlocking.
+	engine.expireTokens()
+	if err := client.Test(ovirtclient.MaxTries(2), ovirtclient.ReconnectStrategy(client)); err == nil {
+		t.Fatalf("Testing the connection with an expired access token did not result in an error.")
+	}
+}
+
+func TestNegotiateAuth(t *testing.T) {
+	t.Parallel()
+	engine := &fakeSSOEngine{validTokens: map[string]bool{}}
+	url, tlsProvider := startFakeSSOEngine(t, engine)
+
+	var spnegoHost string
+	_, err := ovirtclient.NewWithAuthentication(
+		url,
+		ovirtclient.NegotiateAuth(func(host string) ([]byte, error) {
+			spnegoHost = host
+			return []byte(fakeSPNEGOToken), nil
+		}),
+		tlsProvider,
+		ovirtclientlog.NewTestLogger(t),
+		nil,
+		func(connection ovirtclient.Client) error {
+			return connection.Test()
+		},
+	)
+	if err != nil {
+		t.Fatalf("Failed to connect with Negotiate SSO (%v)", err)
+	}
+	if spnegoHost != "127.0.0.1" {
+		t.Fatalf("Incorrect host passed for the SPNEGO token (expected: %s, got: %s).", "127.0.0.1", spnegoHost)
+	}
+
+	_, err = ovirtclient.NewWithAuthentication(
+		url,
+		ovirtclient.NegotiateAuth(func(host string) ([]byte, error) {
+			return []byte("invalid"), nil
+		}),
+		tlsProvider,
+		ovirtclientlog.NewTestLogger(t),
+		nil,
+		nil,
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EAccessDenied) {
+		t.Fatalf("Connecting with an invalid SPNEGO token did not result in an EAccessDenied error (%v)", err)
+	}
+}
+
+func TestPasswordAuth(t *testing.T) {
+	t.Parallel()
+	engine := &fakeSSOEngine{validTokens: map[string]bool{}}
+	url, tlsProvider := startFakeSSOEngine(t, engine)
+
+	_, err := ovirtclient.New(url, fakeSSOUsername, fakeSSOPassword, tlsProvider, ovirtclientlog.NewTestLogger(t), nil)
+	if err != nil {
+		t.Fatalf("Failed to connect with a username and password (%v)", err)
+	}
+	_, err = ovirtclient.New(url, fakeSSOUsername, "invalid", tlsProvider, ovirtclientlog.NewTestLogger(t), nil)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EAccessDenied) {
+		t.Fatalf("Connecting with an invalid password did not result in an EAccessDenied error (%v)", err)
+	}
+
+	token, err := ovirtclient.PasswordAuth(fakeSSOUsername, fakeSSOPassword).AccessToken(url, &http.Client{
+		Transport: &http.Transport{TLSClientConfig: engine.clientTLSConfig},
+	})
+	if err != nil {
+		t.Fatalf("Failed to request an access token with a username and password (%v)", err)
+	}
+	if !engine.isValid(token) {
+		t.Fatalf("The access token %s was not issued by the SSO.", token)
+	}
+}
+
+const (
+	fakeSSOUsername  = "admin@internal"
+	fakeSSOPassword  = "password"
+	fakeSPNEGOToken  = "spnego-token"
+	fakeExpiredGrant = "invalid_grant: The provided authorization grant for the auth code has expired."
+)
+
+// fakeSSOEngine emulates the SSO endpoints of the oVirt Engine and the API entry point, which only accepts the access
+// tokens issued by the SSO.
+type fakeSSOEngine struct {
+	lock            sync.Mutex
+	validTokens     map[string]bool
+	issuedTokens    int
+	clientTLSConfig *tls.Config
+}
+
+func (f *fakeSSOEngine) issueToken() string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.issuedTokens++
+	token := fmt.Sprintf("token-%d", f.issuedTokens)
+	f.validTokens[token] = true
+	return token
+}
+
+func (f *fakeSSOEngine) expireTokens() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.validTokens = map[string]bool{}
+}
+
+func (f *fakeSSOEngine) isValid(token string) bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.validTokens[token]
+}
+
+func (f *fakeSSOEngine) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	switch request.URL.Path {
+	case "/ovirt-engine/sso/oauth/token":
+		if request.FormValue("grant_type") != "password" ||
+			request.FormValue("username") != fakeSSOUsername ||
+			request.FormValue("password") != fakeSSOPassword {
+			f.writeSSOResponse(writer, map[string]string{"error": "Cannot authenticate user", "error_code": "access_denied"})
+			return
+		}
+		f.writeSSOResponse(writer, map[string]string{"access_token": f.issueToken()})
+	case "/ovirt-engine/sso/oauth/token-http-auth":
+		expected := fmt.Sprintf("Negotiate %s", base64.StdEncoding.EncodeToString([]byte(fakeSPNEGOToken)))
+		if request.Header.Get("Authorization") != expected {
+			writer.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		f.writeSSOResponse(writer, map[string]string{"access_token": f.issueToken()})
+	case "/ovirt-engine/api":
+		if !f.isValid(strings.TrimPrefix(request.Header.Get("Authorization"), "Bearer ")) {
+			writer.Header().Set("Content-Type", "application/xml")
+			writer.WriteHeader(http.StatusUnauthorized)
+			_, _ = writer.Write(
+				[]byte(
+					fmt.Sprintf(
+						"<fault><reason>Operation Failed</reason><detail>%s</detail></fault>",
+						fakeExpiredGrant,
+					),
+				),
+			)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/xml")
+		_, _ = writer.Write([]byte("<api></api>"))
+	default:
+		writer.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeSSOEngine) writeSSOResponse(writer http.ResponseWriter, response map[string]string) {
+	writer.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(writer).Encode(response)
+}
+
+func startFakeSSOEngine(t *testing.T, engine *fakeSSOEngine) (string, ovirtclient.TLSProvider) {
+	url, tlsProvider, clientTLSConfig := startFakeEngine(t, engine)
+	engine.clientTLSConfig = clientTLSConfig
+	return url, tlsProvider
+}
+
+// startFakeEngine starts a TLS test server with the specified handler and returns the engine API URL, as well as the
+// TLS settings for connecting to it.
+func startFakeEngine(t *testing.T, handler http.Handler) (string, ovirtclient.TLSProvider, *tls.Config) {
+	caPrivKey, caCert, caCertBytes, err := createCA()
+	if err != nil {
+		t.Fatalf("failed to create CA (%v)", err)
+	}
+	serverPrivKey, serverCert, err := createSignedCert(
+		[]x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		caPrivKey,
+		caCert,
+	)
+	if err != nil {
+		t.Fatalf("failed to create server certificate (%v)", err)
+	}
+	port := getNextFreePort(t)
+	srv, err := newTestServer(t, port, serverCert, serverPrivKey, handler)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := srv.Start(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(srv.Stop)
+
+	tlsProvider := ovirtclient.TLS().CACertsFromMemory(caCertBytes)
+	clientTLSConfig, err := tlsProvider.CreateTLSConfig()
+	if err != nil {
+		t.Fatalf("failed to create client TLS configuration (%v)", err)
+	}
+	return fmt.Sprintf("https://127.0.0.1:%d/ovirt-engine/api", port), tlsProvider, clientTLSConfig
+}
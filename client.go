@@ -1,11 +1,18 @@
 package ovirtclient
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
+	"fmt"
+	"io"
 	"math/rand"
 	"net/http"
+	"net/url"
+	"reflect"
 	"sync"
+	"sync/atomic"
+	"unsafe"
 
 	ovirtsdk4 "github.com/ovirt/go-ovirt"
 )
@@ -16,7 +23,9 @@ import (
 type Client interface {
 	// GetURL returns the oVirt engine base URL.
 	GetURL() string
-	// Reconnect triggers the client to reauthenticate against the oVirt Engine.
+	// Reconnect triggers the client to reauthenticate against the oVirt Engine. The AuthenticationProvider of the
+	// client is asked for a new access token. Concurrent calls wait for the running reconnect and then use the new
+	// connection.
 	Reconnect() (err error)
 	// WithContext creates a subclient with the specified context applied.
 	WithContext(ctx context.Context) Client
@@ -26,14 +35,19 @@ type Client interface {
 	AffinityGroupClient
 	DiskClient
 	DiskAttachmentClient
+	DiskProfileClient
 	VMClient
 	NICClient
 	VNICProfileClient
 	NetworkClient
+	NetworkQoSClient
+	NetworkFilterClient
 	DatacenterClient
 	ClusterClient
 	StorageDomainClient
 	HostClient
+	HostNICClient
+	HostStorageClient
 	TemplateClient
 	TemplateDiskClient
 	TestConnectionClient
@@ -41,6 +55,9 @@ type Client interface {
 	FeatureClient
 	InstanceTypeClient
 	GraphicsConsoleClient
+	SnapshotClient
+	BackupClient
+	EventClient
 }
 
 // ClientWithLegacySupport is an extension of Client that also offers the ability to retrieve the underlying
@@ -63,12 +80,17 @@ type oVirtClient struct {
 	httpClient      http.Client
 	logger          Logger
 	url             string
-	username        string
-	password        string
+	auth            AuthenticationProvider
 	tlsConfig       *tls.Config
 	extraSettings   ExtraSettings
 	nonSecureRandom *rand.Rand
 	verify          func(connection Client) error
+	limiter         *requestLimiter
+	// reconnects counts the successful reconnects. Callers waiting for a running reconnect use it to detect that they
+	// can use the new connection instead of reconnecting again.
+	reconnects *uint64
+	// verifying is set on the copy of the client used to verify a new connection. This copy cannot reconnect.
+	verifying bool
 }
 
 func (o *oVirtClient) WithContext(ctx context.Context) Client {
@@ -79,12 +101,14 @@ func (o *oVirtClient) WithContext(ctx context.Context) Client {
 		o.httpClient,
 		o.logger.WithContext(ctx),
 		o.url,
-		o.username,
-		o.password,
+		o.auth,
 		o.tlsConfig,
 		o.extraSettings,
 		o.nonSecureRandom,
 		o.verify,
+		o.limiter,
+		o.reconnects,
+		o.verifying,
 	}
 }
 
@@ -93,13 +117,23 @@ func (o *oVirtClient) GetContext() context.Context {
 }
 
 func (o *oVirtClient) Reconnect() error {
+	if o.verifying {
+		return newError(EInvalidGrant, "the engine rejected the access token obtained while reconnecting")
+	}
+	reconnects := atomic.LoadUint64(o.reconnects)
 	o.reconnectLock.Lock()
 	defer o.reconnectLock.Unlock()
+	if o.conn != nil && atomic.LoadUint64(o.reconnects) != reconnects {
+		// Another call reconnected while we were waiting for the lock, the new connection can be used as is.
+		return nil
+	}
 	connBuilder := ovirtsdk4.NewConnectionBuilder().
 		URL(o.url).
-		Username(o.username).
-		Password(o.password).
 		TLSConfig(o.tlsConfig)
+	accessToken, err := o.authenticate(connBuilder)
+	if err != nil {
+		return err
+	}
 	if err := processExtraSettings(o.extraSettings, connBuilder); err != nil {
 		return err
 	}
@@ -108,6 +142,14 @@ func (o *oVirtClient) Reconnect() error {
 	if err != nil {
 		return wrap(err, EUnidentified, "failed to create underlying oVirt connection")
 	}
+	if accessToken != "" {
+		if err := setSDKAccessToken(conn, accessToken); err != nil {
+			return err
+		}
+	}
+	if err := setSDKTransportLimiter(conn, o.limiter); err != nil {
+		return err
+	}
 	if o.conn == nil {
 		o.conn = conn
 	} else {
@@ -116,13 +158,116 @@ func (o *oVirtClient) Reconnect() error {
 	}
 
 	if o.verify != nil {
-		if err := o.verify(o); err != nil {
+		// The verification may run into an expired token itself, for example if a pre-issued token expired. We
+		// verify on a copy of the client that does not reconnect, otherwise it would wait for the lock we are holding.
+		verifyClient := *o
+		verifyClient.verifying = true
+		if err := o.verify(&verifyClient); err != nil {
 			return err
 		}
 	}
+	atomic.AddUint64(o.reconnects, 1)
 	return nil
 }
 
+// authenticate configures the credentials on the connection builder. For password authentication the SDK logs in
+// itself, which keeps the error reporting of existing setups unchanged. For all other authentication providers the
+// access token is returned, and must be set on the connection once it is built.
+func (o *oVirtClient) authenticate(connBuilder *ovirtsdk4.ConnectionBuilder) (string, error) {
+	if passwordAuth, ok := o.auth.(*passwordAuthenticationProvider); ok {
+		connBuilder.Username(passwordAuth.username).Password(passwordAuth.password)
+		return "", nil
+	}
+	accessToken, err := o.auth.AccessToken(o.url, &o.httpClient)
+	if err != nil {
+		return "", err
+	}
+	// The SDK refuses to build a connection without a username and password. They are never sent because the
+	// access token is set before the first request.
+	connBuilder.Username("token@internal").Password("token")
+	return accessToken, nil
+}
+
+// sdkConnectionField returns a settable unexported field of an SDK connection. The SDK offers no way to configure
+// some aspects of the connection, such as the access token or the HTTP transport, so we have to set them directly.
+func sdkConnectionField(conn *ovirtsdk4.Connection, name string, fieldType reflect.Type) (reflect.Value, error) {
+	field := reflect.ValueOf(conn).Elem().FieldByName(name)
+	if !field.IsValid() || field.Type() != fieldType {
+		return reflect.Value{}, newError(
+			EBug,
+			"the oVirt SDK connection has no %s field of type %s, the SDK version is not supported",
+			name,
+			fieldType,
+		)
+	}
+	// We disable the gosec linter here because writing the unexported field is the only way to configure it.
+	return reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem(), nil //nolint:gosec
+}
+
+// sendSDKAction sends an action to the engine the same way the SDK does, but returns the action the engine responded
+// with. The SDK discards this response, even though for some actions it is the only place where the engine reports
+// the entity the action created.
+func (o *oVirtClient) sendSDKAction(
+	path string,
+	action *ovirtsdk4.Action,
+	query url.Values,
+) (*ovirtsdk4.Action, error) {
+	httpField, err := sdkConnectionField(o.conn, "client", reflect.TypeOf(&http.Client{}))
+	if err != nil {
+		return nil, err
+	}
+	httpClient, ok := httpField.Interface().(*http.Client)
+	if !ok || httpClient == nil {
+		return nil, newError(EBug, "the oVirt SDK connection has no HTTP client")
+	}
+	tokenField, err := sdkConnectionField(o.conn, "ssoToken", reflect.TypeOf(""))
+	if err != nil {
+		return nil, err
+	}
+	if tokenField.String() == "" {
+		// The SDK obtains the access token on the first request.
+		if err := o.conn.Test(); err != nil {
+			return nil, err
+		}
+	}
+
+	body := &bytes.Buffer{}
+	writer := ovirtsdk4.NewXMLWriter(body)
+	if err := ovirtsdk4.XMLActionWriteOne(writer, action, ""); err != nil {
+		return nil, wrap(err, EBug, "failed to encode action for %s", path)
+	}
+	writer.Flush()
+
+	ctx := o.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	actionURL := fmt.Sprintf("%s%s", o.conn.URL(), path)
+	if len(query) > 0 {
+		actionURL = fmt.Sprintf("%s?%s", actionURL, query.Encode())
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, actionURL, body)
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to create request to %s", actionURL)
+	}
+	req.Header.Add("Version", "4")
+	req.Header.Add("Content-Type", "application/xml")
+	req.Header.Add("Accept", "application/xml")
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tokenField.String()))
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, wrap(err, EConnection, "failed to read response from %s", actionURL)
+	}
+	return ovirtsdk4.CheckAction(respBody, resp)
+}
+
 func (o *oVirtClient) GetSDKClient() *ovirtsdk4.Connection {
 	return o.conn
 }
@@ -1,6 +1,10 @@
 package ovirtclient
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	ovirtsdk4 "github.com/ovirt/go-ovirt"
 )
 
@@ -16,17 +20,567 @@ type ClusterClient interface {
 	ListClusters(retries ...RetryStrategy) ([]Cluster, error)
 	// GetCluster returns a specific cluster based on the cluster ID. An error is returned if the cluster doesn't exist.
 	GetCluster(id ClusterID, retries ...RetryStrategy) (Cluster, error)
+	// CreateCluster creates a new cluster in the specified datacenter. The params parameter may be nil, in which case
+	// the engine defaults are used.
+	CreateCluster(
+		datacenterID DatacenterID,
+		name string,
+		params CreateClusterParameters,
+		retries ...RetryStrategy,
+	) (Cluster, error)
+	// UpdateCluster updates the fields of a cluster set in params.
+	UpdateCluster(id ClusterID, params UpdateClusterParameters, retries ...RetryStrategy) (Cluster, error)
+	// RemoveCluster removes a cluster. The cluster must not contain any hosts or VMs.
+	RemoveCluster(id ClusterID, retries ...RetryStrategy) error
 }
 
 // ClusterID is an identifier for a cluster.
 type ClusterID string
 
-// Cluster represents a cluster returned from a ListClusters or GetCluster call.
-type Cluster interface {
+// SchedulingPolicyID is the identifier of a scheduling policy, which determines how the engine places VMs on the
+// hosts of a cluster.
+type SchedulingPolicyID string
+
+// ClusterData contains the data of a cluster without the client functions.
+type ClusterData interface {
 	// ID returns the UUID of the cluster.
 	ID() ClusterID
 	// Name returns the textual name of the cluster.
 	Name() string
+	// Description returns the description of the cluster.
+	Description() string
+	// DatacenterID returns the ID of the datacenter the cluster belongs to.
+	DatacenterID() DatacenterID
+	// CPUType returns the CPU type of the cluster, for example "Intel Cascadelake Server Family". The hosts of the
+	// cluster must support all features of this CPU type. It is empty if no CPU type has been set yet.
+	CPUType() string
+	// CompatibilityVersion returns the compatibility version of the cluster, which determines the features
+	// available to its hosts and VMs.
+	CompatibilityVersion() ClusterVersion
+	// MemoryOvercommitPercent returns the percentage of the physical memory of the hosts the engine allows to be
+	// allocated to VMs. 100 means no overcommit.
+	MemoryOvercommitPercent() int
+	// SchedulingPolicyID returns the scheduling policy of the cluster.
+	SchedulingPolicyID() SchedulingPolicyID
+	// VirtService returns true if the cluster can run VMs.
+	VirtService() bool
+	// GlusterService returns true if the hosts of the cluster can provide Gluster storage.
+	GlusterService() bool
+}
+
+// Cluster represents a cluster returned from a ListClusters or GetCluster call.
+type Cluster interface {
+	ClusterData
+
+	// Update updates the cluster. See UpdateCluster for details.
+	Update(params UpdateClusterParameters, retries ...RetryStrategy) (Cluster, error)
+	// Remove removes the cluster. See RemoveCluster for details.
+	Remove(retries ...RetryStrategy) error
+}
+
+// ClusterVersion is the compatibility version of a cluster.
+type ClusterVersion interface {
+	// Major returns the major version, for example 4 for 4.7.
+	Major() uint
+	// Minor returns the minor version, for example 7 for 4.7.
+	Minor() uint
+	// String returns the version in the major.minor format.
+	String() string
+}
+
+// NewClusterVersion creates a ClusterVersion to pass to CreateCluster and UpdateCluster.
+func NewClusterVersion(major uint, minor uint) ClusterVersion {
+	return &clusterVersion{
+		major: major,
+		minor: minor,
+	}
+}
+
+// ParseClusterVersion parses a cluster version in the major.minor format.
+func ParseClusterVersion(version string) (ClusterVersion, error) {
+	parts := strings.Split(version, ".")
+	if len(parts) != 2 {
+		return nil, newError(EBadArgument, "invalid cluster version: %s, must be in the major.minor format", version)
+	}
+	major, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return nil, wrap(err, EBadArgument, "invalid major version in cluster version %s", version)
+	}
+	minor, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, wrap(err, EBadArgument, "invalid minor version in cluster version %s", version)
+	}
+	return NewClusterVersion(uint(major), uint(minor)), nil
+}
+
+type clusterVersion struct {
+	major uint
+	minor uint
+}
+
+func (c clusterVersion) Major() uint {
+	return c.major
+}
+
+func (c clusterVersion) Minor() uint {
+	return c.minor
+}
+
+func (c clusterVersion) String() string {
+	return fmt.Sprintf("%d.%d", c.major, c.minor)
+}
+
+// CreateClusterParameters contains the optional parameters for creating a cluster. Each nil value means the engine
+// default is used.
+type CreateClusterParameters interface {
+	// Description returns the description of the new cluster.
+	Description() *string
+	// CPUType returns the CPU type of the new cluster.
+	CPUType() *string
+	// CompatibilityVersion returns the compatibility version of the new cluster.
+	CompatibilityVersion() ClusterVersion
+	// MemoryOvercommitPercent returns the memory overcommit of the new cluster in percent.
+	MemoryOvercommitPercent() *int
+	// SchedulingPolicyID returns the scheduling policy of the new cluster.
+	SchedulingPolicyID() *SchedulingPolicyID
+	// VirtService returns if the new cluster should be able to run VMs.
+	VirtService() *bool
+	// GlusterService returns if the new cluster should provide Gluster storage.
+	GlusterService() *bool
+}
+
+// BuildableCreateClusterParameters is a buildable version of CreateClusterParameters.
+type BuildableCreateClusterParameters interface {
+	CreateClusterParameters
+
+	// WithDescription sets the description of the new cluster.
+	WithDescription(description string) (BuildableCreateClusterParameters, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableCreateClusterParameters
+
+	// WithCPUType sets the CPU type of the new cluster.
+	WithCPUType(cpuType string) (BuildableCreateClusterParameters, error)
+	// MustWithCPUType is identical to WithCPUType, but panics instead of returning an error.
+	MustWithCPUType(cpuType string) BuildableCreateClusterParameters
+
+	// WithCompatibilityVersion sets the compatibility version of the new cluster.
+	WithCompatibilityVersion(version ClusterVersion) (BuildableCreateClusterParameters, error)
+	// MustWithCompatibilityVersion is identical to WithCompatibilityVersion, but panics instead of returning an
+	// error.
+	MustWithCompatibilityVersion(version ClusterVersion) BuildableCreateClusterParameters
+
+	// WithMemoryOvercommitPercent sets the memory overcommit of the new cluster in percent.
+	WithMemoryOvercommitPercent(percent int) (BuildableCreateClusterParameters, error)
+	// MustWithMemoryOvercommitPercent is identical to WithMemoryOvercommitPercent, but panics instead of returning
+	// an error.
+	MustWithMemoryOvercommitPercent(percent int) BuildableCreateClusterParameters
+
+	// WithSchedulingPolicyID sets the scheduling policy of the new cluster.
+	WithSchedulingPolicyID(id SchedulingPolicyID) (BuildableCreateClusterParameters, error)
+	// MustWithSchedulingPolicyID is identical to WithSchedulingPolicyID, but panics instead of returning an error.
+	MustWithSchedulingPolicyID(id SchedulingPolicyID) BuildableCreateClusterParameters
+
+	// WithVirtService sets if the new cluster should be able to run VMs.
+	WithVirtService(virtService bool) (BuildableCreateClusterParameters, error)
+	// MustWithVirtService is identical to WithVirtService, but panics instead of returning an error.
+	MustWithVirtService(virtService bool) BuildableCreateClusterParameters
+
+	// WithGlusterService sets if the new cluster should provide Gluster storage.
+	WithGlusterService(glusterService bool) (BuildableCreateClusterParameters, error)
+	// MustWithGlusterService is identical to WithGlusterService, but panics instead of returning an error.
+	MustWithGlusterService(glusterService bool) BuildableCreateClusterParameters
+}
+
+// CreateClusterParams creates a buildable set of parameters for CreateCluster.
+func CreateClusterParams() BuildableCreateClusterParameters {
+	return &clusterParams{}
+}
+
+// UpdateClusterParameters contains the fields of a cluster to update. Each nil value leaves the field unchanged.
+type UpdateClusterParameters interface {
+	// Name returns the new name of the cluster.
+	Name() *string
+	// Description returns the new description of the cluster.
+	Description() *string
+	// CPUType returns the new CPU type of the cluster.
+	CPUType() *string
+	// CompatibilityVersion returns the new compatibility version of the cluster.
+	CompatibilityVersion() ClusterVersion
+	// MemoryOvercommitPercent returns the new memory overcommit of the cluster in percent.
+	MemoryOvercommitPercent() *int
+	// SchedulingPolicyID returns the new scheduling policy of the cluster.
+	SchedulingPolicyID() *SchedulingPolicyID
+	// VirtService returns if the cluster should be able to run VMs.
+	VirtService() *bool
+	// GlusterService returns if the cluster should provide Gluster storage.
+	GlusterService() *bool
+}
+
+// BuildableUpdateClusterParameters is a buildable version of UpdateClusterParameters.
+type BuildableUpdateClusterParameters interface {
+	UpdateClusterParameters
+
+	// WithName sets the new name of the cluster.
+	WithName(name string) (BuildableUpdateClusterParameters, error)
+	// MustWithName is identical to WithName, but panics instead of returning an error.
+	MustWithName(name string) BuildableUpdateClusterParameters
+
+	// WithDescription sets the new description of the cluster.
+	WithDescription(description string) (BuildableUpdateClusterParameters, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableUpdateClusterParameters
+
+	// WithCPUType sets the new CPU type of the cluster.
+	WithCPUType(cpuType string) (BuildableUpdateClusterParameters, error)
+	// MustWithCPUType is identical to WithCPUType, but panics instead of returning an error.
+	MustWithCPUType(cpuType string) BuildableUpdateClusterParameters
+
+	// WithCompatibilityVersion sets the new compatibility version of the cluster.
+	WithCompatibilityVersion(version ClusterVersion) (BuildableUpdateClusterParameters, error)
+	// MustWithCompatibilityVersion is identical to WithCompatibilityVersion, but panics instead of returning an
+	// error.
+	MustWithCompatibilityVersion(version ClusterVersion) BuildableUpdateClusterParameters
+
+	// WithMemoryOvercommitPercent sets the new memory overcommit of the cluster in percent.
+	WithMemoryOvercommitPercent(percent int) (BuildableUpdateClusterParameters, error)
+	// MustWithMemoryOvercommitPercent is identical to WithMemoryOvercommitPercent, but panics instead of returning
+	// an error.
+	MustWithMemoryOvercommitPercent(percent int) BuildableUpdateClusterParameters
+
+	// WithSchedulingPolicyID sets the new scheduling policy of the cluster.
+	WithSchedulingPolicyID(id SchedulingPolicyID) (BuildableUpdateClusterParameters, error)
+	// MustWithSchedulingPolicyID is identical to WithSchedulingPolicyID, but panics instead of returning an error.
+	MustWithSchedulingPolicyID(id SchedulingPolicyID) BuildableUpdateClusterParameters
+
+	// WithVirtService sets if the cluster should be able to run VMs.
+	WithVirtService(virtService bool) (BuildableUpdateClusterParameters, error)
+	// MustWithVirtService is identical to WithVirtService, but panics instead of returning an error.
+	MustWithVirtService(virtService bool) BuildableUpdateClusterParameters
+
+	// WithGlusterService sets if the cluster should provide Gluster storage.
+	WithGlusterService(glusterService bool) (BuildableUpdateClusterParameters, error)
+	// MustWithGlusterService is identical to WithGlusterService, but panics instead of returning an error.
+	MustWithGlusterService(glusterService bool) BuildableUpdateClusterParameters
+}
+
+// UpdateClusterParams creates a buildable set of parameters for UpdateCluster.
+func UpdateClusterParams() BuildableUpdateClusterParameters {
+	return &updateClusterParams{}
+}
+
+// clusterParams holds the fields shared by the create and update parameters.
+type clusterParams struct {
+	description             *string
+	cpuType                 *string
+	compatibilityVersion    ClusterVersion
+	memoryOvercommitPercent *int
+	schedulingPolicyID      *SchedulingPolicyID
+	virtService             *bool
+	glusterService          *bool
+}
+
+func (c *clusterParams) Description() *string {
+	return c.description
+}
+
+func (c *clusterParams) CPUType() *string {
+	return c.cpuType
+}
+
+func (c *clusterParams) CompatibilityVersion() ClusterVersion {
+	return c.compatibilityVersion
+}
+
+func (c *clusterParams) MemoryOvercommitPercent() *int {
+	return c.memoryOvercommitPercent
+}
+
+func (c *clusterParams) SchedulingPolicyID() *SchedulingPolicyID {
+	return c.schedulingPolicyID
+}
+
+func (c *clusterParams) VirtService() *bool {
+	return c.virtService
+}
+
+func (c *clusterParams) GlusterService() *bool {
+	return c.glusterService
+}
+
+func (c *clusterParams) WithDescription(description string) (BuildableCreateClusterParameters, error) {
+	c.description = &description
+	return c, nil
+}
+
+func (c *clusterParams) MustWithDescription(description string) BuildableCreateClusterParameters {
+	builder, err := c.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *clusterParams) WithCPUType(cpuType string) (BuildableCreateClusterParameters, error) {
+	if cpuType == "" {
+		return nil, newError(EBadArgument, "the CPU type must not be empty")
+	}
+	c.cpuType = &cpuType
+	return c, nil
+}
+
+func (c *clusterParams) MustWithCPUType(cpuType string) BuildableCreateClusterParameters {
+	builder, err := c.WithCPUType(cpuType)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *clusterParams) WithCompatibilityVersion(version ClusterVersion) (BuildableCreateClusterParameters, error) {
+	if version == nil {
+		return nil, newError(EBadArgument, "the compatibility version must not be nil")
+	}
+	c.compatibilityVersion = version
+	return c, nil
+}
+
+func (c *clusterParams) MustWithCompatibilityVersion(version ClusterVersion) BuildableCreateClusterParameters {
+	builder, err := c.WithCompatibilityVersion(version)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *clusterParams) WithMemoryOvercommitPercent(percent int) (BuildableCreateClusterParameters, error) {
+	if err := validateMemoryOvercommitPercent(percent); err != nil {
+		return nil, err
+	}
+	c.memoryOvercommitPercent = &percent
+	return c, nil
+}
+
+func (c *clusterParams) MustWithMemoryOvercommitPercent(percent int) BuildableCreateClusterParameters {
+	builder, err := c.WithMemoryOvercommitPercent(percent)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *clusterParams) WithSchedulingPolicyID(id SchedulingPolicyID) (BuildableCreateClusterParameters, error) {
+	c.schedulingPolicyID = &id
+	return c, nil
+}
+
+func (c *clusterParams) MustWithSchedulingPolicyID(id SchedulingPolicyID) BuildableCreateClusterParameters {
+	builder, err := c.WithSchedulingPolicyID(id)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *clusterParams) WithVirtService(virtService bool) (BuildableCreateClusterParameters, error) {
+	c.virtService = &virtService
+	return c, nil
+}
+
+func (c *clusterParams) MustWithVirtService(virtService bool) BuildableCreateClusterParameters {
+	builder, err := c.WithVirtService(virtService)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *clusterParams) WithGlusterService(glusterService bool) (BuildableCreateClusterParameters, error) {
+	c.glusterService = &glusterService
+	return c, nil
+}
+
+func (c *clusterParams) MustWithGlusterService(glusterService bool) BuildableCreateClusterParameters {
+	builder, err := c.WithGlusterService(glusterService)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+type updateClusterParams struct {
+	clusterParams
+
+	name *string
+}
+
+func (u *updateClusterParams) Name() *string {
+	return u.name
+}
+
+func (u *updateClusterParams) WithName(name string) (BuildableUpdateClusterParameters, error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "the cluster name must not be empty")
+	}
+	u.name = &name
+	return u, nil
+}
+
+func (u *updateClusterParams) MustWithName(name string) BuildableUpdateClusterParameters {
+	builder, err := u.WithName(name)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateClusterParams) WithDescription(description string) (BuildableUpdateClusterParameters, error) {
+	if _, err := u.clusterParams.WithDescription(description); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateClusterParams) MustWithDescription(description string) BuildableUpdateClusterParameters {
+	builder, err := u.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateClusterParams) WithCPUType(cpuType string) (BuildableUpdateClusterParameters, error) {
+	if _, err := u.clusterParams.WithCPUType(cpuType); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateClusterParams) MustWithCPUType(cpuType string) BuildableUpdateClusterParameters {
+	builder, err := u.WithCPUType(cpuType)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateClusterParams) WithCompatibilityVersion(
+	version ClusterVersion,
+) (BuildableUpdateClusterParameters, error) {
+	if _, err := u.clusterParams.WithCompatibilityVersion(version); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateClusterParams) MustWithCompatibilityVersion(version ClusterVersion) BuildableUpdateClusterParameters {
+	builder, err := u.WithCompatibilityVersion(version)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateClusterParams) WithMemoryOvercommitPercent(percent int) (BuildableUpdateClusterParameters, error) {
+	if _, err := u.clusterParams.WithMemoryOvercommitPercent(percent); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateClusterParams) MustWithMemoryOvercommitPercent(percent int) BuildableUpdateClusterParameters {
+	builder, err := u.WithMemoryOvercommitPercent(percent)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateClusterParams) WithSchedulingPolicyID(id SchedulingPolicyID) (BuildableUpdateClusterParameters, error) {
+	if _, err := u.clusterParams.WithSchedulingPolicyID(id); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateClusterParams) MustWithSchedulingPolicyID(id SchedulingPolicyID) BuildableUpdateClusterParameters {
+	builder, err := u.WithSchedulingPolicyID(id)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateClusterParams) WithVirtService(virtService bool) (BuildableUpdateClusterParameters, error) {
+	if _, err := u.clusterParams.WithVirtService(virtService); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateClusterParams) MustWithVirtService(virtService bool) BuildableUpdateClusterParameters {
+	builder, err := u.WithVirtService(virtService)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateClusterParams) WithGlusterService(glusterService bool) (BuildableUpdateClusterParameters, error) {
+	if _, err := u.clusterParams.WithGlusterService(glusterService); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateClusterParams) MustWithGlusterService(glusterService bool) BuildableUpdateClusterParameters {
+	builder, err := u.WithGlusterService(glusterService)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func validateMemoryOvercommitPercent(percent int) error {
+	if percent < 100 {
+		return newError(EBadArgument, "the memory overcommit must be at least 100%% (got: %d%%)", percent)
+	}
+	return nil
+}
+
+// buildSDKCluster fills the cluster builder from the parameters shared by the create and update calls.
+func buildSDKCluster(builder *ovirtsdk4.ClusterBuilder, params CreateClusterParameters) {
+	if description := params.Description(); description != nil {
+		builder.Description(*description)
+	}
+	if cpuType := params.CPUType(); cpuType != nil {
+		builder.Cpu(ovirtsdk4.NewCpuBuilder().Type(*cpuType).MustBuild())
+	}
+	if version := params.CompatibilityVersion(); version != nil {
+		builder.Version(
+			ovirtsdk4.NewVersionBuilder().
+				Major(int64(version.Major())).
+				Minor(int64(version.Minor())).
+				MustBuild(),
+		)
+	}
+	if percent := params.MemoryOvercommitPercent(); percent != nil {
+		builder.MemoryPolicy(
+			ovirtsdk4.NewMemoryPolicyBuilder().
+				OverCommit(ovirtsdk4.NewMemoryOverCommitBuilder().Percent(int64(*percent)).MustBuild()).
+				MustBuild(),
+		)
+	}
+	if schedulingPolicyID := params.SchedulingPolicyID(); schedulingPolicyID != nil {
+		builder.SchedulingPolicy(
+			ovirtsdk4.NewSchedulingPolicyBuilder().Id(string(*schedulingPolicyID)).MustBuild(),
+		)
+	}
+	if virtService := params.VirtService(); virtService != nil {
+		builder.VirtService(*virtService)
+	}
+	if glusterService := params.GlusterService(); glusterService != nil {
+		builder.GlusterService(*glusterService)
+	}
 }
 
 func convertSDKCluster(sdkCluster *ovirtsdk4.Cluster, client Client) (Cluster, error) {
@@ -39,18 +593,60 @@ func convertSDKCluster(sdkCluster *ovirtsdk4.Cluster, client Client) (Cluster, e
 	if !ok {
 		return nil, newError(EFieldMissing, "failed to fetch name for cluster %s", id)
 	}
-	return &cluster{
-		client: client,
-		id:     ClusterID(id),
-		name:   name,
-	}, nil
+	result := &cluster{
+		client:                  client,
+		id:                      ClusterID(id),
+		name:                    name,
+		compatibilityVersion:    &clusterVersion{},
+		memoryOvercommitPercent: 100,
+	}
+	result.description, _ = sdkCluster.Description()
+	if sdkDatacenter, ok := sdkCluster.DataCenter(); ok {
+		if datacenterID, ok := sdkDatacenter.Id(); ok {
+			result.datacenterID = DatacenterID(datacenterID)
+		}
+	}
+	if sdkCPU, ok := sdkCluster.Cpu(); ok {
+		result.cpuType, _ = sdkCPU.Type()
+	}
+	if sdkVersion, ok := sdkCluster.Version(); ok {
+		major, _ := sdkVersion.Major()
+		minor, _ := sdkVersion.Minor()
+		result.compatibilityVersion = &clusterVersion{
+			major: uint(major),
+			minor: uint(minor),
+		}
+	}
+	if sdkMemoryPolicy, ok := sdkCluster.MemoryPolicy(); ok {
+		if sdkOverCommit, ok := sdkMemoryPolicy.OverCommit(); ok {
+			if percent, ok := sdkOverCommit.Percent(); ok {
+				result.memoryOvercommitPercent = int(percent)
+			}
+		}
+	}
+	if sdkSchedulingPolicy, ok := sdkCluster.SchedulingPolicy(); ok {
+		if schedulingPolicyID, ok := sdkSchedulingPolicy.Id(); ok {
+			result.schedulingPolicyID = SchedulingPolicyID(schedulingPolicyID)
+		}
+	}
+	result.virtService, _ = sdkCluster.VirtService()
+	result.glusterService, _ = sdkCluster.GlusterService()
+	return result, nil
 }
 
 type cluster struct {
 	client Client
 
-	id   ClusterID
-	name string
+	id                      ClusterID
+	name                    string
+	description             string
+	datacenterID            DatacenterID
+	cpuType                 string
+	compatibilityVersion    ClusterVersion
+	memoryOvercommitPercent int
+	schedulingPolicyID      SchedulingPolicyID
+	virtService             bool
+	glusterService          bool
 }
 
 func (c cluster) ID() ClusterID {
@@ -60,3 +656,43 @@ func (c cluster) ID() ClusterID {
 func (c cluster) Name() string {
 	return c.name
 }
+
+func (c cluster) Description() string {
+	return c.description
+}
+
+func (c cluster) DatacenterID() DatacenterID {
+	return c.datacenterID
+}
+
+func (c cluster) CPUType() string {
+	return c.cpuType
+}
+
+func (c cluster) CompatibilityVersion() ClusterVersion {
+	return c.compatibilityVersion
+}
+
+func (c cluster) MemoryOvercommitPercent() int {
+	return c.memoryOvercommitPercent
+}
+
+func (c cluster) SchedulingPolicyID() SchedulingPolicyID {
+	return c.schedulingPolicyID
+}
+
+func (c cluster) VirtService() bool {
+	return c.virtService
+}
+
+func (c cluster) GlusterService() bool {
+	return c.glusterService
+}
+
+func (c cluster) Update(params UpdateClusterParameters, retries ...RetryStrategy) (Cluster, error) {
+	return c.client.UpdateCluster(c.id, params, retries...)
+}
+
+func (c cluster) Remove(retries ...RetryStrategy) error {
+	return c.client.RemoveCluster(c.id, retries...)
+}
@@ -0,0 +1,139 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) CreateCluster(
+	datacenterID DatacenterID,
+	name string,
+	params CreateClusterParameters,
+	retries ...RetryStrategy,
+) (result Cluster, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if err := validateClusterName(name); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = CreateClusterParams()
+	}
+	builder := ovirtsdk4.NewClusterBuilder().
+		Name(name).
+		DataCenter(ovirtsdk4.NewDataCenterBuilder().Id(string(datacenterID)).MustBuild())
+	buildSDKCluster(builder, params)
+	sdkCluster, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build cluster")
+	}
+
+	err = retry(
+		fmt.Sprintf("creating cluster %s in datacenter %s", name, datacenterID),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().ClustersService().Add().Cluster(sdkCluster).Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Cluster()
+			if !ok {
+				return newFieldNotFound("cluster creation response", "cluster")
+			}
+			result, err = convertSDKCluster(sdkObject, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert cluster")
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) CreateCluster(
+	datacenterID DatacenterID,
+	name string,
+	params CreateClusterParameters,
+	retries ...RetryStrategy,
+) (result Cluster, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if err := validateClusterName(name); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = CreateClusterParams()
+	}
+	err = retry(
+		fmt.Sprintf("creating cluster %s", name),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			dc, ok := m.dataCenters[datacenterID]
+			if !ok {
+				return newError(ENotFound, "datacenter with ID %s not found", datacenterID)
+			}
+			if err := m.checkClusterNameAvailable(name, ""); err != nil {
+				return err
+			}
+
+			created := generateTestCluster(dc)
+			created.client = m
+			created.id = ClusterID(m.GenerateUUID())
+			created.name = name
+			created.description = ""
+			applyClusterParams(created, params)
+			m.clusters[created.id] = created
+			m.affinityGroups[created.id] = map[AffinityGroupID]*affinityGroup{}
+			dc.clusters = append(dc.clusters, created.id)
+			result = created
+			return nil
+		})
+	return
+}
+
+func validateClusterName(name string) error {
+	if name == "" {
+		return newError(EBadArgument, "the cluster name must not be empty")
+	}
+	return nil
+}
+
+// checkClusterNameAvailable returns an EConflict error if a cluster other than the excluded one has the specified
+// name. The caller must hold the lock of the mock client.
+func (m *mockClient) checkClusterNameAvailable(name string, excludeID ClusterID) error {
+	for _, c := range m.clusters {
+		if c.name == name && c.id != excludeID {
+			return newError(EConflict, "a cluster with the name %s already exists", name)
+		}
+	}
+	return nil
+}
+
+// applyClusterParams applies the parameters shared by the create and update calls to a mock cluster.
+func applyClusterParams(c *cluster, params CreateClusterParameters) {
+	if description := params.Description(); description != nil {
+		c.description = *description
+	}
+	if cpuType := params.CPUType(); cpuType != nil {
+		c.cpuType = *cpuType
+	}
+	if version := params.CompatibilityVersion(); version != nil {
+		c.compatibilityVersion = NewClusterVersion(version.Major(), version.Minor())
+	}
+	if percent := params.MemoryOvercommitPercent(); percent != nil {
+		c.memoryOvercommitPercent = *percent
+	}
+	if schedulingPolicyID := params.SchedulingPolicyID(); schedulingPolicyID != nil {
+		c.schedulingPolicyID = *schedulingPolicyID
+	}
+	if virtService := params.VirtService(); virtService != nil {
+		c.virtService = *virtService
+	}
+	if glusterService := params.GlusterService(); glusterService != nil {
+		c.glusterService = *glusterService
+	}
+}
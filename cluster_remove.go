@@ -0,0 +1,59 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) RemoveCluster(id ClusterID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("removing cluster %s", id),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().ClustersService().ClusterService(string(id)).Remove().Send()
+			return err
+		})
+	return
+}
+
+func (m *mockClient) RemoveCluster(id ClusterID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("removing cluster %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			item, ok := m.clusters[id]
+			if !ok {
+				return newError(ENotFound, "cluster with ID %s not found", id)
+			}
+			for _, h := range m.hosts {
+				if h.clusterID == id {
+					return newError(EConflict, "cannot remove cluster %s, host %s is still in the cluster", id, h.id)
+				}
+			}
+			for _, v := range m.vms {
+				if v.clusterID == id {
+					return newError(EConflict, "cannot remove cluster %s, VM %s is still in the cluster", id, v.id)
+				}
+			}
+
+			if dc, ok := m.dataCenters[item.datacenterID]; ok {
+				clusters := make([]ClusterID, 0, len(dc.clusters))
+				for _, clusterID := range dc.clusters {
+					if clusterID != id {
+						clusters = append(clusters, clusterID)
+					}
+				}
+				dc.clusters = clusters
+			}
+			delete(m.affinityGroups, id)
+			delete(m.clusterNetworks, id)
+			delete(m.clusters, id)
+			return nil
+		})
+}
@@ -0,0 +1,103 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestClusterData(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	cluster, err := helper.GetClient().GetCluster(helper.GetClusterID())
+	if err != nil {
+		t.Fatalf("Failed to fetch cluster %s (%v)", helper.GetClusterID(), err)
+	}
+	if cluster.DatacenterID() == "" {
+		t.Fatalf("No datacenter ID on cluster %s.", cluster.ID())
+	}
+	datacenter, err := helper.GetClient().GetDatacenter(cluster.DatacenterID())
+	if err != nil {
+		t.Fatalf("Failed to fetch datacenter %s (%v)", cluster.DatacenterID(), err)
+	}
+	hasCluster, err := datacenter.HasCluster(cluster.ID())
+	if err != nil {
+		t.Fatalf("Failed to list clusters of datacenter %s (%v)", cluster.DatacenterID(), err)
+	}
+	if !hasCluster {
+		t.Fatalf("Cluster %s is not listed in its datacenter %s.", cluster.ID(), cluster.DatacenterID())
+	}
+	if cluster.CompatibilityVersion().Major() == 0 {
+		t.Fatalf("Invalid compatibility version on cluster %s: %s", cluster.ID(), cluster.CompatibilityVersion())
+	}
+	if cluster.MemoryOvercommitPercent() < 100 {
+		t.Fatalf("Invalid memory overcommit on cluster %s: %d", cluster.ID(), cluster.MemoryOvercommitPercent())
+	}
+	if !cluster.VirtService() {
+		t.Fatalf("The virt service is not enabled on the test cluster %s.", cluster.ID())
+	}
+}
+
+func TestClusterCreateUpdateRemove(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	testCluster, err := client.GetCluster(helper.GetClusterID())
+	if err != nil {
+		t.Fatalf("Failed to fetch cluster %s (%v)", helper.GetClusterID(), err)
+	}
+	name := helper.GenerateTestResourceName(t)
+	cluster, err := client.CreateCluster(
+		testCluster.DatacenterID(),
+		name,
+		ovirtclient.CreateClusterParams().
+			MustWithCPUType(testCluster.CPUType()).
+			MustWithCompatibilityVersion(testCluster.CompatibilityVersion()).
+			MustWithMemoryOvercommitPercent(150),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create cluster (%v)", err)
+	}
+	t.Cleanup(func() {
+		if err := cluster.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("Failed to remove cluster %s (%v)", cluster.ID(), err)
+		}
+	})
+	if cluster.Name() != name || cluster.DatacenterID() != testCluster.DatacenterID() {
+		t.Fatalf("Incorrect name or datacenter on the created cluster.")
+	}
+	if cluster.MemoryOvercommitPercent() != 150 {
+		t.Fatalf("Incorrect memory overcommit (expected: 150, got: %d)", cluster.MemoryOvercommitPercent())
+	}
+
+	cluster, err = cluster.Update(ovirtclient.UpdateClusterParams().MustWithDescription("updated"))
+	if err != nil {
+		t.Fatalf("Failed to update cluster %s (%v)", cluster.ID(), err)
+	}
+	if cluster.Description() != "updated" {
+		t.Fatalf("Incorrect description after update (expected: updated, got: %s)", cluster.Description())
+	}
+
+	if err := cluster.Remove(); err != nil {
+		t.Fatalf("Failed to remove cluster %s (%v)", cluster.ID(), err)
+	}
+	if _, err := client.GetCluster(cluster.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Cluster %s still exists after removal.", cluster.ID())
+	}
+}
+
+func TestClusterCreateRejectsUnknownDatacenter(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	_, err := helper.GetClient().CreateCluster(
+		ovirtclient.DatacenterID(helper.GenerateRandomID(5)),
+		helper.GenerateTestResourceName(t),
+		nil,
+	)
+	if err == nil {
+		t.Fatalf("Creating a cluster in a non-existent datacenter did not result in an error.")
+	}
+}
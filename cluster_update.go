@@ -0,0 +1,88 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) UpdateCluster(
+	id ClusterID,
+	params UpdateClusterParameters,
+	retries ...RetryStrategy,
+) (result Cluster, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if params == nil {
+		return nil, newError(EBadArgument, "the update parameters must not be nil")
+	}
+	builder := ovirtsdk4.NewClusterBuilder().Id(string(id))
+	if name := params.Name(); name != nil {
+		builder.Name(*name)
+	}
+	buildSDKCluster(builder, params)
+	sdkCluster, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build cluster")
+	}
+
+	err = retry(
+		fmt.Sprintf("updating cluster %s", id),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().ClustersService().ClusterService(string(id)).Update().
+				Cluster(sdkCluster).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Cluster()
+			if !ok {
+				return newFieldNotFound("cluster update response", "cluster")
+			}
+			result, err = convertSDKCluster(sdkObject, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert cluster %s", id)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) UpdateCluster(
+	id ClusterID,
+	params UpdateClusterParameters,
+	retries ...RetryStrategy,
+) (result Cluster, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if params == nil {
+		return nil, newError(EBadArgument, "the update parameters must not be nil")
+	}
+	err = retry(
+		fmt.Sprintf("updating cluster %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			item, ok := m.clusters[id]
+			if !ok {
+				return newError(ENotFound, "cluster with ID %s not found", id)
+			}
+			// Clusters returned earlier must not change, so we update a copy.
+			updated := *item
+			if name := params.Name(); name != nil {
+				if err := m.checkClusterNameAvailable(*name, id); err != nil {
+					return err
+				}
+				updated.name = *name
+			}
+			applyClusterParams(&updated, params)
+			m.clusters[id] = &updated
+			result = &updated
+			return nil
+		})
+	return
+}
@@ -1,7 +1,16 @@
 package ovirtclient
 
 const (
-	qcowHeaderSize    = 32
 	qcowMagicBytes    = "QFI\xfb"
 	qcowSizeStartByte = 24
+	// qcowV2HeaderSize is the size of the fixed header of QCOW version 2 images.
+	qcowV2HeaderSize = 72
+	// qcowV3HeaderSize is the size of the QCOW version 3 header including the compression type and padding.
+	qcowV3HeaderSize = 112
+	// qcowMaxBackingFileNameSize is the maximum length of the backing file name as defined by QEMU.
+	qcowMaxBackingFileNameSize = 1023
+	// qcowL1EntryOffsetMask masks the offset of the L2 table in an L1 table entry.
+	qcowL1EntryOffsetMask = 0x00fffffffffffe00
+	// qcowL2EntryCompressed is the bit signaling a compressed cluster in an L2 table entry.
+	qcowL2EntryCompressed = 1 << 62
 )
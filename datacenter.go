@@ -19,12 +19,24 @@ type DatacenterClient interface {
 	ListDatacenters(retries ...RetryStrategy) ([]Datacenter, error)
 	// ListDatacenterClusters lists all clusters in the specified datacenter.
 	ListDatacenterClusters(id DatacenterID, retries ...RetryStrategy) ([]Cluster, error)
+	// CreateDatacenter creates a new datacenter. The params parameter may be nil, in which case a shared datacenter
+	// with the engine's default compatibility version is created.
+	CreateDatacenter(name string, params CreateDatacenterParameters, retries ...RetryStrategy) (Datacenter, error)
+	// RemoveDatacenter removes a datacenter and its logical networks. The datacenter must not contain any clusters.
+	RemoveDatacenter(id DatacenterID, retries ...RetryStrategy) error
 }
 
 // DatacenterData is the core of a Datacenter when client functions are not required.
 type DatacenterData interface {
 	ID() DatacenterID
 	Name() string
+	// Description returns the description of the datacenter.
+	Description() string
+	// Local returns true if the datacenter uses local storage on its hosts instead of shared storage.
+	Local() bool
+	// CompatibilityVersion returns the compatibility version of the datacenter. The clusters in the datacenter must
+	// have the same or a higher compatibility version.
+	CompatibilityVersion() ClusterVersion
 }
 
 // Datacenter is a logical entity that defines the set of resources used in a specific environment.
@@ -36,6 +48,109 @@ type Datacenter interface {
 	Clusters(retries ...RetryStrategy) ([]Cluster, error)
 	// HasCluster returns true if the cluster is in the datacenter. This is a network call and may be slow.
 	HasCluster(clusterID ClusterID, retries ...RetryStrategy) (bool, error)
+	// Remove removes the datacenter. See RemoveDatacenter for details.
+	Remove(retries ...RetryStrategy) error
+}
+
+// CreateDatacenterParameters contains the optional parameters for creating a datacenter.
+type CreateDatacenterParameters interface {
+	// Description returns the description of the new datacenter.
+	Description() *string
+	// Local returns if the new datacenter should use local storage. If nil, the datacenter uses shared storage.
+	Local() *bool
+	// CompatibilityVersion returns the compatibility version of the new datacenter. If nil, the engine default is
+	// used.
+	CompatibilityVersion() ClusterVersion
+}
+
+// BuildableCreateDatacenterParameters is a buildable version of CreateDatacenterParameters.
+type BuildableCreateDatacenterParameters interface {
+	CreateDatacenterParameters
+
+	// WithDescription sets the description of the new datacenter.
+	WithDescription(description string) (BuildableCreateDatacenterParameters, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableCreateDatacenterParameters
+
+	// WithLocal sets if the new datacenter should use local storage.
+	WithLocal(local bool) (BuildableCreateDatacenterParameters, error)
+	// MustWithLocal is identical to WithLocal, but panics instead of returning an error.
+	MustWithLocal(local bool) BuildableCreateDatacenterParameters
+
+	// WithCompatibilityVersion sets the compatibility version of the new datacenter.
+	WithCompatibilityVersion(version ClusterVersion) (BuildableCreateDatacenterParameters, error)
+	// MustWithCompatibilityVersion is identical to WithCompatibilityVersion, but panics instead of returning an
+	// error.
+	MustWithCompatibilityVersion(version ClusterVersion) BuildableCreateDatacenterParameters
+}
+
+// CreateDatacenterParams creates a buildable set of parameters for CreateDatacenter.
+func CreateDatacenterParams() BuildableCreateDatacenterParameters {
+	return &createDatacenterParams{}
+}
+
+type createDatacenterParams struct {
+	description          *string
+	local                *bool
+	compatibilityVersion ClusterVersion
+}
+
+func (c *createDatacenterParams) Description() *string {
+	return c.description
+}
+
+func (c *createDatacenterParams) Local() *bool {
+	return c.local
+}
+
+func (c *createDatacenterParams) CompatibilityVersion() ClusterVersion {
+	return c.compatibilityVersion
+}
+
+func (c *createDatacenterParams) WithDescription(description string) (BuildableCreateDatacenterParameters, error) {
+	c.description = &description
+	return c, nil
+}
+
+func (c *createDatacenterParams) MustWithDescription(description string) BuildableCreateDatacenterParameters {
+	builder, err := c.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *createDatacenterParams) WithLocal(local bool) (BuildableCreateDatacenterParameters, error) {
+	c.local = &local
+	return c, nil
+}
+
+func (c *createDatacenterParams) MustWithLocal(local bool) BuildableCreateDatacenterParameters {
+	builder, err := c.WithLocal(local)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *createDatacenterParams) WithCompatibilityVersion(
+	version ClusterVersion,
+) (BuildableCreateDatacenterParameters, error) {
+	if version == nil {
+		return nil, newError(EBadArgument, "the compatibility version must not be nil")
+	}
+	c.compatibilityVersion = version
+	return c, nil
+}
+
+func (c *createDatacenterParams) MustWithCompatibilityVersion(
+	version ClusterVersion,
+) BuildableCreateDatacenterParameters {
+	builder, err := c.WithCompatibilityVersion(version)
+	if err != nil {
+		panic(err)
+	}
+	return builder
 }
 
 func convertSDKDatacenter(sdkObject *ovirtsdk4.DataCenter, client *oVirtClient) (Datacenter, error) {
@@ -48,18 +163,34 @@ func convertSDKDatacenter(sdkObject *ovirtsdk4.DataCenter, client *oVirtClient)
 		return nil, newFieldNotFound("datacenter", "name")
 	}
 
+	description, _ := sdkObject.Description()
+	local, _ := sdkObject.Local()
+	version := &clusterVersion{}
+	if sdkVersion, ok := sdkObject.Version(); ok {
+		major, _ := sdkVersion.Major()
+		minor, _ := sdkVersion.Minor()
+		version.major = uint(major)
+		version.minor = uint(minor)
+	}
+
 	return &datacenter{
-		client: client,
-		id:     DatacenterID(id),
-		name:   name,
+		client:               client,
+		id:                   DatacenterID(id),
+		name:                 name,
+		description:          description,
+		local:                local,
+		compatibilityVersion: version,
 	}, nil
 }
 
 type datacenter struct {
 	client Client
 
-	id   DatacenterID
-	name string
+	id                   DatacenterID
+	name                 string
+	description          string
+	local                bool
+	compatibilityVersion ClusterVersion
 }
 
 func (d datacenter) Clusters(retries ...RetryStrategy) ([]Cluster, error) {
@@ -86,3 +217,19 @@ func (d datacenter) ID() DatacenterID {
 func (d datacenter) Name() string {
 	return d.name
 }
+
+func (d datacenter) Description() string {
+	return d.description
+}
+
+func (d datacenter) Local() bool {
+	return d.local
+}
+
+func (d datacenter) CompatibilityVersion() ClusterVersion {
+	return d.compatibilityVersion
+}
+
+func (d datacenter) Remove(retries ...RetryStrategy) error {
+	return d.client.RemoveDatacenter(d.id, retries...)
+}
@@ -0,0 +1,115 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) CreateDatacenter(
+	name string,
+	params CreateDatacenterParameters,
+	retries ...RetryStrategy,
+) (result Datacenter, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if err := validateDatacenterName(name); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = CreateDatacenterParams()
+	}
+	builder := ovirtsdk4.NewDataCenterBuilder().Name(name).Local(false)
+	if description := params.Description(); description != nil {
+		builder.Description(*description)
+	}
+	if local := params.Local(); local != nil {
+		builder.Local(*local)
+	}
+	if version := params.CompatibilityVersion(); version != nil {
+		builder.Version(
+			ovirtsdk4.NewVersionBuilder().
+				Major(int64(version.Major())).
+				Minor(int64(version.Minor())).
+				MustBuild(),
+		)
+	}
+	sdkDatacenter, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build datacenter")
+	}
+
+	err = retry(
+		fmt.Sprintf("creating datacenter %s", name),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().DataCentersService().Add().DataCenter(sdkDatacenter).Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.DataCenter()
+			if !ok {
+				return newFieldNotFound("datacenter creation response", "datacenter")
+			}
+			result, err = convertSDKDatacenter(sdkObject, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert datacenter")
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) CreateDatacenter(
+	name string,
+	params CreateDatacenterParameters,
+	retries ...RetryStrategy,
+) (result Datacenter, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if err := validateDatacenterName(name); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = CreateDatacenterParams()
+	}
+	err = retry(
+		fmt.Sprintf("creating datacenter %s", name),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			for _, dc := range m.dataCenters {
+				if dc.name == name {
+					return newError(EConflict, "a datacenter with the name %s already exists", name)
+				}
+			}
+
+			created := generateTestDatacenter()
+			created.client = m
+			created.id = DatacenterID(m.GenerateUUID())
+			created.name = name
+			if description := params.Description(); description != nil {
+				created.description = *description
+			}
+			if local := params.Local(); local != nil {
+				created.local = *local
+			}
+			if version := params.CompatibilityVersion(); version != nil {
+				created.compatibilityVersion = NewClusterVersion(version.Major(), version.Minor())
+			}
+			m.dataCenters[created.id] = created
+			result = created
+			return nil
+		})
+	return
+}
+
+func validateDatacenterName(name string) error {
+	if name == "" {
+		return newError(EBadArgument, "the datacenter name must not be empty")
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) RemoveDatacenter(id DatacenterID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("removing datacenter %s", id),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().DataCentersService().DataCenterService(string(id)).Remove().Send()
+			return err
+		})
+	return
+}
+
+func (m *mockClient) RemoveDatacenter(id DatacenterID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("removing datacenter %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			dc, ok := m.dataCenters[id]
+			if !ok {
+				return newError(ENotFound, "datacenter with ID %s not found", id)
+			}
+			if len(dc.clusters) != 0 {
+				return newError(
+					EConflict,
+					"cannot remove datacenter %s, it still contains %d clusters",
+					id,
+					len(dc.clusters),
+				)
+			}
+			for networkID, n := range m.networks {
+				if n.dcID == id {
+					m.removeMockNetwork(networkID)
+				}
+			}
+			for qosID, qos := range m.networkQoS {
+				if qos.dcID == id {
+					m.removeMockNetworkQoS(qosID)
+				}
+			}
+			delete(m.dataCenters, id)
+			return nil
+		})
+}
@@ -1,6 +1,7 @@
 package ovirtclient
 
 import (
+	"fmt"
 	"io"
 	"strings"
 	"sync"
@@ -154,6 +155,35 @@ type DiskClient interface {
 		retries ...RetryStrategy,
 	) error
 
+	// StartRangedUploadToDisk uploads a disk image to an existing disk by splitting the image into ranges and
+	// sending them over several concurrent connections. Each range is retried individually using the passed retry
+	// strategies, so a failure late in the upload does not restart the whole image. Use RangedUploadParams to
+	// obtain a builder for the parameters.
+	//
+	// If the parameters contain a transfer ID, the upload resumes the existing image transfer instead of creating
+	// a new one, skipping the ranges listed as completed. If the parameters request a resumable upload, a failed
+	// upload pauses the image transfer instead of aborting it so that it can be resumed later. The transfer ID and
+	// the completed chunks can be obtained from the returned UploadImageProgress.
+	//
+	// The reader should implement io.ReaderAt for best performance. Other readers are accessed sequentially.
+	StartRangedUploadToDisk(
+		diskID DiskID,
+		size uint64,
+		reader io.ReadSeekCloser,
+		params RangedUploadParameters,
+		retries ...RetryStrategy,
+	) (UploadImageProgress, error)
+
+	// RangedUploadToDisk runs StartRangedUploadToDisk and then waits for the upload to complete. It returns an error
+	// if the upload failed despite retries.
+	RangedUploadToDisk(
+		diskID DiskID,
+		size uint64,
+		reader io.ReadSeekCloser,
+		params RangedUploadParameters,
+		retries ...RetryStrategy,
+	) error
+
 	// StartImageDownload starts the download of the image file of a specific disk.
 	// The caller can then wait for the initialization using the Initialized() call:
 	//
@@ -204,6 +234,32 @@ type DiskClient interface {
 		retries ...RetryStrategy,
 	) (ImageDownloadReader, error)
 
+	// StartSparseDownloadDisk starts a sparse-aware download of the image of a specific disk. Instead of fetching
+	// the whole image, it queries the extents of the image and only fetches the extents containing data using
+	// ranged requests. Zero extents are returned as zeros when reading, or skipped when using WriteTo with a
+	// writer that supports io.WriterAt or io.Seeker, such as an *os.File, producing a sparse file. Use
+	// SparseDownloadParams to obtain a builder for the parameters.
+	//
+	// Sparse downloads are only supported in the raw format as the extents describe the raw image.
+	//
+	// The caller MUST close the returned reader, otherwise the disk will remain locked in the oVirt engine.
+	StartSparseDownloadDisk(
+		diskID DiskID,
+		format ImageFormat,
+		params SparseDownloadParameters,
+		retries ...RetryStrategy,
+	) (ImageDownload, error)
+
+	// SparseDownloadDisk runs StartSparseDownloadDisk, then waits for the download to be ready before returning the
+	// reader. The caller MUST close the ImageDownloadReader in order to properly unlock the disk in the oVirt
+	// engine.
+	SparseDownloadDisk(
+		diskID DiskID,
+		format ImageFormat,
+		params SparseDownloadParameters,
+		retries ...RetryStrategy,
+	) (ImageDownloadReader, error)
+
 	// StartCreateDisk starts creating an empty disk with the specified parameters and returns a DiskCreation object,
 	// which can be queried for completion. Optional parameters can be created using CreateDiskParams().
 	StartCreateDisk(
@@ -228,6 +284,16 @@ type DiskClient interface {
 		retries ...RetryStrategy,
 	) (Disk, error)
 
+	// CreateLUNDisk creates a direct LUN disk, which gives VMs direct access to a LUN on block storage without a
+	// storage domain. Use NewISCSILUNDiskParams or NewFCPLUNDiskParams to describe the LUN, and ListHostLUNs to find
+	// the available LUNs. Of the optional parameters only the alias, description and shareable flag apply to LUN
+	// disks, setting any other parameter results in an EBadArgument error.
+	CreateLUNDisk(
+		storage LUNDiskStorageParameters,
+		params CreateDiskOptionalParameters,
+		retries ...RetryStrategy,
+	) (Disk, error)
+
 	// StartUpdateDisk sends the disk update request to the oVirt API and returns a DiskUpdate
 	// object, which can be used to wait for the update to complete. Use UpdateDiskParams to
 	// obtain a builder for the parameters structure.
@@ -255,6 +321,132 @@ type DiskClient interface {
 	RemoveDisk(diskID DiskID, retries ...RetryStrategy) error
 	// WaitForDiskOK waits for a disk to be in OK status
 	WaitForDiskOK(diskID DiskID, retries ...RetryStrategy) (Disk, error)
+
+	// StartMoveDisk starts moving a disk to a different storage domain and returns a DiskUpdate object, which can be
+	// used to wait for the move to complete. If the disk is attached to a running VM, the engine performs a live
+	// storage migration. The disk is locked until the move is complete.
+	StartMoveDisk(diskID DiskID, storageDomainID StorageDomainID, retries ...RetryStrategy) (DiskUpdate, error)
+	// MoveDisk is a shorthand for calling StartMoveDisk and then waiting for the move to complete.
+	MoveDisk(diskID DiskID, storageDomainID StorageDomainID, retries ...RetryStrategy) (Disk, error)
+
+	// StartCopyDisk starts copying a disk to a new disk with the specified alias on a storage domain and returns a
+	// DiskCreation object, which can be used to wait for the copy to complete. The disk must not be attached to a
+	// running VM.
+	StartCopyDisk(
+		diskID DiskID,
+		storageDomainID StorageDomainID,
+		alias string,
+		retries ...RetryStrategy,
+	) (DiskCreation, error)
+	// CopyDisk is a shorthand for calling StartCopyDisk and then waiting for the copy to complete. It returns the
+	// new disk.
+	CopyDisk(diskID DiskID, storageDomainID StorageDomainID, alias string, retries ...RetryStrategy) (Disk, error)
+
+	// StartSparsifyDisk starts releasing the space of the disk that is unused by the guest file systems back to the
+	// storage. The disk must be thin provisioned and must not be attached to a running VM.
+	StartSparsifyDisk(diskID DiskID, retries ...RetryStrategy) (DiskUpdate, error)
+	// SparsifyDisk is a shorthand for calling StartSparsifyDisk and then waiting for the operation to complete.
+	SparsifyDisk(diskID DiskID, retries ...RetryStrategy) (Disk, error)
+
+	// StartReduceDisk starts reducing the size of a qcow2 disk on block storage to the size of the data it contains.
+	// The disk must not be attached to a running VM.
+	StartReduceDisk(diskID DiskID, retries ...RetryStrategy) (DiskUpdate, error)
+	// ReduceDisk is a shorthand for calling StartReduceDisk and then waiting for the operation to complete.
+	ReduceDisk(diskID DiskID, retries ...RetryStrategy) (Disk, error)
+}
+
+// LUNDiskStorageParameters describes the LUN backing a direct LUN disk. Use NewISCSILUNDiskParams or
+// NewFCPLUNDiskParams to create an instance.
+type LUNDiskStorageParameters interface {
+	// StorageType returns the type of the block storage. This is either StorageDomainTypeISCSI or
+	// StorageDomainTypeFCP.
+	StorageType() StorageDomainType
+	// LUNID returns the identifier of the LUN. For Fibre Channel LUNs this is the WWID.
+	LUNID() string
+	// Address returns the address of the iSCSI portal. Empty for Fibre Channel LUNs.
+	Address() string
+	// Port returns the port of the iSCSI portal. Zero for Fibre Channel LUNs.
+	Port() uint
+	// Target returns the iSCSI target name. Empty for Fibre Channel LUNs.
+	Target() string
+}
+
+// NewISCSILUNDiskParams creates the storage parameters for a direct LUN disk on an iSCSI LUN.
+func NewISCSILUNDiskParams(address string, port uint, target string, lunID string) (LUNDiskStorageParameters, error) {
+	if err := validateISCSIPortal(address, port); err != nil {
+		return nil, err
+	}
+	if target == "" {
+		return nil, newError(EBadArgument, "iSCSI target cannot be empty")
+	}
+	if lunID == "" {
+		return nil, newError(EBadArgument, "LUN ID cannot be empty")
+	}
+	return &lunDiskStorageParams{
+		storageType: StorageDomainTypeISCSI,
+		lunID:       lunID,
+		address:     address,
+		port:        port,
+		target:      target,
+	}, nil
+}
+
+// MustNewISCSILUNDiskParams is identical to NewISCSILUNDiskParams, but panics instead of returning an error.
+func MustNewISCSILUNDiskParams(address string, port uint, target string, lunID string) LUNDiskStorageParameters {
+	params, err := NewISCSILUNDiskParams(address, port, target, lunID)
+	if err != nil {
+		panic(err)
+	}
+	return params
+}
+
+// NewFCPLUNDiskParams creates the storage parameters for a direct LUN disk on the Fibre Channel LUN with the
+// specified WWID.
+func NewFCPLUNDiskParams(wwid string) (LUNDiskStorageParameters, error) {
+	if wwid == "" {
+		return nil, newError(EBadArgument, "LUN WWID cannot be empty")
+	}
+	return &lunDiskStorageParams{
+		storageType: StorageDomainTypeFCP,
+		lunID:       wwid,
+	}, nil
+}
+
+// MustNewFCPLUNDiskParams is identical to NewFCPLUNDiskParams, but panics instead of returning an error.
+func MustNewFCPLUNDiskParams(wwid string) LUNDiskStorageParameters {
+	params, err := NewFCPLUNDiskParams(wwid)
+	if err != nil {
+		panic(err)
+	}
+	return params
+}
+
+type lunDiskStorageParams struct {
+	storageType StorageDomainType
+	lunID       string
+	address     string
+	port        uint
+	target      string
+}
+
+func (l *lunDiskStorageParams) StorageType() StorageDomainType {
+	return l.storageType
+}
+
+func (l *lunDiskStorageParams) LUNID() string {
+	return l.lunID
+}
+
+func (l *lunDiskStorageParams) Address() string {
+	return l.address
+}
+
+func (l *lunDiskStorageParams) Port() uint {
+	return l.port
+}
+
+func (l *lunDiskStorageParams) Target() string {
+	return l.target
 }
 
 // UpdateDiskParams creates a builder for the params for updating a disk.
@@ -342,6 +534,24 @@ type CreateDiskOptionalParameters interface {
 
 	// InitialSize is the initially reserved disk space when creating the disk.
 	InitialSize() *uint64
+
+	// Description returns the description of the disk. If it returns nil, the disk will have no description.
+	Description() *string
+	// Shareable indicates that the disk can be attached to multiple VMs. Shareable disks must be in the raw format.
+	// If it returns nil, the default will be used.
+	Shareable() *bool
+	// WipeAfterDelete indicates that the disk should be overwritten with zeroes when it is removed. If it returns
+	// nil, the default of the storage domain will be used.
+	WipeAfterDelete() *bool
+	// DiskProfileID returns the disk profile to use for the disk. The disk profile must belong to the storage domain
+	// the disk is created on. If it returns an empty string, the default profile of the storage domain is used.
+	DiskProfileID() DiskProfileID
+	// Backup returns the backup mode of the disk. Incremental backups require the disk to be in the QCOW2 format.
+	// If it returns nil, the default will be used.
+	Backup() *DiskBackup
+	// ContentType returns the type of the content stored on the disk. If it returns nil, DiskContentTypeData is
+	// used.
+	ContentType() *DiskContentType
 }
 
 // BuildableCreateDiskParameters is a buildable version of CreateDiskOptionalParameters.
@@ -362,6 +572,36 @@ type BuildableCreateDiskParameters interface {
 	WithInitialSize(size uint64) (BuildableCreateDiskParameters, error)
 	// MustWithInitialSize is the same as WithInitialSize, but panics instead of returning an error.
 	MustWithInitialSize(size uint64) BuildableCreateDiskParameters
+
+	// WithDescription sets the description of the disk.
+	WithDescription(description string) (BuildableCreateDiskParameters, error)
+	// MustWithDescription is the same as WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableCreateDiskParameters
+
+	// WithShareable sets if the disk can be attached to multiple VMs.
+	WithShareable(shareable bool) (BuildableCreateDiskParameters, error)
+	// MustWithShareable is the same as WithShareable, but panics instead of returning an error.
+	MustWithShareable(shareable bool) BuildableCreateDiskParameters
+
+	// WithWipeAfterDelete sets if the disk should be overwritten with zeroes when it is removed.
+	WithWipeAfterDelete(wipeAfterDelete bool) (BuildableCreateDiskParameters, error)
+	// MustWithWipeAfterDelete is the same as WithWipeAfterDelete, but panics instead of returning an error.
+	MustWithWipeAfterDelete(wipeAfterDelete bool) BuildableCreateDiskParameters
+
+	// WithDiskProfileID sets the disk profile of the disk.
+	WithDiskProfileID(diskProfileID DiskProfileID) (BuildableCreateDiskParameters, error)
+	// MustWithDiskProfileID is the same as WithDiskProfileID, but panics instead of returning an error.
+	MustWithDiskProfileID(diskProfileID DiskProfileID) BuildableCreateDiskParameters
+
+	// WithBackup sets the backup mode of the disk.
+	WithBackup(backup DiskBackup) (BuildableCreateDiskParameters, error)
+	// MustWithBackup is the same as WithBackup, but panics instead of returning an error.
+	MustWithBackup(backup DiskBackup) BuildableCreateDiskParameters
+
+	// WithContentType sets the type of the content stored on the disk.
+	WithContentType(contentType DiskContentType) (BuildableCreateDiskParameters, error)
+	// MustWithContentType is the same as WithContentType, but panics instead of returning an error.
+	MustWithContentType(contentType DiskContentType) BuildableCreateDiskParameters
 }
 
 // CreateDiskParams creates a buildable set of CreateDiskOptionalParameters for use with
@@ -371,9 +611,15 @@ func CreateDiskParams() BuildableCreateDiskParameters {
 }
 
 type createDiskParams struct {
-	alias       string
-	sparse      *bool
-	initialSize *uint64
+	alias           string
+	sparse          *bool
+	initialSize     *uint64
+	description     *string
+	shareable       *bool
+	wipeAfterDelete *bool
+	diskProfileID   DiskProfileID
+	backup          *DiskBackup
+	contentType     *DiskContentType
 }
 
 func (c *createDiskParams) Alias() string {
@@ -427,6 +673,117 @@ func (c *createDiskParams) MustWithInitialSize(size uint64) BuildableCreateDiskP
 	return builder
 }
 
+func (c *createDiskParams) Description() *string {
+	return c.description
+}
+
+func (c *createDiskParams) WithDescription(description string) (BuildableCreateDiskParameters, error) {
+	c.description = &description
+	return c, nil
+}
+
+func (c *createDiskParams) MustWithDescription(description string) BuildableCreateDiskParameters {
+	builder, err := c.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *createDiskParams) Shareable() *bool {
+	return c.shareable
+}
+
+func (c *createDiskParams) WithShareable(shareable bool) (BuildableCreateDiskParameters, error) {
+	c.shareable = &shareable
+	return c, nil
+}
+
+func (c *createDiskParams) MustWithShareable(shareable bool) BuildableCreateDiskParameters {
+	builder, err := c.WithShareable(shareable)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *createDiskParams) WipeAfterDelete() *bool {
+	return c.wipeAfterDelete
+}
+
+func (c *createDiskParams) WithWipeAfterDelete(wipeAfterDelete bool) (BuildableCreateDiskParameters, error) {
+	c.wipeAfterDelete = &wipeAfterDelete
+	return c, nil
+}
+
+func (c *createDiskParams) MustWithWipeAfterDelete(wipeAfterDelete bool) BuildableCreateDiskParameters {
+	builder, err := c.WithWipeAfterDelete(wipeAfterDelete)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *createDiskParams) DiskProfileID() DiskProfileID {
+	return c.diskProfileID
+}
+
+func (c *createDiskParams) WithDiskProfileID(diskProfileID DiskProfileID) (BuildableCreateDiskParameters, error) {
+	if diskProfileID == "" {
+		return c, newError(EBadArgument, "the disk profile ID must not be empty")
+	}
+	c.diskProfileID = diskProfileID
+	return c, nil
+}
+
+func (c *createDiskParams) MustWithDiskProfileID(diskProfileID DiskProfileID) BuildableCreateDiskParameters {
+	builder, err := c.WithDiskProfileID(diskProfileID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *createDiskParams) Backup() *DiskBackup {
+	return c.backup
+}
+
+func (c *createDiskParams) WithBackup(backup DiskBackup) (BuildableCreateDiskParameters, error) {
+	if err := backup.Validate(); err != nil {
+		return c, err
+	}
+	c.backup = &backup
+	return c, nil
+}
+
+func (c *createDiskParams) MustWithBackup(backup DiskBackup) BuildableCreateDiskParameters {
+	builder, err := c.WithBackup(backup)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *createDiskParams) ContentType() *DiskContentType {
+	return c.contentType
+}
+
+func (c *createDiskParams) WithContentType(contentType DiskContentType) (BuildableCreateDiskParameters, error) {
+	if err := contentType.Validate(); err != nil {
+		return c, err
+	}
+	c.contentType = &contentType
+	return c, nil
+}
+
+func (c *createDiskParams) MustWithContentType(contentType DiskContentType) BuildableCreateDiskParameters {
+	builder, err := c.WithContentType(contentType)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
 // DiskCreation is a process object that lets you query the status of the disk creation.
 type DiskCreation interface {
 	// Disk returns the disk that has been created, even if it is not yet ready.
@@ -461,6 +818,107 @@ type ImageDownloadReader interface {
 	// Size returns the size of the disk image in bytes. This is ONLY available after the initialization is complete and
 	// MAY return 0 before.
 	Size() uint64
+	// WriteTo writes the whole image to the specified writer and closes the download. For sparse downloads zero
+	// extents are skipped if the writer supports io.WriterAt or io.Seeker, and data extents are fetched in parallel
+	// if the writer supports io.WriterAt. In this case the writer must be empty, for example a newly created file.
+	// WriteTo cannot be used after Read has been called. For incremental backup downloads only the dirty extents are
+	// written, so the writer must contain the previous backup of the disk.
+	WriteTo(w io.Writer) (n int64, err error)
+	// Extents returns the extents of the image. This is ONLY available after the initialization is complete. Non-sparse
+	// downloads report the whole image as a single data extent.
+	Extents() []ImageExtent
+}
+
+// ImageExtent describes a range of an image and whether it contains data or only zeros.
+type ImageExtent struct {
+	// Start is the offset of the first byte of the extent in the image.
+	Start uint64
+	// Length is the number of bytes in the extent.
+	Length uint64
+	// Zero is true if the extent only contains zeros and does not need to be downloaded.
+	Zero bool
+	// Dirty is only set for incremental backup downloads. It is true if the extent has changed since the checkpoint
+	// the backup was started from. Extents that are not dirty are not downloaded.
+	Dirty bool
+}
+
+// SparseDownloadParameters describes the parameters for a sparse download. Use SparseDownloadParams to create a
+// buildable version.
+type SparseDownloadParameters interface {
+	// Connections returns the number of concurrent connections used to fetch data extents when writing to an
+	// io.WriterAt.
+	Connections() uint
+	// ChunkSize returns the maximum number of bytes fetched in a single ranged request.
+	ChunkSize() uint64
+}
+
+// BuildableSparseDownloadParameters is a buildable version of SparseDownloadParameters.
+type BuildableSparseDownloadParameters interface {
+	SparseDownloadParameters
+
+	// WithConnections sets the number of concurrent connections used to fetch data extents.
+	WithConnections(connections uint) (BuildableSparseDownloadParameters, error)
+	// MustWithConnections is identical to WithConnections, but panics instead of returning an error.
+	MustWithConnections(connections uint) BuildableSparseDownloadParameters
+
+	// WithChunkSize sets the maximum number of bytes fetched in a single ranged request.
+	WithChunkSize(chunkSize uint64) (BuildableSparseDownloadParameters, error)
+	// MustWithChunkSize is identical to WithChunkSize, but panics instead of returning an error.
+	MustWithChunkSize(chunkSize uint64) BuildableSparseDownloadParameters
+}
+
+// SparseDownloadParams creates a buildable set of parameters for a sparse download with 4 concurrent connections
+// and a chunk size of 64 MiB.
+func SparseDownloadParams() BuildableSparseDownloadParameters {
+	return &sparseDownloadParams{
+		connections: 4,
+		chunkSize:   64 * 1024 * 1024,
+	}
+}
+
+type sparseDownloadParams struct {
+	connections uint
+	chunkSize   uint64
+}
+
+func (s *sparseDownloadParams) Connections() uint {
+	return s.connections
+}
+
+func (s *sparseDownloadParams) ChunkSize() uint64 {
+	return s.chunkSize
+}
+
+func (s *sparseDownloadParams) WithConnections(connections uint) (BuildableSparseDownloadParameters, error) {
+	if connections == 0 {
+		return nil, newError(EBadArgument, "at least one connection is required")
+	}
+	s.connections = connections
+	return s, nil
+}
+
+func (s *sparseDownloadParams) MustWithConnections(connections uint) BuildableSparseDownloadParameters {
+	builder, err := s.WithConnections(connections)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (s *sparseDownloadParams) WithChunkSize(chunkSize uint64) (BuildableSparseDownloadParameters, error) {
+	if chunkSize == 0 {
+		return nil, newError(EBadArgument, "chunk size must be positive")
+	}
+	s.chunkSize = chunkSize
+	return s, nil
+}
+
+func (s *sparseDownloadParams) MustWithChunkSize(chunkSize uint64) BuildableSparseDownloadParameters {
+	builder, err := s.WithChunkSize(chunkSize)
+	if err != nil {
+		panic(err)
+	}
+	return builder
 }
 
 // ImageDownload represents an image download in progress. The caller MUST
@@ -498,12 +956,30 @@ type DiskData interface {
 	Format() ImageFormat
 	// StorageDomainIDs returns a list of storage domains this disk is present on. This will typically be a single
 	// disk, but may have multiple disk when the disk has been copied over to other storage domains. The disk is always
-	// present on at least one disk, so this list will never be empty.
+	// present on at least one disk, so this list will never be empty, except for direct LUN disks, which are not
+	// stored on a storage domain.
 	StorageDomainIDs() []StorageDomainID
 	// Status returns the status the disk is in.
 	Status() DiskStatus
 	// Sparse indicates sparse provisioning on the disk.
 	Sparse() bool
+	// Description returns the description of the disk.
+	Description() string
+	// Shareable indicates that the disk can be attached to multiple VMs at the same time, for example for clustered
+	// file systems.
+	Shareable() bool
+	// WipeAfterDelete indicates that the disk is overwritten with zeroes when it is removed.
+	WipeAfterDelete() bool
+	// DiskProfileID returns the disk profile of the disk. It returns an empty string if the engine did not return a
+	// disk profile.
+	DiskProfileID() DiskProfileID
+	// Backup returns the backup mode of the disk.
+	Backup() DiskBackup
+	// ContentType returns the type of the content stored on the disk.
+	ContentType() DiskContentType
+	// LUNStorage returns the LUN backing the disk if the disk is a direct LUN disk, or nil if the disk is an image
+	// on a storage domain.
+	LUNStorage() LUN
 }
 
 // Disk is a disk in oVirt.
@@ -562,6 +1038,15 @@ type Disk interface {
 
 	// WaitForOK waits for the disk status to return to OK.
 	WaitForOK(retries ...RetryStrategy) (Disk, error)
+
+	// Move moves the disk to a different storage domain. See MoveDisk for details.
+	Move(storageDomainID StorageDomainID, retries ...RetryStrategy) (Disk, error)
+	// Copy copies the disk to a new disk on the specified storage domain. See CopyDisk for details.
+	Copy(storageDomainID StorageDomainID, alias string, retries ...RetryStrategy) (Disk, error)
+	// Sparsify releases the unused space of the disk. See SparsifyDisk for details.
+	Sparsify(retries ...RetryStrategy) (Disk, error)
+	// Reduce reduces the size of the disk image. See ReduceDisk for details.
+	Reduce(retries ...RetryStrategy) (Disk, error)
 }
 
 // DiskStatus shows the status of a disk. Certain operations lock a disk, which is important because the disk can then
@@ -614,6 +1099,207 @@ type UploadImageProgress interface {
 	Err() error
 	// Done returns a channel that will be closed when the upload is complete.
 	Done() <-chan struct{}
+	// TransferID returns the ID of the image transfer used for the upload. It returns an empty string until the
+	// image transfer has been created.
+	TransferID() ImageTransferID
+	// Chunks returns the progress of the individual ranges of the upload. Single-stream uploads report a single
+	// chunk covering the whole image.
+	Chunks() []UploadChunkProgress
+	// Throughput returns the average upload speed in bytes per second since the upload started.
+	Throughput() uint64
+}
+
+// ImageTransferID is the identifier of an image transfer in the oVirt Engine.
+type ImageTransferID string
+
+// UploadChunkProgress describes the progress of a single range of an image upload.
+type UploadChunkProgress interface {
+	// Offset is the position of the first byte of the chunk in the image.
+	Offset() uint64
+	// Length is the number of bytes in the chunk.
+	Length() uint64
+	// UploadedBytes returns the number of bytes of this chunk already uploaded. It is reset if the chunk is
+	// retried.
+	UploadedBytes() uint64
+	// Completed returns true if the chunk has been fully uploaded.
+	Completed() bool
+	// Tries returns the number of attempts made to upload this chunk.
+	Tries() uint
+}
+
+// ImageRange describes a byte range in an image. End is exclusive.
+type ImageRange struct {
+	Start uint64
+	End   uint64
+}
+
+// RangedUploadParameters describes the parameters for a ranged upload. Use RangedUploadParams to create a
+// buildable version.
+type RangedUploadParameters interface {
+	// ChunkSize returns the size of the ranges in bytes the image is split into.
+	ChunkSize() uint64
+	// Connections returns the number of concurrent connections used for the upload.
+	Connections() uint
+	// TransferID returns the ID of an existing image transfer to resume, or nil if a new transfer should be
+	// started.
+	TransferID() *ImageTransferID
+	// CompletedRanges returns the ranges already uploaded in a previous attempt when resuming a transfer.
+	CompletedRanges() []ImageRange
+	// Resumable returns true if a failed upload should pause the image transfer instead of aborting it. The disk
+	// remains locked until the transfer is resumed and completed, or cancelled in the oVirt Engine.
+	Resumable() bool
+}
+
+// BuildableRangedUploadParameters is a buildable version of RangedUploadParameters.
+type BuildableRangedUploadParameters interface {
+	RangedUploadParameters
+
+	// WithChunkSize sets the size of the ranges in bytes the image is split into.
+	WithChunkSize(chunkSize uint64) (BuildableRangedUploadParameters, error)
+	// MustWithChunkSize is identical to WithChunkSize, but panics instead of returning an error.
+	MustWithChunkSize(chunkSize uint64) BuildableRangedUploadParameters
+
+	// WithConnections sets the number of concurrent connections used for the upload.
+	WithConnections(connections uint) (BuildableRangedUploadParameters, error)
+	// MustWithConnections is identical to WithConnections, but panics instead of returning an error.
+	MustWithConnections(connections uint) BuildableRangedUploadParameters
+
+	// WithTransferID sets the ID of an existing image transfer to resume.
+	WithTransferID(transferID ImageTransferID) (BuildableRangedUploadParameters, error)
+	// MustWithTransferID is identical to WithTransferID, but panics instead of returning an error.
+	MustWithTransferID(transferID ImageTransferID) BuildableRangedUploadParameters
+
+	// WithCompletedRanges sets the ranges already uploaded in a previous attempt. These ranges are not uploaded
+	// again when resuming a transfer.
+	WithCompletedRanges(ranges []ImageRange) (BuildableRangedUploadParameters, error)
+	// MustWithCompletedRanges is identical to WithCompletedRanges, but panics instead of returning an error.
+	MustWithCompletedRanges(ranges []ImageRange) BuildableRangedUploadParameters
+
+	// WithResumable sets if a failed upload should pause the image transfer instead of aborting it.
+	WithResumable(resumable bool) (BuildableRangedUploadParameters, error)
+	// MustWithResumable is identical to WithResumable, but panics instead of returning an error.
+	MustWithResumable(resumable bool) BuildableRangedUploadParameters
+}
+
+// RangedUploadParams creates a buildable set of parameters for a ranged upload with a chunk size of 64 MiB and 4
+// concurrent connections.
+func RangedUploadParams() BuildableRangedUploadParameters {
+	return &rangedUploadParams{
+		chunkSize:   64 * 1024 * 1024,
+		connections: 4,
+	}
+}
+
+type rangedUploadParams struct {
+	chunkSize       uint64
+	connections     uint
+	transferID      *ImageTransferID
+	completedRanges []ImageRange
+	resumable       bool
+}
+
+func (r *rangedUploadParams) ChunkSize() uint64 {
+	return r.chunkSize
+}
+
+func (r *rangedUploadParams) Connections() uint {
+	return r.connections
+}
+
+func (r *rangedUploadParams) TransferID() *ImageTransferID {
+	return r.transferID
+}
+
+func (r *rangedUploadParams) CompletedRanges() []ImageRange {
+	return r.completedRanges
+}
+
+func (r *rangedUploadParams) Resumable() bool {
+	return r.resumable
+}
+
+func (r *rangedUploadParams) WithChunkSize(chunkSize uint64) (BuildableRangedUploadParameters, error) {
+	if chunkSize == 0 {
+		return nil, newError(EBadArgument, "chunk size must be positive")
+	}
+	r.chunkSize = chunkSize
+	return r, nil
+}
+
+func (r *rangedUploadParams) MustWithChunkSize(chunkSize uint64) BuildableRangedUploadParameters {
+	builder, err := r.WithChunkSize(chunkSize)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (r *rangedUploadParams) WithConnections(connections uint) (BuildableRangedUploadParameters, error) {
+	if connections == 0 {
+		return nil, newError(EBadArgument, "at least one connection is required")
+	}
+	r.connections = connections
+	return r, nil
+}
+
+func (r *rangedUploadParams) MustWithConnections(connections uint) BuildableRangedUploadParameters {
+	builder, err := r.WithConnections(connections)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (r *rangedUploadParams) WithTransferID(transferID ImageTransferID) (BuildableRangedUploadParameters, error) {
+	if transferID == "" {
+		return nil, newError(EBadArgument, "transfer ID cannot be empty")
+	}
+	r.transferID = &transferID
+	return r, nil
+}
+
+func (r *rangedUploadParams) MustWithTransferID(transferID ImageTransferID) BuildableRangedUploadParameters {
+	builder, err := r.WithTransferID(transferID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (r *rangedUploadParams) WithCompletedRanges(ranges []ImageRange) (BuildableRangedUploadParameters, error) {
+	for _, imageRange := range ranges {
+		if imageRange.End <= imageRange.Start {
+			return nil, newError(
+				EBadArgument,
+				"invalid image range: end (%d) must be larger than start (%d)",
+				imageRange.End,
+				imageRange.Start,
+			)
+		}
+	}
+	r.completedRanges = ranges
+	return r, nil
+}
+
+func (r *rangedUploadParams) MustWithCompletedRanges(ranges []ImageRange) BuildableRangedUploadParameters {
+	builder, err := r.WithCompletedRanges(ranges)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (r *rangedUploadParams) WithResumable(resumable bool) (BuildableRangedUploadParameters, error) {
+	r.resumable = resumable
+	return r, nil
+}
+
+func (r *rangedUploadParams) MustWithResumable(resumable bool) BuildableRangedUploadParameters {
+	builder, err := r.WithResumable(resumable)
+	if err != nil {
+		panic(err)
+	}
+	return builder
 }
 
 // ImageFormat is a constant for representing the format that images can be in. This is relevant
@@ -669,11 +1355,134 @@ func (l ImageFormatList) Strings() []string {
 	return result
 }
 
+// DiskBackup describes if a disk takes part in incremental backups.
+type DiskBackup string
+
+// Validate returns an error if the disk backup mode doesn't have a valid value.
+func (b DiskBackup) Validate() error {
+	for _, backup := range DiskBackupValues() {
+		if backup == b {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid disk backup mode: %s must be one of: %s",
+		b,
+		strings.Join(DiskBackupValues().Strings(), ", "),
+	)
+}
+
+const (
+	// DiskBackupNone indicates that only full backups can be taken of the disk.
+	DiskBackupNone DiskBackup = "none"
+	// DiskBackupIncremental enables incremental backups for the disk. The disk must be in the QCOW2 format.
+	DiskBackupIncremental DiskBackup = "incremental"
+)
+
+// DiskBackupList is a list of DiskBackup values.
+type DiskBackupList []DiskBackup
+
+// DiskBackupValues returns all possible DiskBackup values.
+func DiskBackupValues() DiskBackupList {
+	return []DiskBackup{
+		DiskBackupNone,
+		DiskBackupIncremental,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l DiskBackupList) Strings() []string {
+	result := make([]string, len(l))
+	for i, backup := range l {
+		result[i] = string(backup)
+	}
+	return result
+}
+
+// DiskContentType describes what kind of content is stored on a disk.
+type DiskContentType string
+
+// Validate returns an error if the disk content type doesn't have a valid value.
+func (c DiskContentType) Validate() error {
+	for _, contentType := range DiskContentTypeValues() {
+		if contentType == c {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid disk content type: %s must be one of: %s",
+		c,
+		strings.Join(DiskContentTypeValues().Strings(), ", "),
+	)
+}
+
+const (
+	// DiskContentTypeData is a regular disk used by a VM.
+	DiskContentTypeData DiskContentType = "data"
+	// DiskContentTypeISO is an ISO image that can be attached to a VM as a CD-ROM.
+	DiskContentTypeISO DiskContentType = "iso"
+	// DiskContentTypeMemoryDumpVolume contains the memory dump of a VM taken with a snapshot or hibernation.
+	DiskContentTypeMemoryDumpVolume DiskContentType = "memory_dump_volume"
+	// DiskContentTypeMemoryMetadataVolume contains the metadata of a memory dump.
+	DiskContentTypeMemoryMetadataVolume DiskContentType = "memory_metadata_volume"
+	// DiskContentTypeOVFStore contains the OVF descriptions of the VMs and templates on a storage domain.
+	DiskContentTypeOVFStore DiskContentType = "ovf_store"
+	// DiskContentTypeBackupScratch is a temporary disk holding the changes of a disk during a backup.
+	DiskContentTypeBackupScratch DiskContentType = "backup_scratch"
+	// DiskContentTypeHostedEngine is the disk of the hosted engine VM.
+	DiskContentTypeHostedEngine DiskContentType = "hosted_engine"
+	// DiskContentTypeHostedEngineConfiguration contains the configuration of the hosted engine.
+	DiskContentTypeHostedEngineConfiguration DiskContentType = "hosted_engine_configuration"
+	// DiskContentTypeHostedEngineMetadata contains the metadata of the hosted engine.
+	DiskContentTypeHostedEngineMetadata DiskContentType = "hosted_engine_metadata"
+	// DiskContentTypeHostedEngineSanlock contains the sanlock lockspace of the hosted engine.
+	DiskContentTypeHostedEngineSanlock DiskContentType = "hosted_engine_sanlock"
+)
+
+// DiskContentTypeList is a list of DiskContentType values.
+type DiskContentTypeList []DiskContentType
+
+// DiskContentTypeValues returns all possible DiskContentType values.
+func DiskContentTypeValues() DiskContentTypeList {
+	return []DiskContentType{
+		DiskContentTypeData,
+		DiskContentTypeISO,
+		DiskContentTypeMemoryDumpVolume,
+		DiskContentTypeMemoryMetadataVolume,
+		DiskContentTypeOVFStore,
+		DiskContentTypeBackupScratch,
+		DiskContentTypeHostedEngine,
+		DiskContentTypeHostedEngineConfiguration,
+		DiskContentTypeHostedEngineMetadata,
+		DiskContentTypeHostedEngineSanlock,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l DiskContentTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, contentType := range l {
+		result[i] = string(contentType)
+	}
+	return result
+}
+
 func convertSDKDisk(sdkDisk *ovirtsdk4.Disk, client Client) (Disk, error) {
 	id, ok := sdkDisk.Id()
 	if !ok {
 		return nil, newError(EFieldMissing, "disk does not contain an ID")
 	}
+	// LUN disks are not stored on a storage domain and have no image, so the image-related fields are optional.
+	var lunStorage *lun
+	if sdkLUNStorage, ok := sdkDisk.LunStorage(); ok {
+		var err error
+		lunStorage, err = convertSDKDiskLUNStorage(sdkLUNStorage)
+		if err != nil {
+			return nil, wrap(err, EBug, "failed to convert LUN storage of disk %s", id)
+		}
+	}
 	var storageDomainIDs []StorageDomainID
 	if sdkStorageDomain, ok := sdkDisk.StorageDomain(); ok {
 		storageDomainID, _ := sdkStorageDomain.Id()
@@ -685,7 +1494,7 @@ func convertSDKDisk(sdkDisk *ovirtsdk4.Disk, client Client) (Disk, error) {
 			storageDomainIDs = append(storageDomainIDs, StorageDomainID(storageDomainID))
 		}
 	}
-	if len(storageDomainIDs) == 0 {
+	if len(storageDomainIDs) == 0 && lunStorage == nil {
 		return nil, newError(EFieldMissing, "failed to find a valid storage domain for disk %s", id)
 	}
 	alias, ok := sdkDisk.Alias()
@@ -694,25 +1503,34 @@ func convertSDKDisk(sdkDisk *ovirtsdk4.Disk, client Client) (Disk, error) {
 	}
 	provisionedSize, ok := sdkDisk.ProvisionedSize()
 	if !ok {
-		return nil, newError(EFieldMissing, "disk %s does not contain a provisioned size", id)
+		if lunStorage == nil {
+			return nil, newError(EFieldMissing, "disk %s does not contain a provisioned size", id)
+		}
+		provisionedSize = int64(lunStorage.size)
 	}
 	totalSize, ok := sdkDisk.TotalSize()
-	if !ok {
+	if !ok && lunStorage == nil {
 		return nil, newError(EFieldMissing, "disk %s does not contain a total size", id)
 	}
 	format, ok := sdkDisk.Format()
 	if !ok {
-		return nil, newError(EFieldMissing, "disk %s has no format field", id)
+		if lunStorage == nil {
+			return nil, newError(EFieldMissing, "disk %s has no format field", id)
+		}
+		format = ovirtsdk4.DiskFormat(ImageFormatRaw)
 	}
 	status, ok := sdkDisk.Status()
 	if !ok {
-		return nil, newError(EFieldMissing, "disk %s has no status field", id)
+		if lunStorage == nil {
+			return nil, newError(EFieldMissing, "disk %s has no status field", id)
+		}
+		status = ovirtsdk4.DiskStatus(DiskStatusOK)
 	}
 	sparse, ok := sdkDisk.Sparse()
-	if !ok {
+	if !ok && lunStorage == nil {
 		return nil, newError(EFieldMissing, "disk %s has no sparse field", id)
 	}
-	return &disk{
+	result := &disk{
 		client: client,
 
 		id:               DiskID(id),
@@ -723,7 +1541,24 @@ func convertSDKDisk(sdkDisk *ovirtsdk4.Disk, client Client) (Disk, error) {
 		storageDomainIDs: storageDomainIDs,
 		status:           DiskStatus(status),
 		sparse:           sparse,
-	}, nil
+		backup:           DiskBackupNone,
+		contentType:      DiskContentTypeData,
+		lunStorage:       lunStorage,
+	}
+	result.description, _ = sdkDisk.Description()
+	result.shareable, _ = sdkDisk.Shareable()
+	result.wipeAfterDelete, _ = sdkDisk.WipeAfterDelete()
+	if diskProfile, ok := sdkDisk.DiskProfile(); ok {
+		diskProfileID, _ := diskProfile.Id()
+		result.diskProfileID = DiskProfileID(diskProfileID)
+	}
+	if backup, ok := sdkDisk.Backup(); ok {
+		result.backup = DiskBackup(backup)
+	}
+	if contentType, ok := sdkDisk.ContentType(); ok {
+		result.contentType = DiskContentType(contentType)
+	}
+	return result, nil
 }
 
 type disk struct {
@@ -737,6 +1572,13 @@ type disk struct {
 	status           DiskStatus
 	totalSize        uint64
 	sparse           bool
+	description      string
+	shareable        bool
+	wipeAfterDelete  bool
+	diskProfileID    DiskProfileID
+	backup           DiskBackup
+	contentType      DiskContentType
+	lunStorage       *lun
 }
 
 func (d *disk) WaitForOK(retries ...RetryStrategy) (Disk, error) {
@@ -767,10 +1609,57 @@ func (d *disk) StartUpdate(params UpdateDiskParameters, retries ...RetryStrategy
 	return d.client.StartUpdateDisk(d.id, params, retries...)
 }
 
+func (d *disk) Move(storageDomainID StorageDomainID, retries ...RetryStrategy) (Disk, error) {
+	return d.client.MoveDisk(d.id, storageDomainID, retries...)
+}
+
+func (d *disk) Copy(storageDomainID StorageDomainID, alias string, retries ...RetryStrategy) (Disk, error) {
+	return d.client.CopyDisk(d.id, storageDomainID, alias, retries...)
+}
+
+func (d *disk) Sparsify(retries ...RetryStrategy) (Disk, error) {
+	return d.client.SparsifyDisk(d.id, retries...)
+}
+
+func (d *disk) Reduce(retries ...RetryStrategy) (Disk, error) {
+	return d.client.ReduceDisk(d.id, retries...)
+}
+
 func (d *disk) Sparse() bool {
 	return d.sparse
 }
 
+func (d *disk) Description() string {
+	return d.description
+}
+
+func (d *disk) Shareable() bool {
+	return d.shareable
+}
+
+func (d *disk) WipeAfterDelete() bool {
+	return d.wipeAfterDelete
+}
+
+func (d *disk) DiskProfileID() DiskProfileID {
+	return d.diskProfileID
+}
+
+func (d *disk) Backup() DiskBackup {
+	return d.backup
+}
+
+func (d *disk) ContentType() DiskContentType {
+	return d.contentType
+}
+
+func (d *disk) LUNStorage() LUN {
+	if d.lunStorage == nil {
+		return nil
+	}
+	return d.lunStorage
+}
+
 func (d *disk) AttachToVM(
 	vmID VMID,
 	diskInterface DiskInterface,
@@ -848,3 +1737,36 @@ func (d *diskWait) Wait(retries ...RetryStrategy) (Disk, error) {
 	}
 	return disk, err
 }
+
+// startDiskJob sends a request that starts an asynchronous job on a disk and returns a diskWait to track the job. The
+// send function receives the correlation ID that must be passed with the request.
+func (o *oVirtClient) startDiskJob(
+	id DiskID,
+	action string,
+	send func(diskService *ovirtsdk4.DiskService, correlationID string) error,
+	retries []RetryStrategy,
+) (*diskWait, error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	correlationID := fmt.Sprintf("disk_%s_%s", action, generateRandomID(5, o.nonSecureRandom))
+	err := retry(
+		fmt.Sprintf("starting %s of disk %s", action, id),
+		o.logger,
+		retries,
+		func() error {
+			return send(o.conn.SystemService().DisksService().DiskService(string(id)), correlationID)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	disk, err := o.GetDisk(id, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return &diskWait{
+		client:        o,
+		disk:          disk,
+		correlationID: correlationID,
+		lock:          &sync.Mutex{},
+	}, nil
+}
@@ -23,6 +23,15 @@ type DiskAttachmentClient interface {
 	GetDiskAttachment(vmID VMID, id DiskAttachmentID, retries ...RetryStrategy) (DiskAttachment, error)
 	// ListDiskAttachments lists all disk attachments for a virtual machine.
 	ListDiskAttachments(vmID VMID, retries ...RetryStrategy) ([]DiskAttachment, error)
+	// UpdateDiskAttachment changes the interface, bootable or active flag of a disk attachment. Changing the active
+	// flag of an attachment on a running VM hot plugs or unplugs the disk. The interface can only be changed while
+	// the VM is down, and only one disk attachment per VM can be bootable.
+	UpdateDiskAttachment(
+		vmID VMID,
+		id DiskAttachmentID,
+		params UpdateDiskAttachmentParameters,
+		retries ...RetryStrategy,
+	) (DiskAttachment, error)
 	// RemoveDiskAttachment removes the disk attachment in question.
 	RemoveDiskAttachment(vmID VMID, diskAttachmentID DiskAttachmentID, retries ...RetryStrategy) error
 }
@@ -92,6 +101,18 @@ type CreateDiskAttachmentOptionalParams interface {
 
 	// Active defines whether the disk is active in the virtual machine it’s attached to.
 	Active() *bool
+
+	// ReadOnly defines whether the VM can only read from the disk. Read-only disks are not supported on the IDE
+	// interface.
+	ReadOnly() *bool
+
+	// PassDiscard defines whether the VM passes discard commands to the storage. This requires the VirtIO or
+	// VirtIO-SCSI interface.
+	PassDiscard() *bool
+
+	// UsesSCSIReservation defines whether the VM can use SCSI reservations on the disk, for example for clustered
+	// applications. This requires the VirtIO-SCSI interface.
+	UsesSCSIReservation() *bool
 }
 
 // BuildableCreateDiskAttachmentParams is a buildable version of CreateDiskAttachmentOptionalParams.
@@ -106,6 +127,21 @@ type BuildableCreateDiskAttachmentParams interface {
 	WithActive(active bool) (BuildableCreateDiskAttachmentParams, error)
 	// MustWithActive is the same as WithActive, but panics instead of returning an error.
 	MustWithActive(active bool) BuildableCreateDiskAttachmentParams
+
+	// WithReadOnly sets whether the VM can only read from the disk.
+	WithReadOnly(readOnly bool) (BuildableCreateDiskAttachmentParams, error)
+	// MustWithReadOnly is the same as WithReadOnly, but panics instead of returning an error.
+	MustWithReadOnly(readOnly bool) BuildableCreateDiskAttachmentParams
+
+	// WithPassDiscard sets whether the VM passes discard commands to the storage.
+	WithPassDiscard(passDiscard bool) (BuildableCreateDiskAttachmentParams, error)
+	// MustWithPassDiscard is the same as WithPassDiscard, but panics instead of returning an error.
+	MustWithPassDiscard(passDiscard bool) BuildableCreateDiskAttachmentParams
+
+	// WithUsesSCSIReservation sets whether the VM can use SCSI reservations on the disk.
+	WithUsesSCSIReservation(usesSCSIReservation bool) (BuildableCreateDiskAttachmentParams, error)
+	// MustWithUsesSCSIReservation is the same as WithUsesSCSIReservation, but panics instead of returning an error.
+	MustWithUsesSCSIReservation(usesSCSIReservation bool) BuildableCreateDiskAttachmentParams
 }
 
 // CreateDiskAttachmentParams creates a buildable set of parameters for creating a disk attachment.
@@ -114,8 +150,11 @@ func CreateDiskAttachmentParams() BuildableCreateDiskAttachmentParams {
 }
 
 type createDiskAttachmentParams struct {
-	bootable *bool
-	active   *bool
+	bootable            *bool
+	active              *bool
+	readOnly            *bool
+	passDiscard         *bool
+	usesSCSIReservation *bool
 }
 
 func (c createDiskAttachmentParams) Bootable() *bool {
@@ -152,6 +191,191 @@ func (c createDiskAttachmentParams) MustWithActive(active bool) BuildableCreateD
 	return builder
 }
 
+func (c createDiskAttachmentParams) ReadOnly() *bool {
+	return c.readOnly
+}
+
+func (c createDiskAttachmentParams) WithReadOnly(readOnly bool) (BuildableCreateDiskAttachmentParams, error) {
+	c.readOnly = &readOnly
+	return c, nil
+}
+
+func (c createDiskAttachmentParams) MustWithReadOnly(readOnly bool) BuildableCreateDiskAttachmentParams {
+	builder, err := c.WithReadOnly(readOnly)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c createDiskAttachmentParams) PassDiscard() *bool {
+	return c.passDiscard
+}
+
+func (c createDiskAttachmentParams) WithPassDiscard(passDiscard bool) (BuildableCreateDiskAttachmentParams, error) {
+	c.passDiscard = &passDiscard
+	return c, nil
+}
+
+func (c createDiskAttachmentParams) MustWithPassDiscard(passDiscard bool) BuildableCreateDiskAttachmentParams {
+	builder, err := c.WithPassDiscard(passDiscard)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c createDiskAttachmentParams) UsesSCSIReservation() *bool {
+	return c.usesSCSIReservation
+}
+
+func (c createDiskAttachmentParams) WithUsesSCSIReservation(
+	usesSCSIReservation bool,
+) (BuildableCreateDiskAttachmentParams, error) {
+	c.usesSCSIReservation = &usesSCSIReservation
+	return c, nil
+}
+
+func (c createDiskAttachmentParams) MustWithUsesSCSIReservation(
+	usesSCSIReservation bool,
+) BuildableCreateDiskAttachmentParams {
+	builder, err := c.WithUsesSCSIReservation(usesSCSIReservation)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// UpdateDiskAttachmentParameters contains the changes to a disk attachment. Fields that return nil are not changed.
+type UpdateDiskAttachmentParameters interface {
+	// DiskInterface returns the new interface of the disk. The interface can only be changed while the VM is down.
+	DiskInterface() *DiskInterface
+	// Bootable returns whether the disk should be bootable.
+	Bootable() *bool
+	// Active returns whether the disk should be active. Changing this flag on a running VM hot plugs or unplugs
+	// the disk.
+	Active() *bool
+}
+
+// BuildableUpdateDiskAttachmentParameters is a buildable version of UpdateDiskAttachmentParameters.
+type BuildableUpdateDiskAttachmentParameters interface {
+	UpdateDiskAttachmentParameters
+
+	// WithDiskInterface sets the new interface of the disk.
+	WithDiskInterface(diskInterface DiskInterface) (BuildableUpdateDiskAttachmentParameters, error)
+	// MustWithDiskInterface is the same as WithDiskInterface, but panics instead of returning an error.
+	MustWithDiskInterface(diskInterface DiskInterface) BuildableUpdateDiskAttachmentParameters
+
+	// WithBootable sets whether the disk should be bootable.
+	WithBootable(bootable bool) (BuildableUpdateDiskAttachmentParameters, error)
+	// MustWithBootable is the same as WithBootable, but panics instead of returning an error.
+	MustWithBootable(bootable bool) BuildableUpdateDiskAttachmentParameters
+
+	// WithActive sets whether the disk should be active.
+	WithActive(active bool) (BuildableUpdateDiskAttachmentParameters, error)
+	// MustWithActive is the same as WithActive, but panics instead of returning an error.
+	MustWithActive(active bool) BuildableUpdateDiskAttachmentParameters
+}
+
+// UpdateDiskAttachmentParams creates a buildable set of parameters for updating a disk attachment.
+func UpdateDiskAttachmentParams() BuildableUpdateDiskAttachmentParameters {
+	return &updateDiskAttachmentParams{}
+}
+
+type updateDiskAttachmentParams struct {
+	diskInterface *DiskInterface
+	bootable      *bool
+	active        *bool
+}
+
+func (u *updateDiskAttachmentParams) DiskInterface() *DiskInterface {
+	return u.diskInterface
+}
+
+func (u *updateDiskAttachmentParams) WithDiskInterface(
+	diskInterface DiskInterface,
+) (BuildableUpdateDiskAttachmentParameters, error) {
+	if err := diskInterface.Validate(); err != nil {
+		return nil, err
+	}
+	u.diskInterface = &diskInterface
+	return u, nil
+}
+
+func (u *updateDiskAttachmentParams) MustWithDiskInterface(
+	diskInterface DiskInterface,
+) BuildableUpdateDiskAttachmentParameters {
+	builder, err := u.WithDiskInterface(diskInterface)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateDiskAttachmentParams) Bootable() *bool {
+	return u.bootable
+}
+
+func (u *updateDiskAttachmentParams) WithBootable(bootable bool) (BuildableUpdateDiskAttachmentParameters, error) {
+	u.bootable = &bootable
+	return u, nil
+}
+
+func (u *updateDiskAttachmentParams) MustWithBootable(bootable bool) BuildableUpdateDiskAttachmentParameters {
+	builder, err := u.WithBootable(bootable)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateDiskAttachmentParams) Active() *bool {
+	return u.active
+}
+
+func (u *updateDiskAttachmentParams) WithActive(active bool) (BuildableUpdateDiskAttachmentParameters, error) {
+	u.active = &active
+	return u, nil
+}
+
+func (u *updateDiskAttachmentParams) MustWithActive(active bool) BuildableUpdateDiskAttachmentParameters {
+	builder, err := u.WithActive(active)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// validateDiskAttachmentOptions checks that the interface of a disk attachment supports the requested options.
+func validateDiskAttachmentOptions(
+	diskInterface DiskInterface,
+	readOnly bool,
+	passDiscard bool,
+	usesSCSIReservation bool,
+) error {
+	if readOnly && diskInterface == DiskInterfaceIDE {
+		return newError(EBadArgument, "read-only disks are not supported on the %s interface", diskInterface)
+	}
+	if passDiscard && diskInterface != DiskInterfaceVirtIO && diskInterface != DiskInterfaceVirtIOSCSI {
+		return newError(
+			EBadArgument,
+			"passing discard commands requires the %s or %s interface, not %s",
+			DiskInterfaceVirtIO,
+			DiskInterfaceVirtIOSCSI,
+			diskInterface,
+		)
+	}
+	if usesSCSIReservation && diskInterface != DiskInterfaceVirtIOSCSI {
+		return newError(
+			EBadArgument,
+			"SCSI reservations require the %s interface, not %s",
+			DiskInterfaceVirtIOSCSI,
+			diskInterface,
+		)
+	}
+	return nil
+}
+
 // DiskAttachment links together a Disk and a VM.
 type DiskAttachment interface {
 	// ID returns the identifier of the attachment.
@@ -166,12 +390,23 @@ type DiskAttachment interface {
 	Bootable() bool
 	// Active defines whether the disk is active in the virtual machine it’s attached to.
 	Active() bool
+	// ReadOnly defines whether the VM can only read from the disk.
+	ReadOnly() bool
+	// PassDiscard defines whether the VM passes discard commands to the storage.
+	PassDiscard() bool
+	// UsesSCSIReservation defines whether the VM can use SCSI reservations on the disk.
+	UsesSCSIReservation() bool
+	// LogicalName returns the name of the disk device inside the guest, for example /dev/vda. The name is reported
+	// by the guest agent, so it is empty if the VM is not running or has no guest agent.
+	LogicalName() string
 
 	// VM fetches the virtual machine this attachment belongs to.
 	VM(retries ...RetryStrategy) (VM, error)
 	// Disk fetches the disk this attachment attaches.
 	Disk(retries ...RetryStrategy) (Disk, error)
 
+	// Update changes the current disk attachment. See UpdateDiskAttachment for details.
+	Update(params UpdateDiskAttachmentParameters, retries ...RetryStrategy) (DiskAttachment, error)
 	// Remove removes the current disk attachment.
 	Remove(retries ...RetryStrategy) error
 }
@@ -179,12 +414,16 @@ type DiskAttachment interface {
 type diskAttachment struct {
 	client Client
 
-	id            DiskAttachmentID
-	vmid          VMID
-	diskID        DiskID
-	diskInterface DiskInterface
-	active        bool
-	bootable      bool
+	id                  DiskAttachmentID
+	vmid                VMID
+	diskID              DiskID
+	diskInterface       DiskInterface
+	active              bool
+	bootable            bool
+	readOnly            bool
+	passDiscard         bool
+	usesSCSIReservation bool
+	logicalName         string
 }
 
 func (d *diskAttachment) DiskInterface() DiskInterface {
@@ -215,6 +454,34 @@ func (d *diskAttachment) Active() bool {
 	return d.active
 }
 
+func (d *diskAttachment) ReadOnly() bool {
+	return d.readOnly
+}
+
+func (d *diskAttachment) PassDiscard() bool {
+	return d.passDiscard
+}
+
+func (d *diskAttachment) UsesSCSIReservation() bool {
+	return d.usesSCSIReservation
+}
+
+func (d *diskAttachment) LogicalName() string {
+	return d.logicalName
+}
+
+func (d *diskAttachment) Update(
+	params UpdateDiskAttachmentParameters,
+	retries ...RetryStrategy,
+) (DiskAttachment, error) {
+	return d.client.UpdateDiskAttachment(d.vmid, d.id, params, retries...)
+}
+
+func (d *diskAttachment) clone() *diskAttachment {
+	result := *d
+	return &result
+}
+
 func (d *diskAttachment) VM(retries ...RetryStrategy) (VM, error) {
 	return d.client.GetVM(d.vmid, retries...)
 }
@@ -256,7 +523,7 @@ func convertSDKDiskAttachment(object *ovirtsdk4.DiskAttachment, o *oVirtClient)
 	if !ok {
 		return nil, newFieldNotFound("active on disk attachment", "active")
 	}
-	return &diskAttachment{
+	result := &diskAttachment{
 		client: o,
 
 		id:            DiskAttachmentID(id),
@@ -265,5 +532,10 @@ func convertSDKDiskAttachment(object *ovirtsdk4.DiskAttachment, o *oVirtClient)
 		diskInterface: DiskInterface(diskInterface),
 		bootable:      bootable,
 		active:        active,
-	}, nil
+	}
+	result.readOnly, _ = object.ReadOnly()
+	result.passDiscard, _ = object.PassDiscard()
+	result.usesSCSIReservation, _ = object.UsesScsiReservation()
+	result.logicalName, _ = object.LogicalName()
+	return result, nil
 }
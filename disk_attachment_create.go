@@ -14,8 +14,8 @@ func (o *oVirtClient) CreateDiskAttachment(
 	retries ...RetryStrategy,
 ) (result DiskAttachment, err error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
-	if err := diskInterface.Validate(); err != nil {
-		return nil, wrap(err, EBadArgument, "failed to create disk attachment")
+	if err := validateCreateDiskAttachmentParams(diskInterface, params); err != nil {
+		return nil, err
 	}
 	err = retry(
 		fmt.Sprintf("attaching disk %s to vm %s", diskID, vmID),
@@ -33,6 +33,15 @@ func (o *oVirtClient) CreateDiskAttachment(
 				if bootable := params.Bootable(); bootable != nil {
 					attachmentBuilder.Bootable(*params.Bootable())
 				}
+				if readOnly := params.ReadOnly(); readOnly != nil {
+					attachmentBuilder.ReadOnly(*readOnly)
+				}
+				if passDiscard := params.PassDiscard(); passDiscard != nil {
+					attachmentBuilder.PassDiscard(*passDiscard)
+				}
+				if usesSCSIReservation := params.UsesSCSIReservation(); usesSCSIReservation != nil {
+					attachmentBuilder.UsesScsiReservation(*usesSCSIReservation)
+				}
 			}
 			attachment := attachmentBuilder.MustBuild()
 
@@ -74,8 +83,8 @@ func (m *mockClient) CreateDiskAttachment(
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
-	if err := diskInterface.Validate(); err != nil {
-		return nil, wrap(err, EBadArgument, "failed to create disk attachment")
+	if err := validateCreateDiskAttachmentParams(diskInterface, params); err != nil {
+		return nil, err
 	}
 
 	vm, ok := m.vms[vmID]
@@ -102,6 +111,20 @@ func (m *mockClient) CreateDiskAttachment(
 		if active := params.Active(); active != nil {
 			attachment.active = *active
 		}
+		if readOnly := params.ReadOnly(); readOnly != nil {
+			attachment.readOnly = *readOnly
+		}
+		if passDiscard := params.PassDiscard(); passDiscard != nil {
+			attachment.passDiscard = *passDiscard
+		}
+		if usesSCSIReservation := params.UsesSCSIReservation(); usesSCSIReservation != nil {
+			attachment.usesSCSIReservation = *usesSCSIReservation
+		}
+	}
+	if attachment.bootable {
+		if err := m.checkNoOtherBootableDisk(vm.ID(), ""); err != nil {
+			return nil, err
+		}
 	}
 	for _, diskAttachment := range m.vmDiskAttachmentsByVM[vm.ID()] {
 		if diskAttachment.DiskID() == diskID {
@@ -109,18 +132,61 @@ func (m *mockClient) CreateDiskAttachment(
 		}
 	}
 
-	if diskAttachment, ok := m.vmDiskAttachmentsByDisk[disk.ID()]; ok {
-		return nil, newError(
-			EConflict,
-			"cannot attach disk %s to VM %s, already attached to VM %s",
-			diskID,
-			vmID,
-			diskAttachment.VMID(),
-		)
+	if !disk.shareable {
+		for _, diskAttachment := range m.vmDiskAttachmentsByDisk[disk.ID()] {
+			return nil, newError(
+				EConflict,
+				"cannot attach disk %s to VM %s, already attached to VM %s and the disk is not shareable",
+				diskID,
+				vmID,
+				diskAttachment.VMID(),
+			)
+		}
 	}
 
-	m.vmDiskAttachmentsByDisk[disk.ID()] = attachment
-	m.vmDiskAttachmentsByVM[vm.ID()][attachment.ID()] = attachment
+	m.addVMDiskAttachment(attachment)
 
 	return attachment, nil
 }
+
+func validateCreateDiskAttachmentParams(diskInterface DiskInterface, params CreateDiskAttachmentOptionalParams) error {
+	if err := diskInterface.Validate(); err != nil {
+		return wrap(err, EBadArgument, "failed to create disk attachment")
+	}
+	if params == nil {
+		return nil
+	}
+	readOnly := params.ReadOnly() != nil && *params.ReadOnly()
+	passDiscard := params.PassDiscard() != nil && *params.PassDiscard()
+	usesSCSIReservation := params.UsesSCSIReservation() != nil && *params.UsesSCSIReservation()
+	return validateDiskAttachmentOptions(diskInterface, readOnly, passDiscard, usesSCSIReservation)
+}
+
+// checkNoOtherBootableDisk returns an error if the VM already has a bootable disk attachment other than the one with
+// the specified ID. The caller must hold the lock of the mock client.
+func (m *mockClient) checkNoOtherBootableDisk(vmID VMID, exceptID DiskAttachmentID) error {
+	for _, diskAttachment := range m.vmDiskAttachmentsByVM[vmID] {
+		if diskAttachment.id != exceptID && diskAttachment.bootable {
+			return newError(
+				EConflict,
+				"VM %s already has a bootable disk (attachment %s), only one disk can be bootable",
+				vmID,
+				diskAttachment.id,
+			)
+		}
+	}
+	return nil
+}
+
+// addVMDiskAttachment records a disk attachment both by VM and by disk. The caller must hold the lock of the mock
+// client.
+func (m *mockClient) addVMDiskAttachment(attachment *diskAttachment) {
+	if _, ok := m.vmDiskAttachmentsByVM[attachment.vmid]; !ok {
+		m.vmDiskAttachmentsByVM[attachment.vmid] = map[DiskAttachmentID]*diskAttachment{}
+	}
+	m.vmDiskAttachmentsByVM[attachment.vmid][attachment.id] = attachment
+	if _, ok := m.vmDiskAttachmentsByDisk[attachment.diskID]; !ok {
+		m.vmDiskAttachmentsByDisk[attachment.diskID] = map[DiskAttachmentID]*diskAttachment{}
+	}
+	m.vmDiskAttachmentsByDisk[attachment.diskID][attachment.id] = attachment
+}
@@ -38,8 +38,17 @@ func (m *mockClient) RemoveDiskAttachment(vmID VMID, diskAttachmentID DiskAttach
 		return newError(ENotFound, "Disk attachment %s not found on VM %s", diskAttachmentID, vmID)
 	}
 
-	delete(m.vmDiskAttachmentsByDisk, diskAttachment.DiskID())
-	delete(m.vmDiskAttachmentsByVM[vmID], diskAttachmentID)
+	m.removeVMDiskAttachment(diskAttachment)
 
 	return nil
 }
+
+// removeVMDiskAttachment removes a disk attachment from both the by VM and the by disk records. The caller must hold
+// the lock of the mock client.
+func (m *mockClient) removeVMDiskAttachment(attachment *diskAttachment) {
+	delete(m.vmDiskAttachmentsByVM[attachment.vmid], attachment.id)
+	delete(m.vmDiskAttachmentsByDisk[attachment.diskID], attachment.id)
+	if len(m.vmDiskAttachmentsByDisk[attachment.diskID]) == 0 {
+		delete(m.vmDiskAttachmentsByDisk, attachment.diskID)
+	}
+}
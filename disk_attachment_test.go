@@ -55,6 +55,158 @@ func TestDiskAttachmentCannotBeAttachedToSecondVM(t *testing.T) {
 	assertCannotAttachDisk(t, vm2, disk, ovirtclient.EConflict)
 }
 
+func TestShareableDiskCanBeAttachedToMultipleVMs(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm1 := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("disk_attachment_test_%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams(),
+	)
+	vm2 := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("disk_attachment_test_%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams(),
+	)
+	disk := assertCanCreateDiskWithParameters(
+		t,
+		helper,
+		ovirtclient.ImageFormatRaw,
+		ovirtclient.CreateDiskParams().MustWithShareable(true).MustWithSparse(false),
+	)
+	if !disk.Shareable() {
+		t.Fatalf("The disk was not created as shareable.")
+	}
+	attachment1 := assertCanAttachDisk(t, vm1, disk)
+	attachment2 := assertCanAttachDisk(t, vm2, disk)
+	assertDiskAttachmentMatches(t, attachment1, disk, vm1)
+	assertDiskAttachmentMatches(t, attachment2, disk, vm2)
+	assertCanDetachDisk(t, attachment1)
+	assertCanDetachDisk(t, attachment2)
+}
+
+func TestDiskAttachmentOptionsAndUpdate(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("disk_attachment_test_%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams(),
+	)
+	disk := assertCanCreateDisk(t, helper)
+	attachment, err := vm.AttachDisk(
+		disk.ID(),
+		ovirtclient.DiskInterfaceVirtIOSCSI,
+		ovirtclient.CreateDiskAttachmentParams().
+			MustWithReadOnly(true).
+			MustWithPassDiscard(true),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create disk attachment (%v)", err)
+	}
+	if !attachment.ReadOnly() || !attachment.PassDiscard() || attachment.UsesSCSIReservation() {
+		t.Fatalf("Incorrect options on newly created disk attachment.")
+	}
+
+	updatedAttachment, err := attachment.Update(
+		ovirtclient.UpdateDiskAttachmentParams().
+			MustWithDiskInterface(ovirtclient.DiskInterfaceVirtIO).
+			MustWithBootable(true).
+			MustWithActive(true),
+	)
+	if err != nil {
+		t.Fatalf("Failed to update disk attachment %s (%v)", attachment.ID(), err)
+	}
+	if updatedAttachment.DiskInterface() != ovirtclient.DiskInterfaceVirtIO {
+		t.Fatalf(
+			"Incorrect disk interface after update (%s != %s)",
+			updatedAttachment.DiskInterface(),
+			ovirtclient.DiskInterfaceVirtIO,
+		)
+	}
+	if !updatedAttachment.Bootable() || !updatedAttachment.Active() {
+		t.Fatalf("Incorrect value for 'bootable' or 'active' after update.")
+	}
+	if !updatedAttachment.ReadOnly() {
+		t.Fatalf("The read-only flag was lost during the update.")
+	}
+}
+
+func TestDiskAttachmentOnlyOneBootableDisk(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("disk_attachment_test_%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams(),
+	)
+	disk1 := assertCanCreateDisk(t, helper)
+	disk2 := assertCanCreateDisk(t, helper)
+	_ = assertCanAttachDiskWithParams(t, vm, disk1, ovirtclient.CreateDiskAttachmentParams().MustWithBootable(true))
+	attachment2 := assertCanAttachDisk(t, vm, disk2)
+
+	_, err := attachment2.Update(ovirtclient.UpdateDiskAttachmentParams().MustWithBootable(true), ovirtclient.MaxTries(1))
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Making a second disk bootable did not result in an EConflict error (%v)", err)
+	}
+}
+
+func TestDiskAttachmentInterfaceCannotChangeWhileVMIsUp(t *testing.T) {
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("disk_attachment_test_%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams(),
+	)
+	disk := assertCanCreateDisk(t, helper)
+	attachment := assertCanAttachDiskWithParams(
+		t,
+		vm,
+		disk,
+		ovirtclient.CreateDiskAttachmentParams().MustWithBootable(true).MustWithActive(true),
+	)
+	assertCanStartVM(t, helper, vm)
+	assertVMWillStart(t, vm)
+
+	_, err := attachment.Update(
+		ovirtclient.UpdateDiskAttachmentParams().MustWithDiskInterface(ovirtclient.DiskInterfaceSATA),
+		ovirtclient.MaxTries(1),
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Changing the disk interface of a running VM did not result in an EConflict error (%v)", err)
+	}
+}
+
+func TestDiskAttachmentRejectsSCSIReservationWithoutVirtIOSCSI(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("disk_attachment_test_%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams(),
+	)
+	disk := assertCanCreateDisk(t, helper)
+	_, err := vm.AttachDisk(
+		disk.ID(),
+		ovirtclient.DiskInterfaceVirtIO,
+		ovirtclient.CreateDiskAttachmentParams().MustWithUsesSCSIReservation(true),
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Using SCSI reservations on a VirtIO disk did not result in an EBadArgument error (%v)", err)
+	}
+}
+
 func assertCanCreateDisk(t *testing.T, helper ovirtclient.TestHelper) ovirtclient.Disk {
 	return assertCanCreateDiskWithParameters(t, helper, ovirtclient.ImageFormatRaw, nil)
 }
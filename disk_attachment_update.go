@@ -0,0 +1,125 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) UpdateDiskAttachment(
+	vmID VMID,
+	id DiskAttachmentID,
+	params UpdateDiskAttachmentParameters,
+	retries ...RetryStrategy,
+) (result DiskAttachment, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if params == nil {
+		return nil, newError(EBadArgument, "the parameters for updating a disk attachment must not be nil")
+	}
+	attachmentBuilder := ovirtsdk.NewDiskAttachmentBuilder()
+	if diskInterface := params.DiskInterface(); diskInterface != nil {
+		attachmentBuilder.Interface(ovirtsdk.DiskInterface(*diskInterface))
+	}
+	if bootable := params.Bootable(); bootable != nil {
+		attachmentBuilder.Bootable(*bootable)
+	}
+	if active := params.Active(); active != nil {
+		attachmentBuilder.Active(*active)
+	}
+	attachment, err := attachmentBuilder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build disk attachment")
+	}
+	err = retry(
+		fmt.Sprintf("updating disk attachment %s on VM %s", id, vmID),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().VmsService().VmService(string(vmID)).DiskAttachmentsService().
+				AttachmentService(string(id)).
+				Update().
+				DiskAttachment(attachment).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.DiskAttachment()
+			if !ok {
+				return newFieldNotFound("update disk attachment response", "disk attachment")
+			}
+			result, err = convertSDKDiskAttachment(sdkObject, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert disk attachment %s", id)
+			}
+			return nil
+		},
+	)
+	return result, err
+}
+
+func (m *mockClient) UpdateDiskAttachment(
+	vmID VMID,
+	id DiskAttachmentID,
+	params UpdateDiskAttachmentParameters,
+	retries ...RetryStrategy,
+) (result DiskAttachment, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if params == nil {
+		return nil, newError(EBadArgument, "the parameters for updating a disk attachment must not be nil")
+	}
+	err = retry(
+		fmt.Sprintf("updating disk attachment %s on VM %s", id, vmID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			vm, ok := m.vms[vmID]
+			if !ok {
+				return newError(ENotFound, "VM with ID %s not found", vmID)
+			}
+			existing, ok := m.vmDiskAttachmentsByVM[vmID][id]
+			if !ok {
+				return newError(ENotFound, "disk attachment %s not found on VM %s", id, vmID)
+			}
+			attachment := existing.clone()
+			if diskInterface := params.DiskInterface(); diskInterface != nil && *diskInterface != attachment.diskInterface {
+				if vm.status != VMStatusDown {
+					return newError(
+						EConflict,
+						"the interface of disk attachment %s cannot be changed while VM %s is in status %s",
+						id,
+						vmID,
+						vm.status,
+					)
+				}
+				attachment.diskInterface = *diskInterface
+			}
+			if bootable := params.Bootable(); bootable != nil {
+				attachment.bootable = *bootable
+			}
+			if active := params.Active(); active != nil {
+				attachment.active = *active
+			}
+			if err := validateDiskAttachmentOptions(
+				attachment.diskInterface,
+				attachment.readOnly,
+				attachment.passDiscard,
+				attachment.usesSCSIReservation,
+			); err != nil {
+				return err
+			}
+			if attachment.bootable {
+				if err := m.checkNoOtherBootableDisk(vmID, id); err != nil {
+					return err
+				}
+			}
+			m.removeVMDiskAttachment(existing)
+			m.addVMDiskAttachment(attachment)
+			result = attachment
+			return nil
+		})
+	return
+}
@@ -0,0 +1,152 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"net/url"
+	"sync"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) CopyDisk(
+	diskID DiskID,
+	storageDomainID StorageDomainID,
+	alias string,
+	retries ...RetryStrategy,
+) (Disk, error) {
+	progress, err := o.StartCopyDisk(diskID, storageDomainID, alias, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return progress.Wait(retries...)
+}
+
+func (o *oVirtClient) StartCopyDisk(
+	diskID DiskID,
+	storageDomainID StorageDomainID,
+	alias string,
+	retries ...RetryStrategy,
+) (DiskCreation, error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if err := validateDiskCopyAlias(alias); err != nil {
+		return nil, err
+	}
+	correlationID := fmt.Sprintf("disk_copy_%s", generateRandomID(5, o.nonSecureRandom))
+	var newDiskID string
+	err := retry(
+		fmt.Sprintf("copying disk %s to storage domain %s", diskID, storageDomainID),
+		o.logger,
+		retries,
+		func() error {
+			// The SDK discards the response of the copy action, but it is the only reliable way to learn the ID
+			// of the new disk, so we send the action ourselves.
+			response, err := o.sendSDKAction(
+				fmt.Sprintf("/disks/%s/copy", diskID),
+				ovirtsdk.NewActionBuilder().
+					StorageDomain(ovirtsdk.NewStorageDomainBuilder().Id(string(storageDomainID)).MustBuild()).
+					Disk(ovirtsdk.NewDiskBuilder().Alias(alias).MustBuild()).
+					MustBuild(),
+				url.Values{"correlation_id": []string{correlationID}},
+			)
+			if err != nil {
+				return err
+			}
+			sdkDisk, ok := response.Disk()
+			if !ok {
+				return newFieldNotFound("copy action response", "disk")
+			}
+			newDiskID, ok = sdkDisk.Id()
+			if !ok {
+				return newFieldNotFound("disk in the copy action response", "ID")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	newDisk, err := o.GetDisk(DiskID(newDiskID), retries...)
+	if err != nil {
+		return nil, err
+	}
+	return &diskWait{
+		client:        o,
+		disk:          newDisk,
+		correlationID: correlationID,
+		lock:          &sync.Mutex{},
+	}, nil
+}
+
+func (m *mockClient) CopyDisk(
+	diskID DiskID,
+	storageDomainID StorageDomainID,
+	alias string,
+	retries ...RetryStrategy,
+) (Disk, error) {
+	progress, err := m.StartCopyDisk(diskID, storageDomainID, alias, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return progress.Wait(retries...)
+}
+
+func (m *mockClient) StartCopyDisk(
+	diskID DiskID,
+	storageDomainID StorageDomainID,
+	alias string,
+	retries ...RetryStrategy,
+) (result DiskCreation, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if err := validateDiskCopyAlias(alias); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("copying disk %s to storage domain %s", diskID, storageDomainID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			source, ok := m.disks[diskID]
+			if !ok {
+				return newError(ENotFound, "disk with ID %s not found", diskID)
+			}
+			if err := source.checkImageDisk("copy"); err != nil {
+				return err
+			}
+			if err := m.checkDiskNotInUse(diskID); err != nil {
+				return err
+			}
+			if err := m.checkStorageDomainSpace(storageDomainID, source.totalSize); err != nil {
+				return err
+			}
+			if err := source.Lock(); err != nil {
+				return err
+			}
+			newDisk := source.clone(nil)
+			newDisk.alias = alias
+			newDisk.storageDomainIDs = []StorageDomainID{storageDomainID}
+			newDisk.status = DiskStatusLocked
+			if profile := m.getDefaultDiskProfile(storageDomainID); profile != nil {
+				newDisk.diskProfileID = profile.id
+			}
+			m.disks[newDisk.id] = newDisk
+			m.adjustStorageDomainAvailable(storageDomainID, -int64(newDisk.totalSize))
+
+			result = m.startMockDiskOperation(newDisk, func() *diskWithData {
+				source.Unlock()
+				return newDisk
+			})
+			return nil
+		})
+	return
+}
+
+func validateDiskCopyAlias(alias string) error {
+	if alias == "" {
+		return newError(EBadArgument, "the alias of the disk copy must not be empty")
+	}
+	return nil
+}
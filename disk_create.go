@@ -16,7 +16,7 @@ func (o *oVirtClient) StartCreateDisk(
 ) (DiskCreation, error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
 
-	if err := validateDiskCreationParameters(format, size); err != nil {
+	if err := validateDiskCreationParameters(format, size, params); err != nil {
 		return nil, err
 	}
 
@@ -63,11 +63,23 @@ func (o *oVirtClient) StartCreateDisk(
 	return result, nil
 }
 
-func validateDiskCreationParameters(format ImageFormat, size uint64) error {
+func validateDiskCreationParameters(format ImageFormat, size uint64, params CreateDiskOptionalParameters) error {
 	if err := format.Validate(); err != nil {
 		return err
 	}
-	return validateDiskSize(size)
+	if err := validateDiskSize(size); err != nil {
+		return err
+	}
+	if params == nil {
+		return nil
+	}
+	if shareable := params.Shareable(); shareable != nil && *shareable && format != ImageFormatRaw {
+		return newError(EBadArgument, "shareable disks must be in the %s format", ImageFormatRaw)
+	}
+	if backup := params.Backup(); backup != nil && *backup == DiskBackupIncremental && format != ImageFormatCow {
+		return newError(EBadArgument, "incremental backups require the disk to be in the %s format", ImageFormatCow)
+	}
+	return nil
 }
 
 func validateDiskSize(size uint64) error {
@@ -130,6 +142,24 @@ func (o *oVirtClient) buildDiskObjectForCreation(
 		if initialSize := params.InitialSize(); initialSize != nil {
 			diskBuilder.InitialSize(int64(*initialSize))
 		}
+		if description := params.Description(); description != nil {
+			diskBuilder.Description(*description)
+		}
+		if shareable := params.Shareable(); shareable != nil {
+			diskBuilder.Shareable(*shareable)
+		}
+		if wipeAfterDelete := params.WipeAfterDelete(); wipeAfterDelete != nil {
+			diskBuilder.WipeAfterDelete(*wipeAfterDelete)
+		}
+		if diskProfileID := params.DiskProfileID(); diskProfileID != "" {
+			diskBuilder.DiskProfile(ovirtsdk4.NewDiskProfileBuilder().Id(string(diskProfileID)).MustBuild())
+		}
+		if backup := params.Backup(); backup != nil {
+			diskBuilder.Backup(ovirtsdk4.DiskBackup(*backup))
+		}
+		if contentType := params.ContentType(); contentType != nil {
+			diskBuilder.ContentType(ovirtsdk4.DiskContentType(*contentType))
+		}
 	}
 	return diskBuilder.Build()
 }
@@ -0,0 +1,190 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"sync"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) CreateLUNDisk(
+	storage LUNDiskStorageParameters,
+	params CreateDiskOptionalParameters,
+	retries ...RetryStrategy,
+) (result Disk, err error) {
+	if err := validateLUNDiskCreationParameters(storage, params); err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	sdkDisk, err := buildSDKLUNDisk(storage, params)
+	if err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("creating LUN disk for LUN %s", storage.LUNID()),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().DisksService().Add().Disk(sdkDisk).Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Disk()
+			if !ok {
+				return newError(EFieldMissing, "missing disk object from disk add response")
+			}
+			result, err = convertSDKDisk(sdkObject, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert LUN disk")
+			}
+			return nil
+		},
+	)
+	return result, err
+}
+
+func buildSDKLUNDisk(
+	storage LUNDiskStorageParameters,
+	params CreateDiskOptionalParameters,
+) (*ovirtsdk4.Disk, error) {
+	logicalUnit := ovirtsdk4.NewLogicalUnitBuilder().Id(storage.LUNID())
+	if storage.StorageType() == StorageDomainTypeISCSI {
+		logicalUnit.Address(storage.Address()).Port(int64(storage.Port())).Target(storage.Target())
+	}
+	diskBuilder := ovirtsdk4.NewDiskBuilder().
+		LunStorageBuilder(
+			ovirtsdk4.NewHostStorageBuilder().
+				Type(ovirtsdk4.StorageType(storage.StorageType())).
+				LogicalUnitsBuilderOfAny(*logicalUnit),
+		)
+	if params != nil {
+		if alias := params.Alias(); alias != "" {
+			diskBuilder.Alias(alias)
+		}
+		if description := params.Description(); description != nil {
+			diskBuilder.Description(*description)
+		}
+		if shareable := params.Shareable(); shareable != nil {
+			diskBuilder.Shareable(*shareable)
+		}
+	}
+	sdkDisk, err := diskBuilder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build LUN disk")
+	}
+	return sdkDisk, nil
+}
+
+func validateLUNDiskCreationParameters(storage LUNDiskStorageParameters, params CreateDiskOptionalParameters) error {
+	if storage == nil {
+		return newError(EBadArgument, "storage parameters are required for LUN disk creation")
+	}
+	switch storage.StorageType() {
+	case StorageDomainTypeISCSI, StorageDomainTypeFCP:
+	default:
+		return newError(EBadArgument, "unsupported storage type for LUN disk creation: %s", storage.StorageType())
+	}
+	if params == nil {
+		return nil
+	}
+	if params.Sparse() != nil || params.InitialSize() != nil || params.WipeAfterDelete() != nil ||
+		params.DiskProfileID() != "" || params.Backup() != nil || params.ContentType() != nil {
+		return newError(
+			EBadArgument,
+			"only the alias, description and shareable parameters can be set for LUN disks",
+		)
+	}
+	return nil
+}
+
+func (m *mockClient) CreateLUNDisk(
+	storage LUNDiskStorageParameters,
+	params CreateDiskOptionalParameters,
+	retries ...RetryStrategy,
+) (result Disk, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if err := validateLUNDiskCreationParameters(storage, params); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("creating LUN disk for LUN %s", storage.LUNID()),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			l, err := m.findLUNForDisk(storage)
+			if err != nil {
+				return err
+			}
+
+			d := &diskWithData{
+				disk: disk{
+					client:          m,
+					id:              DiskID(m.GenerateUUID()),
+					format:          ImageFormatRaw,
+					provisionedSize: l.size,
+					status:          DiskStatusOK,
+					backup:          DiskBackupNone,
+					contentType:     DiskContentTypeData,
+				},
+				lock:    &sync.Mutex{},
+				changes: newMockDiskChanges(),
+			}
+			if params != nil {
+				d.alias = params.Alias()
+				if description := params.Description(); description != nil {
+					d.description = *description
+				}
+				if shareable := params.Shareable(); shareable != nil {
+					d.shareable = *shareable
+				}
+			}
+			d.lunStorage = l.clone()
+			d.lunStorage.diskID = d.id
+			m.disks[d.id] = d
+			result = d
+			return nil
+		})
+	return
+}
+
+// findLUNForDisk looks up the LUN matching the storage parameters in the LUN inventory of the mock and checks that
+// it is not yet in use. The caller must hold the lock of the mock client.
+func (m *mockClient) findLUNForDisk(storage LUNDiskStorageParameters) (*lun, error) {
+	for _, l := range m.luns {
+		if l.id != storage.LUNID() {
+			continue
+		}
+		if l.storageType != storage.StorageType() {
+			return nil, newError(
+				EBadArgument,
+				"LUN %s is on %s storage, not %s",
+				l.id,
+				l.storageType,
+				storage.StorageType(),
+			)
+		}
+		if l.storageType == StorageDomainTypeISCSI &&
+			(l.address != storage.Address() || l.port != storage.Port() || l.target != storage.Target()) {
+			return nil, newError(
+				ENotFound,
+				"LUN %s is not exposed by iSCSI target %s on %s:%d",
+				l.id,
+				storage.Target(),
+				storage.Address(),
+				storage.Port(),
+			)
+		}
+		if d := m.findLUNDisk(l.id); d != nil {
+			return nil, newError(EConflict, "LUN %s is already used by disk %s", l.id, d.id)
+		}
+		if l.storageDomainID != "" {
+			return nil, newError(EConflict, "LUN %s is already used by storage domain %s", l.id, l.storageDomainID)
+		}
+		return l, nil
+	}
+	return nil, newError(ENotFound, "LUN with ID %s not found", storage.LUNID())
+}
@@ -35,7 +35,7 @@ func (m *mockClient) createDisk(
 	size uint64,
 	params CreateDiskOptionalParameters,
 ) (*diskWithData, error) {
-	if err := validateDiskCreationParameters(format, size); err != nil {
+	if err := validateDiskCreationParameters(format, size, params); err != nil {
 		return nil, err
 	}
 
@@ -52,9 +52,12 @@ func (m *mockClient) createDisk(
 			totalSize:        size,
 			storageDomainIDs: []StorageDomainID{storageDomainID},
 			status:           DiskStatusLocked,
+			backup:           DiskBackupNone,
+			contentType:      DiskContentTypeData,
 		},
-		lock: &sync.Mutex{},
-		data: nil,
+		lock:    &sync.Mutex{},
+		data:    nil,
+		changes: newMockDiskChanges(),
 	}
 
 	if params != nil {
@@ -64,6 +67,39 @@ func (m *mockClient) createDisk(
 		if sparse := params.Sparse(); sparse != nil {
 			disk.disk.sparse = *sparse
 		}
+		if description := params.Description(); description != nil {
+			disk.disk.description = *description
+		}
+		if shareable := params.Shareable(); shareable != nil {
+			disk.disk.shareable = *shareable
+		}
+		if wipeAfterDelete := params.WipeAfterDelete(); wipeAfterDelete != nil {
+			disk.disk.wipeAfterDelete = *wipeAfterDelete
+		}
+		if diskProfileID := params.DiskProfileID(); diskProfileID != "" {
+			disk.disk.diskProfileID = diskProfileID
+		}
+		if backup := params.Backup(); backup != nil {
+			disk.disk.backup = *backup
+		}
+		if contentType := params.ContentType(); contentType != nil {
+			disk.disk.contentType = *contentType
+		}
+	}
+	if disk.disk.diskProfileID == "" {
+		if profile := m.getDefaultDiskProfile(storageDomainID); profile != nil {
+			disk.disk.diskProfileID = profile.id
+		}
+	} else if profile, ok := m.diskProfiles[disk.disk.diskProfileID]; !ok {
+		return nil, newError(ENotFound, "disk profile with ID %s not found", disk.disk.diskProfileID)
+	} else if profile.storageDomainID != storageDomainID {
+		return nil, newError(
+			EBadArgument,
+			"disk profile %s belongs to storage domain %s, not %s",
+			profile.id,
+			profile.storageDomainID,
+			storageDomainID,
+		)
 	}
 
 	m.disks[disk.id] = disk
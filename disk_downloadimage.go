@@ -283,6 +283,33 @@ func (i *imageDownload) Size() uint64 {
 	return i.size
 }
 
+// WriteTo copies the image to the writer and closes the download.
+func (i *imageDownload) WriteTo(w io.Writer) (int64, error) {
+	// We hide the WriteTo function from io.Copy to avoid an endless recursion.
+	n, err := io.Copy(w, readerOnly{i})
+	if closeErr := i.Close(); err == nil {
+		err = closeErr
+	}
+	return n, err
+}
+
+// Extents returns the whole image as a single data extent.
+func (i *imageDownload) Extents() []ImageExtent {
+	<-i.done
+	return []ImageExtent{
+		{
+			Start:  0,
+			Length: i.size,
+			Zero:   false,
+		},
+	}
+}
+
+// readerOnly hides all functions of a reader except Read.
+type readerOnly struct {
+	io.Reader
+}
+
 // Deprecated: use StartDownloadDisk instead.
 func (m *mockClient) StartImageDownload(diskID DiskID, format ImageFormat, retries ...RetryStrategy) (
 	ImageDownload,
@@ -402,6 +429,25 @@ func (m *mockImageDownload) Size() uint64 {
 	return m.size
 }
 
+func (m *mockImageDownload) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.Copy(w, readerOnly{m})
+	if closeErr := m.Close(); err == nil {
+		err = closeErr
+	}
+	return n, err
+}
+
+func (m *mockImageDownload) Extents() []ImageExtent {
+	<-m.done
+	return []ImageExtent{
+		{
+			Start:  0,
+			Length: m.Size(),
+			Zero:   false,
+		},
+	}
+}
+
 func (m *mockImageDownload) prepare() {
 	// Sleep one second to trigger possible race condition with determining size.
 	time.Sleep(time.Second)
@@ -0,0 +1,779 @@
+package ovirtclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) StartSparseDownloadDisk(
+	diskID DiskID,
+	format ImageFormat,
+	params SparseDownloadParameters,
+	retries ...RetryStrategy,
+) (ImageDownload, error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	if err := validateSparseDownload(format, params); err != nil {
+		return nil, err
+	}
+
+	o.logger.Infof("Starting sparse disk %s image download...", diskID)
+	disk, err := o.GetDisk(diskID, retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to fetch disk for sparse image download")
+	}
+
+	source := &oVirtSparseDownloadSource{
+		cli:        o,
+		logger:     o.logger,
+		retries:    retries,
+		httpClient: o.httpClient,
+		disk:       disk,
+		format:     format,
+	}
+	return newSparseImageDownload(source, params, false), nil
+}
+
+func (o *oVirtClient) SparseDownloadDisk(
+	diskID DiskID,
+	format ImageFormat,
+	params SparseDownloadParameters,
+	retries ...RetryStrategy,
+) (ImageDownloadReader, error) {
+	download, err := o.StartSparseDownloadDisk(diskID, format, params, retries...)
+	if err != nil {
+		return nil, err
+	}
+	<-download.Initialized()
+	if err := download.Err(); err != nil {
+		_ = download.Close()
+		return nil, err
+	}
+	return download, nil
+}
+
+func (m *mockClient) StartSparseDownloadDisk(
+	diskID DiskID,
+	format ImageFormat,
+	params SparseDownloadParameters,
+	retries ...RetryStrategy,
+) (result ImageDownload, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if err := validateSparseDownload(format, params); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("starting sparse download of disk %s", diskID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			disk, ok := m.disks[diskID]
+			if !ok {
+				return newError(ENotFound, "disk with ID %s not found", diskID)
+			}
+			if err := disk.Lock(); err != nil {
+				return err
+			}
+
+			disk.lock.Lock()
+			data := disk.data
+			disk.lock.Unlock()
+			source := &mockSparseDownloadSource{
+				data:    data,
+				release: disk.Unlock,
+			}
+			result = newSparseImageDownload(source, params, false)
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) SparseDownloadDisk(
+	diskID DiskID,
+	format ImageFormat,
+	params SparseDownloadParameters,
+	retries ...RetryStrategy,
+) (ImageDownloadReader, error) {
+	download, err := m.StartSparseDownloadDisk(diskID, format, params, retries...)
+	if err != nil {
+		return nil, err
+	}
+	<-download.Initialized()
+	if err := download.Err(); err != nil {
+		_ = download.Close()
+		return nil, err
+	}
+	return download, nil
+}
+
+func validateSparseDownload(format ImageFormat, params SparseDownloadParameters) error {
+	if format != ImageFormatRaw {
+		return newError(
+			EBadArgument,
+			"sparse downloads are only supported in the %s format, %s was requested",
+			ImageFormatRaw,
+			format,
+		)
+	}
+	if params == nil {
+		return newError(EBadArgument, "sparse download parameters are required")
+	}
+	if params.ChunkSize() == 0 {
+		return newError(EBadArgument, "chunk size must be positive")
+	}
+	if params.Connections() == 0 {
+		return newError(EBadArgument, "at least one connection is required")
+	}
+	return nil
+}
+
+// sparseDownloadSource is the backend of a sparse image download. It provides the extents of the image and the data
+// for individual ranges.
+type sparseDownloadSource interface {
+	// initialize prepares the source for downloading and returns the extents of the image.
+	initialize() ([]ImageExtent, error)
+	// fetch returns a reader for the specified range of the image.
+	fetch(ctx context.Context, start uint64, length uint64) (io.ReadCloser, error)
+	// finalize releases all resources held by the source. If err is passed, the download is considered failed.
+	finalize(err error) error
+}
+
+// newSparseImageDownload creates a sparse download from the source. If incremental is true, only the dirty extents
+// are downloaded.
+func newSparseImageDownload(
+	source sparseDownloadSource,
+	params SparseDownloadParameters,
+	incremental bool,
+) *sparseImageDownload {
+	ctx, cancel := context.WithCancel(context.Background())
+	dl := &sparseImageDownload{
+		lock:        &sync.Mutex{},
+		source:      source,
+		ctx:         ctx,
+		cancel:      cancel,
+		done:        make(chan struct{}),
+		connections: params.Connections(),
+		chunkSize:   params.ChunkSize(),
+		incremental: incremental,
+	}
+	go dl.prepare()
+	return dl
+}
+
+// sparseImageDownload implements ImageDownload by only fetching the data extents from its source and filling in the
+// zero extents locally.
+type sparseImageDownload struct {
+	lock   *sync.Mutex
+	source sparseDownloadSource
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	connections uint
+	chunkSize   uint64
+	// incremental indicates that only dirty extents should be written, as the target contains the previous backup.
+	incremental bool
+
+	extents   []ImageExtent
+	size      uint64
+	bytesRead uint64
+	lastError error
+	closed    bool
+
+	// position is the offset of the next byte returned by Read.
+	position uint64
+	// current is the reader of the data range currently being read, ending at currentEnd.
+	current    io.ReadCloser
+	currentEnd uint64
+}
+
+func (s *sparseImageDownload) prepare() {
+	defer close(s.done)
+	extents, err := s.source.initialize()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err != nil {
+		s.lastError = s.source.finalize(err)
+		s.closed = true
+		return
+	}
+	s.extents = extents
+	for _, extent := range extents {
+		if end := extent.Start + extent.Length; end > s.size {
+			s.size = end
+		}
+	}
+}
+
+func (s *sparseImageDownload) Err() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.lastError
+}
+
+func (s *sparseImageDownload) Initialized() <-chan struct{} {
+	return s.done
+}
+
+func (s *sparseImageDownload) BytesRead() uint64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.bytesRead
+}
+
+func (s *sparseImageDownload) Size() uint64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.size
+}
+
+func (s *sparseImageDownload) Extents() []ImageExtent {
+	<-s.done
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	result := make([]ImageExtent, len(s.extents))
+	copy(result, s.extents)
+	return result
+}
+
+// Read returns the image data, filling in zero extents locally. When the end of the image is reached the download is
+// closed automatically.
+//
+// Incremental backups cannot be read as a byte stream since the regions not changed since the previous backup would be
+// returned as zeros. Read returns an EBadArgument error in this case, use WriteTo with a seekable target instead.
+func (s *sparseImageDownload) Read(p []byte) (int, error) {
+	<-s.done
+	if err := s.Err(); err != nil {
+		return 0, err
+	}
+	if s.incremental {
+		return 0, newError(
+			EBadArgument,
+			"incremental backups cannot be read as a byte stream, use WriteTo with an io.WriterAt or io.WriteSeeker",
+		)
+	}
+	if s.position >= s.size {
+		_ = s.Close()
+		return 0, io.EOF
+	}
+	extent := s.extentAt(s.position)
+	remaining := extent.Start + extent.Length - s.position
+	if uint64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+	var n int
+	var err error
+	if s.needsData(extent) {
+		n, err = s.readData(p, extent.Start+extent.Length)
+	} else {
+		for i := range p {
+			p[i] = 0
+		}
+		n = len(p)
+	}
+	s.position += uint64(n)
+	s.addBytesRead(uint64(n))
+	if err != nil {
+		s.fail(err)
+		return n, err
+	}
+	return n, nil
+}
+
+// readData reads from the data range starting at the current position, opening a new range if needed.
+func (s *sparseImageDownload) readData(p []byte, extentEnd uint64) (int, error) {
+	if s.current == nil {
+		end := extentEnd
+		if s.chunkSize < end-s.position {
+			end = s.position + s.chunkSize
+		}
+		reader, err := s.source.fetch(s.ctx, s.position, end-s.position)
+		if err != nil {
+			return 0, err
+		}
+		s.current = reader
+		s.currentEnd = end
+	}
+	if uint64(len(p)) > s.currentEnd-s.position {
+		p = p[:s.currentEnd-s.position]
+	}
+	n, err := io.ReadFull(s.current, p)
+	if err != nil {
+		return n, wrap(err, EConnection, "failed to read image data at offset %d", s.position+uint64(n))
+	}
+	if s.position+uint64(n) == s.currentEnd {
+		err = s.current.Close()
+		s.current = nil
+		if err != nil {
+			return n, wrap(err, EConnection, "failed to close image data reader")
+		}
+	}
+	return n, nil
+}
+
+// needsData returns true if the data of the extent must be fetched from the source.
+func (s *sparseImageDownload) needsData(extent ImageExtent) bool {
+	return !extent.Zero && (!s.incremental || extent.Dirty)
+}
+
+// needsZeros returns true if the extent must be explicitly overwritten with zeros because it was changed to zeros
+// since the previous backup.
+func (s *sparseImageDownload) needsZeros(extent ImageExtent) bool {
+	return s.incremental && extent.Dirty && extent.Zero
+}
+
+// extentAt returns the extent containing the specified offset. Offsets not covered by an extent are treated as zero.
+func (s *sparseImageDownload) extentAt(offset uint64) ImageExtent {
+	next := s.size
+	for _, extent := range s.extents {
+		if offset >= extent.Start && offset < extent.Start+extent.Length {
+			return extent
+		}
+		if extent.Start > offset && extent.Start < next {
+			next = extent.Start
+		}
+	}
+	return ImageExtent{Start: offset, Length: next - offset, Zero: true}
+}
+
+// WriteTo writes the image to the writer. Zero extents are skipped if the writer is an io.WriterAt or an io.Seeker,
+// and data extents are fetched in parallel if the writer is an io.WriterAt. If the writer supports truncation, such as
+// an *os.File, it is truncated to the image size to account for a trailing zero extent.
+//
+// For incremental backups only the dirty extents are written, so the writer must contain the previous backup and
+// must be an io.WriterAt or an io.WriteSeeker. Otherwise, an EBadArgument error is returned and the download is left
+// open, so it can be written to a different writer or closed.
+func (s *sparseImageDownload) WriteTo(w io.Writer) (int64, error) {
+	<-s.done
+	if err := s.Err(); err != nil {
+		return 0, err
+	}
+	if s.incremental && !isSeekableWriter(w) {
+		return 0, newError(
+			EBadArgument,
+			"incremental backups can only be written to an io.WriterAt or io.WriteSeeker containing the previous backup",
+		)
+	}
+	if s.position != 0 {
+		return 0, newError(EConflict, "WriteTo cannot be used after Read has been called")
+	}
+
+	var err error
+	switch writer := w.(type) {
+	case io.WriterAt:
+		err = s.writeToWriterAt(writer)
+	case io.WriteSeeker:
+		err = s.writeToWriteSeeker(writer)
+	default:
+		// We hide the WriteTo function from io.Copy to avoid an endless recursion.
+		_, err = io.Copy(w, readerOnly{s})
+	}
+	if err == nil {
+		err = truncateSparseWriter(w, s.size)
+	}
+	if err != nil {
+		s.fail(err)
+		return int64(s.BytesRead()), err
+	}
+	return int64(s.size), s.Close()
+}
+
+// isSeekableWriter returns true if the writer can skip regions of the image instead of writing them.
+func isSeekableWriter(w io.Writer) bool {
+	switch w.(type) {
+	case io.WriterAt:
+		return true
+	case io.WriteSeeker:
+		return true
+	default:
+		return false
+	}
+}
+
+// writeToWriterAt fetches all data extents in chunks using the configured number of connections and writes them to
+// their offsets.
+func (s *sparseImageDownload) writeToWriterAt(w io.WriterAt) error {
+	var ranges []ImageExtent
+	for _, extent := range s.extents {
+		if s.needsZeros(extent) {
+			if err := writeZeros(&offsetWriter{w: w, offset: int64(extent.Start)}, extent.Length); err != nil {
+				return wrap(err, EUnidentified, "failed to write zero extent at offset %d", extent.Start)
+			}
+		}
+		if !s.needsData(extent) {
+			s.addBytesRead(extent.Length)
+			continue
+		}
+		for start := extent.Start; start < extent.Start+extent.Length; start += s.chunkSize {
+			length := extent.Start + extent.Length - start
+			if length > s.chunkSize {
+				length = s.chunkSize
+			}
+			ranges = append(ranges, ImageExtent{Start: start, Length: length})
+		}
+	}
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+	work := make(chan ImageExtent)
+	errs := make(chan error, s.connections)
+	wg := &sync.WaitGroup{}
+	for i := uint(0); i < s.connections; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range work {
+				if err := s.copyRange(ctx, w, r); err != nil {
+					errs <- err
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+	for _, r := range ranges {
+		select {
+		case work <- r:
+		case <-ctx.Done():
+		}
+	}
+	close(work)
+	wg.Wait()
+	close(errs)
+	if err := <-errs; err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil && s.ctx.Err() != nil {
+		return wrap(err, ETimeout, "sparse image download cancelled")
+	}
+	return nil
+}
+
+// copyRange fetches a single range and writes it to the corresponding offset of the writer.
+func (s *sparseImageDownload) copyRange(ctx context.Context, w io.WriterAt, r ImageExtent) error {
+	reader, err := s.source.fetch(ctx, r.Start, r.Length)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+	n, err := io.Copy(&offsetWriter{w: w, offset: int64(r.Start)}, reader)
+	s.addBytesRead(uint64(n))
+	if err != nil {
+		return wrap(err, EConnection, "failed to download image range at offset %d", r.Start)
+	}
+	if uint64(n) != r.Length {
+		return newError(
+			EConnection,
+			"image range at offset %d was truncated (expected: %d bytes, got: %d bytes)",
+			r.Start,
+			r.Length,
+			n,
+		)
+	}
+	return nil
+}
+
+// writeToWriteSeeker writes the data extents sequentially and seeks over the extents that need not be written.
+func (s *sparseImageDownload) writeToWriteSeeker(w io.WriteSeeker) error {
+	for _, extent := range s.extents {
+		if s.needsZeros(extent) {
+			if err := writeZeros(w, extent.Length); err != nil {
+				return wrap(err, EUnidentified, "failed to write zero extent at offset %d", extent.Start)
+			}
+			s.addBytesRead(extent.Length)
+			continue
+		}
+		if !s.needsData(extent) {
+			if _, err := w.Seek(int64(extent.Length), io.SeekCurrent); err != nil {
+				return wrap(err, EUnidentified, "failed to skip zero extent at offset %d", extent.Start)
+			}
+			s.addBytesRead(extent.Length)
+			continue
+		}
+		if err := s.copyRange(s.ctx, &offsetWriterAt{w: w}, extent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeZeros writes the specified number of zero bytes to the writer.
+func writeZeros(w io.Writer, length uint64) error {
+	zeros := make([]byte, 64*1024)
+	for length > 0 {
+		chunk := zeros
+		if uint64(len(chunk)) > length {
+			chunk = chunk[:length]
+		}
+		n, err := w.Write(chunk)
+		if err != nil {
+			return err
+		}
+		length -= uint64(n)
+	}
+	return nil
+}
+
+// truncateSparseWriter extends the writer to the specified size if it supports truncation. This is needed when the
+// image ends with a zero extent that was skipped.
+func truncateSparseWriter(w io.Writer, size uint64) error {
+	truncater, ok := w.(interface{ Truncate(size int64) error })
+	if !ok {
+		return nil
+	}
+	if err := truncater.Truncate(int64(size)); err != nil {
+		return wrap(err, EUnidentified, "failed to truncate download target to %d bytes", size)
+	}
+	return nil
+}
+
+func (s *sparseImageDownload) addBytesRead(n uint64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.bytesRead += n
+}
+
+// fail records the error and finalizes the download as failed.
+func (s *sparseImageDownload) fail(err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.closed {
+		return
+	}
+	s.closed = true
+	s.cancel()
+	s.closeCurrent()
+	s.lastError = s.source.finalize(err)
+}
+
+// Close finalizes the download. This is important so the disk does not stay locked.
+func (s *sparseImageDownload) Close() error {
+	<-s.done
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	s.cancel()
+	s.closeCurrent()
+	return s.source.finalize(nil)
+}
+
+func (s *sparseImageDownload) closeCurrent() {
+	if s.current != nil {
+		_ = s.current.Close()
+		s.current = nil
+	}
+}
+
+// offsetWriter writes to an io.WriterAt sequentially starting at the specified offset.
+type offsetWriter struct {
+	w      io.WriterAt
+	offset int64
+}
+
+func (o *offsetWriter) Write(p []byte) (int, error) {
+	n, err := o.w.WriteAt(p, o.offset)
+	o.offset += int64(n)
+	return n, err
+}
+
+// offsetWriterAt adapts a sequential io.Writer to io.WriterAt. Writes must happen in order starting at the current
+// position of the writer.
+type offsetWriterAt struct {
+	w io.Writer
+}
+
+func (o *offsetWriterAt) WriteAt(p []byte, _ int64) (int, error) {
+	return o.w.Write(p)
+}
+
+// oVirtSparseDownloadSource downloads image ranges from ImageIO and queries the image extents using the extents
+// endpoint. If backupID is set, the image transfer is created for the specified backup.
+type oVirtSparseDownloadSource struct {
+	cli         *oVirtClient
+	logger      Logger
+	retries     []RetryStrategy
+	httpClient  http.Client
+	disk        Disk
+	format      ImageFormat
+	backupID    BackupID
+	incremental bool
+	transfer    imageTransfer
+	transferURL string
+}
+
+// imageIOExtent is the JSON representation of an extent returned by the ImageIO extents endpoint.
+type imageIOExtent struct {
+	Start  uint64 `json:"start"`
+	Length uint64 `json:"length"`
+	Zero   bool   `json:"zero"`
+	Hole   bool   `json:"hole"`
+	Dirty  bool   `json:"dirty"`
+}
+
+func (o *oVirtSparseDownloadSource) initialize() ([]ImageExtent, error) {
+	updateDisk := func(disk Disk) {
+		o.disk = disk
+	}
+	if o.backupID != "" {
+		o.transfer = newBackupImageTransfer(o.cli, o.logger, o.disk.ID(), o.backupID, o.retries, updateDisk)
+	} else {
+		o.transfer = newImageTransfer(
+			o.cli,
+			o.logger,
+			o.disk.ID(),
+			"",
+			o.retries,
+			ovirtsdk4.IMAGETRANSFERDIRECTION_DOWNLOAD,
+			ovirtsdk4.DiskFormat(o.format),
+			updateDisk,
+		)
+	}
+	transferURL, err := o.transfer.initialize()
+	if err != nil {
+		return nil, err
+	}
+	o.transferURL = transferURL
+
+	var extents []ImageExtent
+	err = retry(
+		fmt.Sprintf("fetching image extents from %s", transferURL),
+		o.logger,
+		o.retries,
+		func() error {
+			extents, err = o.fetchExtents()
+			return err
+		},
+	)
+	return extents, err
+}
+
+func (o *oVirtSparseDownloadSource) fetchExtents() ([]ImageExtent, error) {
+	extentsContext := "zero"
+	if o.incremental {
+		extentsContext = "dirty"
+	}
+	extentsURL := fmt.Sprintf("%s/extents?context=%s", o.transferURL, extentsContext)
+	req, err := http.NewRequest(http.MethodGet, extentsURL, nil)
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to create extents request for %s", o.transferURL)
+	}
+	response, err := o.httpClient.Do(req)
+	if err != nil {
+		return nil, wrap(err, EConnection, "HTTP request to image extents of %s failed", o.transferURL)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+	if err := o.transfer.checkStatusCode(response.StatusCode); err != nil {
+		return nil, wrap(err, EUnidentified, "failed to fetch image extents of disk %s", o.disk.ID())
+	}
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, wrap(err, EConnection, "failed to read image extents of disk %s", o.disk.ID())
+	}
+	var imageIOExtents []imageIOExtent
+	if err := json.Unmarshal(body, &imageIOExtents); err != nil {
+		return nil, wrap(err, EBug, "failed to parse image extents of disk %s", o.disk.ID())
+	}
+	extents := make([]ImageExtent, len(imageIOExtents))
+	for i, extent := range imageIOExtents {
+		extents[i] = ImageExtent{
+			Start:  extent.Start,
+			Length: extent.Length,
+			Zero:   extent.Zero,
+			Dirty:  extent.Dirty,
+		}
+	}
+	return extents, nil
+}
+
+func (o *oVirtSparseDownloadSource) fetch(ctx context.Context, start uint64, length uint64) (io.ReadCloser, error) {
+	var body io.ReadCloser
+	err := retry(
+		fmt.Sprintf("fetching image range %d-%d from %s", start, start+length-1, o.transferURL),
+		o.logger,
+		append([]RetryStrategy{ContextStrategy(ctx)}, o.retries...),
+		func() error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.transferURL, nil)
+			if err != nil {
+				return wrap(err, EBug, "failed to create ranged request for %s", o.transferURL)
+			}
+			req.Header.Set("range", fmt.Sprintf("bytes=%d-%d", start, start+length-1))
+			response, err := o.httpClient.Do(req)
+			if err != nil {
+				return wrap(err, EConnection, "HTTP request to image transfer URL %s failed", o.transferURL)
+			}
+			if err := o.transfer.checkStatusCode(response.StatusCode); err != nil {
+				_ = response.Body.Close()
+				return wrap(err, EUnidentified, "failed to download image range at offset %d", start)
+			}
+			body = response.Body
+			return nil
+		},
+	)
+	return body, err
+}
+
+func (o *oVirtSparseDownloadSource) finalize(err error) error {
+	if o.transfer == nil {
+		return err
+	}
+	return o.transfer.finalize(err)
+}
+
+// mockSparseDownloadSource serves image ranges from the data of a mock disk and detects zero extents by scanning the
+// data. If changedBlocks is set, the extents are marked dirty based on the blocks changed since the last checkpoint.
+type mockSparseDownloadSource struct {
+	data          []byte
+	changedBlocks map[uint64]bool
+	release       func()
+}
+
+func (m *mockSparseDownloadSource) initialize() ([]ImageExtent, error) {
+	// Sleep one second to trigger possible race condition with determining size.
+	time.Sleep(time.Second)
+	var extents []ImageExtent
+	zeroBlock := make([]byte, mockDiskBlockSize)
+	for start := 0; start < len(m.data); start += mockDiskBlockSize {
+		block := mockDiskBlock(m.data, start)
+		zero := bytes.Equal(block, zeroBlock[:len(block)])
+		dirty := m.changedBlocks[uint64(start/mockDiskBlockSize)]
+		if last := len(extents) - 1; last >= 0 && extents[last].Zero == zero && extents[last].Dirty == dirty {
+			extents[last].Length += uint64(len(block))
+			continue
+		}
+		extents = append(extents, ImageExtent{Start: uint64(start), Length: uint64(len(block)), Zero: zero, Dirty: dirty})
+	}
+	return extents, nil
+}
+
+func (m *mockSparseDownloadSource) fetch(ctx context.Context, start uint64, length uint64) (io.ReadCloser, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, wrap(err, ETimeout, "sparse image download cancelled")
+	}
+	if start+length > uint64(len(m.data)) {
+		return nil, newError(EBadArgument, "range %d-%d is outside of the image", start, start+length-1)
+	}
+	return io.NopCloser(bytes.NewReader(m.data[start : start+length])), nil
+}
+
+func (m *mockSparseDownloadSource) finalize(err error) error {
+	m.release()
+	return err
+}
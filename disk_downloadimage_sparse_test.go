@@ -0,0 +1,170 @@
+package ovirtclient_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestSparseImageDownloadWriteTo(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	disk, data := assertCanUploadSparseImage(t, helper)
+
+	download, err := client.SparseDownloadDisk(
+		disk.ID(),
+		ovirtclient.ImageFormatRaw,
+		ovirtclient.SparseDownloadParams().MustWithChunkSize(256).MustWithConnections(3),
+	)
+	if err != nil {
+		t.Fatalf("Failed to start sparse download of disk %s (%v)", disk.ID(), err)
+	}
+	assertExtentsContainZero(t, download)
+
+	target := filepath.Join(t.TempDir(), "image.raw")
+	fh, err := os.Create(target)
+	if err != nil {
+		t.Fatalf("Failed to create download target %s (%v)", target, err)
+	}
+	n, err := download.WriteTo(fh)
+	if closeErr := fh.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		t.Fatalf("Sparse download of disk %s failed (%v)", disk.ID(), err)
+	}
+	if uint64(n) != download.Size() {
+		t.Fatalf("Incorrect number of bytes written (expected: %d, got: %d)", download.Size(), n)
+	}
+
+	downloaded, err := os.ReadFile(target)
+	if err != nil {
+		t.Fatalf("Failed to read download target %s (%v)", target, err)
+	}
+	if !bytes.Equal(downloaded[:len(data)], data) {
+		t.Fatal(fmt.Errorf("the sparse downloaded image did not match the original upload"))
+	}
+}
+
+func TestSparseImageDownloadRead(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	disk, data := assertCanUploadSparseImage(t, helper)
+
+	download, err := client.SparseDownloadDisk(
+		disk.ID(),
+		ovirtclient.ImageFormatRaw,
+		ovirtclient.SparseDownloadParams().MustWithChunkSize(256),
+	)
+	if err != nil {
+		t.Fatalf("Failed to start sparse download of disk %s (%v)", disk.ID(), err)
+	}
+	defer func() {
+		_ = download.Close()
+	}()
+
+	downloaded, err := io.ReadAll(download)
+	if err != nil {
+		t.Fatalf("Sparse download of disk %s failed (%v)", disk.ID(), err)
+	}
+	if !bytes.Equal(downloaded[:len(data)], data) {
+		t.Fatal(fmt.Errorf("the sparse downloaded image did not match the original upload"))
+	}
+}
+
+func TestSparseImageDownloadRejectsNonRawFormat(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	disk := assertCanCreateDisk(t, helper)
+
+	_, err := helper.GetClient().StartSparseDownloadDisk(
+		disk.ID(),
+		ovirtclient.ImageFormatCow,
+		ovirtclient.SparseDownloadParams(),
+	)
+	if err == nil {
+		t.Fatalf("Starting a sparse download in the QCOW2 format did not result in an error.")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Starting a sparse download in the QCOW2 format returned an incorrect error code (%v)", err)
+	}
+}
+
+func TestSparseImageDownloadRejectsZeroChunkSizeAndConnections(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	disk := assertCanCreateDisk(t, helper)
+
+	for name, params := range map[string]*customSparseDownloadParams{
+		"chunk size":  {chunkSize: 0, connections: 1},
+		"connections": {chunkSize: 128, connections: 0},
+	} {
+		_, err := helper.GetClient().StartSparseDownloadDisk(disk.ID(), ovirtclient.ImageFormatRaw, params)
+		if err == nil {
+			t.Fatalf("Starting a sparse download with a zero %s did not result in an error.", name)
+		}
+		if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+			t.Fatalf("Starting a sparse download with a zero %s returned an incorrect error code (%v)", name, err)
+		}
+	}
+}
+
+// customSparseDownloadParams implements SparseDownloadParameters without the validation in the builder.
+type customSparseDownloadParams struct {
+	chunkSize   uint64
+	connections uint
+}
+
+func (c *customSparseDownloadParams) Connections() uint {
+	return c.connections
+}
+
+func (c *customSparseDownloadParams) ChunkSize() uint64 {
+	return c.chunkSize
+}
+
+// assertCanUploadSparseImage uploads a raw image to a new disk that contains the test image followed by a zero
+// region and the test image again.
+func assertCanUploadSparseImage(t *testing.T, helper ovirtclient.TestHelper) (ovirtclient.Disk, []byte) {
+	testImageData, _ := getTestImageData(t)
+	var data []byte
+	data = append(data, testImageData...)
+	data = append(data, make([]byte, 2048)...)
+	data = append(data, testImageData...)
+
+	disk := assertCanCreateDisk(t, helper)
+	if err := helper.GetClient().UploadToDisk(
+		disk.ID(),
+		uint64(len(data)),
+		&nopReadCloser{bytes.NewReader(data)},
+	); err != nil {
+		t.Fatalf("Failed to upload sparse image to disk %s (%v)", disk.ID(), err)
+	}
+	return disk, data
+}
+
+func assertExtentsContainZero(t *testing.T, download ovirtclient.ImageDownloadReader) {
+	var total uint64
+	hasZero := false
+	for _, extent := range download.Extents() {
+		total += extent.Length
+		if extent.Zero {
+			hasZero = true
+		}
+	}
+	if total != download.Size() {
+		t.Fatalf("The extents do not cover the whole image (expected: %d bytes, got: %d bytes)", download.Size(), total)
+	}
+	if !hasZero {
+		t.Fatalf("No zero extents were reported for an image containing a zero region.")
+	}
+}
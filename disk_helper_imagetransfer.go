@@ -68,6 +68,31 @@ func newImageTransfer(
 	}
 }
 
+// newBackupImageTransfer creates a new image transfer for downloading a disk that is part of a VM backup. The disk
+// stays locked while the backup is running, so the transfer does not wait for the disk to become OK. The image is
+// always transferred in the raw format as required by the backup API.
+func newBackupImageTransfer(
+	cli *oVirtClient,
+	logger Logger,
+	diskID DiskID,
+	backupID BackupID,
+	retries []RetryStrategy,
+	updateDisk func(disk Disk),
+) imageTransfer {
+	transfer := newImageTransfer(
+		cli,
+		logger,
+		diskID,
+		"",
+		retries,
+		ovirtsdk4.IMAGETRANSFERDIRECTION_DOWNLOAD,
+		ovirtsdk4.DISKFORMAT_RAW,
+		updateDisk,
+	).(*imageTransferImpl)
+	transfer.backupID = backupID
+	return transfer
+}
+
 // imageTransfer is an internal helper to facilitate image transfers from/to the oVirt Engine. It should not be reused
 // for multiple transfers.
 type imageTransfer interface {
@@ -76,6 +101,15 @@ type imageTransfer interface {
 	// transfer and returns an error. In any case, the calling party MUST call finalize to correctly
 	// finalize the image transfer.
 	initialize() (transferURL string, err error)
+	// resume attaches to an existing image transfer with the specified ID, resuming it if it is paused. If
+	// successful, it returns the URL the image needs to be transferred to/from. The calling party MUST call
+	// finalize or pause afterwards.
+	resume(transferID ImageTransferID) (transferURL string, err error)
+	// pause pauses the image transfer so that it can be resumed later. This can be used instead of finalize with an
+	// error if the transfer should be kept. The disk stays locked until the transfer is resumed and finalized.
+	pause() error
+	// id returns the ID of the image transfer, or an empty string if no transfer has been created yet.
+	id() ImageTransferID
 	// finalize cleans up the image transfer. It must be called regardless if an error happened or not, and the error
 	// must be passed to it so it can determine how to best clean up the image transfer.
 	//
@@ -119,6 +153,8 @@ type imageTransferImpl struct {
 	transferService *ovirtsdk4.ImageTransferService
 	// transferURL is the URL that is found for the transfer. It is set after findTransferURL is called.
 	transferURL string
+	// backupID is the ID of the VM backup the transfer belongs to, if any.
+	backupID BackupID
 }
 
 // checkStatusCode takes a HTTP status code from the ImageIO endpoint and verifies it.
@@ -178,6 +214,109 @@ func (i *imageTransferImpl) initialize() (transferURL string, err error) {
 	return i.transferURL, nil
 }
 
+// resume attaches to an existing image transfer and resumes it if it is paused. Contrary to initialize it does not
+// abort the transfer if something goes wrong, as the transfer was not created by this call.
+func (i *imageTransferImpl) resume(transferID ImageTransferID) (transferURL string, err error) {
+	i.transferService = i.conn.SystemService().ImageTransfersService().ImageTransferService(string(transferID))
+	steps := []func() error{
+		i.loadImageTransfer,
+		i.resumePausedTransfer,
+		i.waitForImageTransferReady,
+		i.findTransferURL,
+	}
+
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return "", err
+		}
+	}
+	return i.transferURL, nil
+}
+
+// loadImageTransfer fetches the existing image transfer from i.transferService and verifies that it belongs to the
+// disk and direction of this transfer. It sets i.transfer.
+func (i *imageTransferImpl) loadImageTransfer() error {
+	return retry(
+		fmt.Sprintf("fetching existing image transfer for disk %s", i.diskID),
+		i.logger,
+		i.retries,
+		func() error {
+			response, err := i.transferService.Get().Send()
+			if err != nil {
+				return err
+			}
+			transfer, ok := response.ImageTransfer()
+			if !ok {
+				return newError(EFieldMissing, "fetching image transfer did not return an image transfer")
+			}
+			if disk, ok := transfer.Disk(); ok {
+				if diskID, ok := disk.Id(); ok && DiskID(diskID) != i.diskID {
+					return newError(
+						EBadArgument,
+						"image transfer belongs to disk %s instead of %s",
+						diskID,
+						i.diskID,
+					)
+				}
+			}
+			if direction, ok := transfer.Direction(); ok && direction != i.direction {
+				return newError(
+					EBadArgument,
+					"image transfer direction is %s instead of %s",
+					direction,
+					i.direction,
+				)
+			}
+			i.transfer = transfer
+			return nil
+		},
+	)
+}
+
+// resumePausedTransfer sends a resume request if the loaded image transfer is paused.
+func (i *imageTransferImpl) resumePausedTransfer() error {
+	phase, ok := i.transfer.Phase()
+	if !ok {
+		return newError(EFieldMissing, "image transfer did not contain a phase")
+	}
+	if phase != ovirtsdk4.IMAGETRANSFERPHASE_PAUSED_USER && phase != ovirtsdk4.IMAGETRANSFERPHASE_PAUSED_SYSTEM {
+		return nil
+	}
+	return retry(
+		fmt.Sprintf("resuming image transfer for disk %s", i.diskID),
+		i.logger,
+		i.retries,
+		func() error {
+			_, err := i.transferService.Resume().Send()
+			return err
+		},
+	)
+}
+
+// pause pauses the image transfer with the oVirt Engine API so that it can be resumed later.
+func (i *imageTransferImpl) pause() error {
+	if i.transfer == nil {
+		return newError(EBug, "no image transfer to pause")
+	}
+	return retry(
+		fmt.Sprintf("pausing image transfer for disk %s", i.diskID),
+		i.logger,
+		i.retries,
+		func() error {
+			_, err := i.transferService.Pause().Send()
+			return err
+		},
+	)
+}
+
+func (i *imageTransferImpl) id() ImageTransferID {
+	if i.transfer == nil {
+		return ""
+	}
+	transferID, _ := i.transfer.Id()
+	return ImageTransferID(transferID)
+}
+
 // finalize finalizes or aborts the image transfer, depending on if an error happened. The calling
 // party must pass any error that happened so that the finalize function can make the correct decision.
 func (i *imageTransferImpl) finalize(err error) error {
@@ -205,6 +344,10 @@ func (i *imageTransferImpl) finalize(err error) error {
 //
 // This function also calls the updateDisk hook to update the disk on the calling side.
 func (i *imageTransferImpl) waitForTransferOk() (err error) {
+	if i.backupID != "" {
+		// The disk stays locked until the backup is finalized.
+		return i.cli.waitForJobFinished(i.correlationID, i.retries)
+	}
 	disk, err := i.cli.WaitForDiskOK(i.diskID, i.retries...)
 
 	if err != nil {
@@ -226,12 +369,15 @@ func (i *imageTransferImpl) buildImageTransferRequest() (
 ) {
 	imageTransfersService := i.conn.SystemService().ImageTransfersService()
 	image := ovirtsdk4.NewImageBuilder().Id(string(i.diskID)).MustBuild()
-	transfer := ovirtsdk4.
+	transferBuilder := ovirtsdk4.
 		NewImageTransferBuilder().
 		Image(image).
 		Direction(i.direction).
-		Format(i.format).
-		MustBuild()
+		Format(i.format)
+	if i.backupID != "" {
+		transferBuilder.Backup(ovirtsdk4.NewBackupBuilder().Id(string(i.backupID)).MustBuild())
+	}
+	transfer := transferBuilder.MustBuild()
 	transferReq := imageTransfersService.
 		Add().
 		ImageTransfer(transfer).
@@ -317,7 +463,7 @@ func (i *imageTransferImpl) checkImageTransferReady() error {
 		)
 	}
 	switch phase {
-	case ovirtsdk4.IMAGETRANSFERPHASE_INITIALIZING:
+	case ovirtsdk4.IMAGETRANSFERPHASE_INITIALIZING, ovirtsdk4.IMAGETRANSFERPHASE_RESUMING:
 		return newError(
 			EPending,
 			"image transfer is in phase %s instead of transferring",
@@ -1,7 +1,10 @@
 package ovirtclient
 
 import (
+	"bytes"
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -11,6 +14,73 @@ type diskWithData struct {
 	disk
 	lock *sync.Mutex
 	data []byte
+	// changes tracks the changed blocks of the disk for incremental backups.
+	changes *mockDiskChanges
+}
+
+// mockDiskBlockSize is the granularity at which the mock tracks changed blocks and detects zero extents.
+const mockDiskBlockSize = 512
+
+// mockDiskChanges simulates the dirty bitmaps the engine uses for incremental backups. Every write to a disk gets a
+// sequence number and each block records the sequence number of the last write that changed it.
+type mockDiskChanges struct {
+	sequence uint64
+	blocks   map[uint64]uint64
+}
+
+func newMockDiskChanges() *mockDiskChanges {
+	return &mockDiskChanges{
+		blocks: map[uint64]uint64{},
+	}
+}
+
+// setData replaces the data of the disk and records the blocks whose contents changed.
+func (d *diskWithData) setData(data []byte) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.changes.sequence++
+	length := len(d.data)
+	if len(data) > length {
+		length = len(data)
+	}
+	for start := 0; start < length; start += mockDiskBlockSize {
+		if !bytes.Equal(mockDiskBlock(d.data, start), mockDiskBlock(data, start)) {
+			d.changes.blocks[uint64(start/mockDiskBlockSize)] = d.changes.sequence
+		}
+	}
+	d.data = data
+}
+
+// changeSequence returns the sequence number of the last write to the disk.
+func (d *diskWithData) changeSequence() uint64 {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.changes.sequence
+}
+
+// changedBlocksSince returns the indexes of the blocks changed after the write with the specified sequence number.
+func (d *diskWithData) changedBlocksSince(sequence uint64) map[uint64]bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	result := map[uint64]bool{}
+	for block, blockSequence := range d.changes.blocks {
+		if blockSequence > sequence {
+			result[block] = true
+		}
+	}
+	return result
+}
+
+// mockDiskBlock returns the block starting at the specified offset, or a shorter slice at the end of the data.
+func mockDiskBlock(data []byte, start int) []byte {
+	if start >= len(data) {
+		return nil
+	}
+	end := start + mockDiskBlockSize
+	if end > len(data) {
+		end = len(data)
+	}
+	return data[start:end]
 }
 
 func (d *diskWithData) Lock() error {
@@ -42,9 +112,17 @@ func (d *diskWithData) WithAlias(alias *string) *diskWithData {
 			status:           d.status,
 			totalSize:        d.totalSize,
 			sparse:           d.sparse,
+			description:      d.description,
+			shareable:        d.shareable,
+			wipeAfterDelete:  d.wipeAfterDelete,
+			diskProfileID:    d.diskProfileID,
+			backup:           d.backup,
+			contentType:      d.contentType,
+			lunStorage:       d.lunStorage,
 		},
 		d.lock,
 		d.data,
+		d.changes,
 	}
 }
 
@@ -66,9 +144,17 @@ func (d *diskWithData) withProvisionedSize(ps uint64) (*diskWithData, error) {
 			status:           d.status,
 			totalSize:        ps,
 			sparse:           d.sparse,
+			description:      d.description,
+			shareable:        d.shareable,
+			wipeAfterDelete:  d.wipeAfterDelete,
+			diskProfileID:    d.diskProfileID,
+			backup:           d.backup,
+			contentType:      d.contentType,
+			lunStorage:       d.lunStorage,
 		},
 		d.lock,
 		d.data,
+		d.changes,
 	}, nil
 }
 
@@ -88,8 +174,191 @@ func (d *diskWithData) clone(sparse *bool) *diskWithData {
 			d.status,
 			d.totalSize,
 			*sparse,
+			d.description,
+			d.shareable,
+			d.wipeAfterDelete,
+			d.diskProfileID,
+			d.backup,
+			d.contentType,
+			d.lunStorage,
 		},
 		&sync.Mutex{},
 		d.data,
+		newMockDiskChanges(),
 	}
 }
+
+func (d *diskWithData) withStorageDomainIDs(storageDomainIDs []StorageDomainID) *diskWithData {
+	return &diskWithData{
+		disk{
+			client:           d.client,
+			id:               d.id,
+			alias:            d.alias,
+			provisionedSize:  d.provisionedSize,
+			format:           d.format,
+			storageDomainIDs: storageDomainIDs,
+			status:           d.status,
+			totalSize:        d.totalSize,
+			sparse:           d.sparse,
+			description:      d.description,
+			shareable:        d.shareable,
+			wipeAfterDelete:  d.wipeAfterDelete,
+			diskProfileID:    d.diskProfileID,
+			backup:           d.backup,
+			contentType:      d.contentType,
+			lunStorage:       d.lunStorage,
+		},
+		d.lock,
+		d.data,
+		d.changes,
+	}
+}
+
+func (d *diskWithData) withTotalSize(totalSize uint64) *diskWithData {
+	return &diskWithData{
+		disk{
+			client:           d.client,
+			id:               d.id,
+			alias:            d.alias,
+			provisionedSize:  d.provisionedSize,
+			format:           d.format,
+			storageDomainIDs: d.storageDomainIDs,
+			status:           d.status,
+			totalSize:        totalSize,
+			sparse:           d.sparse,
+			description:      d.description,
+			shareable:        d.shareable,
+			wipeAfterDelete:  d.wipeAfterDelete,
+			diskProfileID:    d.diskProfileID,
+			backup:           d.backup,
+			contentType:      d.contentType,
+			lunStorage:       d.lunStorage,
+		},
+		d.lock,
+		d.data,
+		d.changes,
+	}
+}
+
+// dataSize returns the number of bytes written to the disk.
+func (d *diskWithData) dataSize() uint64 {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return uint64(len(d.data))
+}
+
+// usedSize returns the number of bytes in the blocks of the disk that contain non-zero data.
+func (d *diskWithData) usedSize() uint64 {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	zeroBlock := make([]byte, mockDiskBlockSize)
+	var result uint64
+	for start := 0; start < len(d.data); start += mockDiskBlockSize {
+		block := mockDiskBlock(d.data, start)
+		if !bytes.Equal(block, zeroBlock[:len(block)]) {
+			result += uint64(len(block))
+		}
+	}
+	return result
+}
+
+// checkImageDisk returns an error if the disk is a direct LUN disk, which has no image the operation could work on.
+func (d *diskWithData) checkImageDisk(operation string) error {
+	if d.lunStorage != nil {
+		return newError(EBadArgument, "cannot %s disk %s, it is a direct LUN disk", operation, d.id)
+	}
+	return nil
+}
+
+// checkDiskNotInUse returns an error if the disk is attached to a VM that is not down. The caller must hold the lock
+// of the mock client.
+func (m *mockClient) checkDiskNotInUse(id DiskID) error {
+	if vm := m.diskUsedByRunningVM(id); vm != nil {
+		return newError(EConflict, "disk %s is in use by VM %s in status %s", id, vm.id, vm.status)
+	}
+	return nil
+}
+
+// diskUsedByRunningVM returns a VM that is not down and has the disk attached, or nil if there is none. The caller
+// must hold the lock of the mock client.
+func (m *mockClient) diskUsedByRunningVM(id DiskID) *vm {
+	for _, attachment := range m.vmDiskAttachmentsByDisk[id] {
+		if vm, ok := m.vms[attachment.vmid]; ok && vm.status != VMStatusDown {
+			return vm
+		}
+	}
+	return nil
+}
+
+// shrinkMockDisk returns a copy of the disk whose total size is reduced to the specified size and releases the freed
+// bytes on its storage domains. The caller must hold the lock of the mock client.
+func (m *mockClient) shrinkMockDisk(disk *diskWithData, size uint64) *diskWithData {
+	if size >= disk.totalSize {
+		return disk
+	}
+	for _, storageDomainID := range disk.storageDomainIDs {
+		m.adjustStorageDomainAvailable(storageDomainID, int64(disk.totalSize-size))
+	}
+	return disk.withTotalSize(size)
+}
+
+// startMockDiskOperation finishes a long-running operation on a locked disk in the background. The apply function is
+// called with the lock of the mock client held and returns the disk as it should be stored once the operation is
+// complete. The caller must hold the lock of the mock client.
+func (m *mockClient) startMockDiskOperation(disk *diskWithData, apply func() *diskWithData) *mockDiskOperation {
+	operation := &mockDiskOperation{
+		client: m,
+		disk:   disk,
+		done:   make(chan struct{}),
+	}
+	go operation.do(apply)
+	return operation
+}
+
+// mockDiskOperation tracks a long-running disk operation in the mock. It implements both DiskCreation and DiskUpdate.
+type mockDiskOperation struct {
+	client *mockClient
+	disk   *diskWithData
+	done   chan struct{}
+}
+
+func (o *mockDiskOperation) Disk() Disk {
+	o.client.lock.Lock()
+	defer o.client.lock.Unlock()
+
+	return o.disk
+}
+
+func (o *mockDiskOperation) Wait(retries ...RetryStrategy) (result Disk, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o.client))
+	diskID := o.Disk().ID()
+	err = retry(
+		fmt.Sprintf("waiting for the operation on disk %s to finish", diskID),
+		o.client.logger,
+		retries,
+		func() error {
+			select {
+			case <-o.done:
+				result = o.Disk()
+				return nil
+			default:
+				return newError(EPending, "the operation on disk %s is still in progress", diskID)
+			}
+		})
+	return
+}
+
+func (o *mockDiskOperation) do(apply func() *diskWithData) {
+	// Sleep to trigger potential race conditions / improper status handling.
+	time.Sleep(time.Second)
+
+	o.client.lock.Lock()
+	defer o.client.lock.Unlock()
+
+	disk := apply()
+	o.client.disks[disk.ID()] = disk
+	disk.Unlock()
+	o.disk = disk
+
+	close(o.done)
+}
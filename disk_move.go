@@ -0,0 +1,93 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) MoveDisk(diskID DiskID, storageDomainID StorageDomainID, retries ...RetryStrategy) (
+	Disk,
+	error,
+) {
+	progress, err := o.StartMoveDisk(diskID, storageDomainID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return progress.Wait(retries...)
+}
+
+func (o *oVirtClient) StartMoveDisk(diskID DiskID, storageDomainID StorageDomainID, retries ...RetryStrategy) (
+	DiskUpdate,
+	error,
+) {
+	return o.startDiskJob(
+		diskID,
+		"move",
+		func(diskService *ovirtsdk.DiskService, correlationID string) error {
+			_, err := diskService.Move().
+				StorageDomain(ovirtsdk.NewStorageDomainBuilder().Id(string(storageDomainID)).MustBuild()).
+				Query("correlation_id", correlationID).
+				Send()
+			return err
+		},
+		retries,
+	)
+}
+
+func (m *mockClient) MoveDisk(diskID DiskID, storageDomainID StorageDomainID, retries ...RetryStrategy) (
+	Disk,
+	error,
+) {
+	progress, err := m.StartMoveDisk(diskID, storageDomainID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return progress.Wait(retries...)
+}
+
+func (m *mockClient) StartMoveDisk(
+	diskID DiskID,
+	storageDomainID StorageDomainID,
+	retries ...RetryStrategy,
+) (result DiskUpdate, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	err = retry(
+		fmt.Sprintf("moving disk %s to storage domain %s", diskID, storageDomainID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			disk, ok := m.disks[diskID]
+			if !ok {
+				return newError(ENotFound, "disk with ID %s not found", diskID)
+			}
+			if err := disk.checkImageDisk("move"); err != nil {
+				return err
+			}
+			if diskOnStorageDomain(disk, storageDomainID) {
+				return newError(EBadArgument, "disk %s is already on storage domain %s", diskID, storageDomainID)
+			}
+			if err := m.checkStorageDomainSpace(storageDomainID, disk.totalSize); err != nil {
+				return err
+			}
+			if err := disk.Lock(); err != nil {
+				return err
+			}
+			result = m.startMockDiskOperation(disk, func() *diskWithData {
+				for _, sourceID := range disk.storageDomainIDs {
+					m.adjustStorageDomainAvailable(sourceID, int64(disk.totalSize))
+				}
+				m.adjustStorageDomainAvailable(storageDomainID, -int64(disk.totalSize))
+				moved := disk.withStorageDomainIDs([]StorageDomainID{storageDomainID})
+				if profile := m.getDefaultDiskProfile(storageDomainID); profile != nil {
+					moved.diskProfileID = profile.id
+				}
+				return moved
+			})
+			return nil
+		})
+	return
+}
@@ -0,0 +1,165 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestDiskMove(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+	targetStorageDomainID := helper.GetSecondaryStorageDomainID(t)
+
+	disk := assertCanCreateDisk(t, helper)
+
+	t.Logf("Moving disk %s to storage domain %s...", disk.ID(), targetStorageDomainID)
+	progress, err := client.StartMoveDisk(disk.ID(), targetStorageDomainID)
+	if err != nil {
+		t.Fatalf("Failed to start moving disk %s. (%v)", disk.ID(), err)
+	}
+	if status := progress.Disk().Status(); status != ovirtclient.DiskStatusLocked {
+		t.Fatalf("The disk was not locked during the move (status: %s).", status)
+	}
+	movedDisk, err := progress.Wait()
+	if err != nil {
+		t.Fatalf("Failed to move disk %s. (%v)", disk.ID(), err)
+	}
+	storageDomainIDs := movedDisk.StorageDomainIDs()
+	if len(storageDomainIDs) != 1 || storageDomainIDs[0] != targetStorageDomainID {
+		t.Fatalf(
+			"The moved disk is on the incorrect storage domains (expected: %s, got: %v).",
+			targetStorageDomainID,
+			storageDomainIDs,
+		)
+	}
+	if movedDisk.Status() != ovirtclient.DiskStatusOK {
+		t.Fatalf("The moved disk is not in status OK (status: %s).", movedDisk.Status())
+	}
+}
+
+func TestDiskMoveToSameStorageDomain(t *testing.T) {
+	helper := getHelper(t)
+
+	disk := assertCanCreateDisk(t, helper)
+
+	_, err := disk.Move(helper.GetStorageDomainID())
+	if err == nil {
+		t.Fatalf("Moving the disk to the storage domain it is already on did not result in an error.")
+	}
+}
+
+func TestDiskCopy(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+	targetStorageDomainID := helper.GetSecondaryStorageDomainID(t)
+
+	disk := assertCanCreateDisk(t, helper)
+	availableBefore := assertStorageDomainAvailable(t, helper, targetStorageDomainID)
+
+	alias := fmt.Sprintf("%s-copy", disk.Alias())
+	t.Logf("Copying disk %s to storage domain %s...", disk.ID(), targetStorageDomainID)
+	newDisk, err := disk.Copy(targetStorageDomainID, alias)
+	if newDisk != nil {
+		t.Cleanup(func() {
+			if err := client.RemoveDisk(newDisk.ID()); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+				t.Fatalf("Failed to remove disk copy %s. (%v)", newDisk.ID(), err)
+			}
+		})
+	}
+	if err != nil {
+		t.Fatalf("Failed to copy disk %s. (%v)", disk.ID(), err)
+	}
+	if newDisk.ID() == disk.ID() {
+		t.Fatalf("The disk copy has the same ID as the original disk.")
+	}
+	if newDisk.Alias() != alias {
+		t.Fatalf("The disk copy has the incorrect alias (expected: %s, got: %s).", alias, newDisk.Alias())
+	}
+	storageDomainIDs := newDisk.StorageDomainIDs()
+	if len(storageDomainIDs) != 1 || storageDomainIDs[0] != targetStorageDomainID {
+		t.Fatalf(
+			"The disk copy is on the incorrect storage domains (expected: %s, got: %v).",
+			targetStorageDomainID,
+			storageDomainIDs,
+		)
+	}
+
+	originalDisk, err := client.GetDisk(disk.ID())
+	if err != nil {
+		t.Fatalf("Failed to fetch original disk %s. (%v)", disk.ID(), err)
+	}
+	if originalDisk.Status() != ovirtclient.DiskStatusOK {
+		t.Fatalf("The original disk is not in status OK after the copy (status: %s).", originalDisk.Status())
+	}
+	availableAfter := assertStorageDomainAvailable(t, helper, targetStorageDomainID)
+	if availableAfter >= availableBefore {
+		t.Fatalf(
+			"The available space on storage domain %s did not decrease after the copy (before: %d, after: %d).",
+			targetStorageDomainID,
+			availableBefore,
+			availableAfter,
+		)
+	}
+}
+
+func TestDiskSparsifyAndReduce(t *testing.T) {
+	helper := getHelper(t)
+
+	disk := assertCanCreateDiskWithParameters(
+		t,
+		helper,
+		ovirtclient.ImageFormatCow,
+		ovirtclient.CreateDiskParams().MustWithSparse(true),
+	)
+
+	t.Logf("Sparsifying disk %s...", disk.ID())
+	sparsifiedDisk, err := disk.Sparsify()
+	if err != nil {
+		t.Fatalf("Failed to sparsify disk %s. (%v)", disk.ID(), err)
+	}
+	if sparsifiedDisk.TotalSize() > disk.TotalSize() {
+		t.Fatalf(
+			"The total size of the disk increased after sparsifying (before: %d, after: %d).",
+			disk.TotalSize(),
+			sparsifiedDisk.TotalSize(),
+		)
+	}
+
+	t.Logf("Reducing disk %s...", disk.ID())
+	reducedDisk, err := sparsifiedDisk.Reduce()
+	if err != nil {
+		t.Fatalf("Failed to reduce disk %s. (%v)", disk.ID(), err)
+	}
+	if reducedDisk.TotalSize() > sparsifiedDisk.TotalSize() {
+		t.Fatalf(
+			"The total size of the disk increased after reducing (before: %d, after: %d).",
+			sparsifiedDisk.TotalSize(),
+			reducedDisk.TotalSize(),
+		)
+	}
+}
+
+func TestDiskReduceRejectsRawDisk(t *testing.T) {
+	helper := getHelper(t)
+
+	disk := assertCanCreateDisk(t, helper)
+
+	_, err := disk.Reduce()
+	if err == nil {
+		t.Fatalf("Reducing a raw disk did not result in an error.")
+	}
+}
+
+func assertStorageDomainAvailable(
+	t *testing.T,
+	helper ovirtclient.TestHelper,
+	storageDomainID ovirtclient.StorageDomainID,
+) uint64 {
+	storageDomain, err := helper.GetClient().GetStorageDomain(storageDomainID)
+	if err != nil {
+		t.Fatalf("Failed to fetch storage domain %s. (%v)", storageDomainID, err)
+	}
+	return storageDomain.Available()
+}
@@ -0,0 +1,113 @@
+package ovirtclient
+
+import (
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// DiskProfileID is the identifier of a disk profile.
+type DiskProfileID string
+
+// StorageQoSID is the identifier of a storage QoS entry that limits the throughput and IOPS of the disks using a disk
+// profile.
+type StorageQoSID string
+
+// DiskProfileClient describes the functions related to disk profiles. Disk profiles belong to a storage domain and
+// can optionally reference a storage QoS entry. The engine creates a default disk profile with the name of the
+// storage domain for every storage domain.
+type DiskProfileClient interface {
+	// ListDiskProfiles lists all disk profiles.
+	ListDiskProfiles(retries ...RetryStrategy) ([]DiskProfile, error)
+	// ListStorageDomainDiskProfiles lists the disk profiles that can be used for disks on the specified storage
+	// domain.
+	ListStorageDomainDiskProfiles(storageDomainID StorageDomainID, retries ...RetryStrategy) ([]DiskProfile, error)
+	// GetDiskProfile returns a single disk profile.
+	GetDiskProfile(id DiskProfileID, retries ...RetryStrategy) (DiskProfile, error)
+}
+
+// DiskProfileData contains the data of a disk profile.
+type DiskProfileData interface {
+	// ID returns the identifier of the disk profile.
+	ID() DiskProfileID
+	// Name returns the name of the disk profile.
+	Name() string
+	// Description returns the description of the disk profile.
+	Description() string
+	// StorageDomainID returns the storage domain the disk profile belongs to.
+	StorageDomainID() StorageDomainID
+	// QoSID returns the storage QoS entry applied to the disks using this profile. It returns an empty string if the
+	// disks are not limited.
+	QoSID() StorageQoSID
+}
+
+// DiskProfile is a disk profile that can be selected when creating a disk.
+type DiskProfile interface {
+	DiskProfileData
+
+	// StorageDomain fetches the storage domain the disk profile belongs to.
+	StorageDomain(retries ...RetryStrategy) (StorageDomain, error)
+}
+
+func convertSDKDiskProfile(sdkObject *ovirtsdk4.DiskProfile, client Client) (DiskProfile, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("disk profile", "ID")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("disk profile", "name")
+	}
+	sdkStorageDomain, ok := sdkObject.StorageDomain()
+	if !ok {
+		return nil, newFieldNotFound("disk profile", "storage domain")
+	}
+	storageDomainID, ok := sdkStorageDomain.Id()
+	if !ok {
+		return nil, newFieldNotFound("storage domain on disk profile", "ID")
+	}
+	result := &diskProfile{
+		client:          client,
+		id:              DiskProfileID(id),
+		name:            name,
+		storageDomainID: StorageDomainID(storageDomainID),
+	}
+	result.description, _ = sdkObject.Description()
+	if qos, ok := sdkObject.Qos(); ok {
+		qosID, _ := qos.Id()
+		result.qosID = StorageQoSID(qosID)
+	}
+	return result, nil
+}
+
+type diskProfile struct {
+	client Client
+
+	id              DiskProfileID
+	name            string
+	description     string
+	storageDomainID StorageDomainID
+	qosID           StorageQoSID
+}
+
+func (d *diskProfile) ID() DiskProfileID {
+	return d.id
+}
+
+func (d *diskProfile) Name() string {
+	return d.name
+}
+
+func (d *diskProfile) Description() string {
+	return d.description
+}
+
+func (d *diskProfile) StorageDomainID() StorageDomainID {
+	return d.storageDomainID
+}
+
+func (d *diskProfile) QoSID() StorageQoSID {
+	return d.qosID
+}
+
+func (d *diskProfile) StorageDomain(retries ...RetryStrategy) (StorageDomain, error) {
+	return d.client.GetStorageDomain(d.storageDomainID, retries...)
+}
@@ -0,0 +1,48 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetDiskProfile(id DiskProfileID, retries ...RetryStrategy) (result DiskProfile, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting disk profile %s", id),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().DiskProfilesService().DiskProfileService(string(id)).Get().Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Profile()
+			if !ok {
+				return newError(ENotFound, "no disk profile returned when getting disk profile ID %s", id)
+			}
+			result, err = convertSDKDiskProfile(sdkObject, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert disk profile %s", id)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) GetDiskProfile(id DiskProfileID, retries ...RetryStrategy) (result DiskProfile, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	err = retry(
+		fmt.Sprintf("getting disk profile %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if item, ok := m.diskProfiles[id]; ok {
+				result = item
+				return nil
+			}
+			return newError(ENotFound, "disk profile with ID %s not found", id)
+		})
+	return
+}
@@ -0,0 +1,48 @@
+package ovirtclient
+
+func (o *oVirtClient) ListDiskProfiles(retries ...RetryStrategy) (result []DiskProfile, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []DiskProfile{}
+	err = retry(
+		"listing disk profiles",
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().DiskProfilesService().List().Send()
+			if err != nil {
+				return err
+			}
+			sdkObjects, ok := response.Profile()
+			if !ok {
+				return nil
+			}
+			result = make([]DiskProfile, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], err = convertSDKDiskProfile(sdkObject, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert disk profile during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) ListDiskProfiles(retries ...RetryStrategy) (result []DiskProfile, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	err = retry(
+		"listing disk profiles",
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			result = make([]DiskProfile, 0, len(m.diskProfiles))
+			for _, item := range m.diskProfiles {
+				result = append(result, item)
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,82 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestDiskProfiles(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	profiles, err := client.ListStorageDomainDiskProfiles(helper.GetStorageDomainID())
+	if err != nil {
+		t.Fatalf("Failed to list disk profiles of storage domain %s. (%v)", helper.GetStorageDomainID(), err)
+	}
+	if len(profiles) == 0 {
+		t.Fatalf("No disk profiles found on storage domain %s.", helper.GetStorageDomainID())
+	}
+	profile := profiles[0]
+	if profile.StorageDomainID() != helper.GetStorageDomainID() {
+		t.Fatalf(
+			"Disk profile %s has the incorrect storage domain ID (expected: %s, got: %s).",
+			profile.ID(),
+			helper.GetStorageDomainID(),
+			profile.StorageDomainID(),
+		)
+	}
+
+	fetchedProfile, err := client.GetDiskProfile(profile.ID())
+	if err != nil {
+		t.Fatalf("Failed to fetch disk profile %s. (%v)", profile.ID(), err)
+	}
+	if fetchedProfile.Name() != profile.Name() {
+		t.Fatalf(
+			"The fetched disk profile has the incorrect name (expected: %s, got: %s).",
+			profile.Name(),
+			fetchedProfile.Name(),
+		)
+	}
+
+	disk := assertCanCreateDiskWithParameters(
+		t,
+		helper,
+		ovirtclient.ImageFormatRaw,
+		ovirtclient.CreateDiskParams().MustWithDiskProfileID(profile.ID()),
+	)
+	if disk.DiskProfileID() != profile.ID() {
+		t.Fatalf(
+			"The disk has the incorrect disk profile (expected: %s, got: %s).",
+			profile.ID(),
+			disk.DiskProfileID(),
+		)
+	}
+}
+
+func TestDiskProfileFromOtherStorageDomainIsRejected(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	secondaryStorageDomainID := helper.GetSecondaryStorageDomainID(t)
+
+	profiles, err := client.ListStorageDomainDiskProfiles(secondaryStorageDomainID)
+	if err != nil {
+		t.Fatalf("Failed to list disk profiles of storage domain %s. (%v)", secondaryStorageDomainID, err)
+	}
+	if len(profiles) == 0 {
+		t.Skipf("No disk profiles found on storage domain %s.", secondaryStorageDomainID)
+	}
+
+	disk, err := client.CreateDisk(
+		helper.GetStorageDomainID(),
+		ovirtclient.ImageFormatRaw,
+		1048576,
+		ovirtclient.CreateDiskParams().MustWithDiskProfileID(profiles[0].ID()),
+	)
+	if err == nil {
+		_ = disk.Remove()
+		t.Fatalf("Creating a disk with a disk profile of a different storage domain did not result in an error.")
+	}
+}
@@ -0,0 +1,76 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) ReduceDisk(diskID DiskID, retries ...RetryStrategy) (Disk, error) {
+	progress, err := o.StartReduceDisk(diskID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return progress.Wait(retries...)
+}
+
+func (o *oVirtClient) StartReduceDisk(diskID DiskID, retries ...RetryStrategy) (DiskUpdate, error) {
+	return o.startDiskJob(
+		diskID,
+		"reduce",
+		func(diskService *ovirtsdk.DiskService, correlationID string) error {
+			_, err := diskService.Reduce().Query("correlation_id", correlationID).Send()
+			return err
+		},
+		retries,
+	)
+}
+
+func (m *mockClient) ReduceDisk(diskID DiskID, retries ...RetryStrategy) (Disk, error) {
+	progress, err := m.StartReduceDisk(diskID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return progress.Wait(retries...)
+}
+
+func (m *mockClient) StartReduceDisk(diskID DiskID, retries ...RetryStrategy) (result DiskUpdate, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	err = retry(
+		fmt.Sprintf("reducing disk %s", diskID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			disk, ok := m.disks[diskID]
+			if !ok {
+				return newError(ENotFound, "disk with ID %s not found", diskID)
+			}
+			if err := disk.checkImageDisk("reduce"); err != nil {
+				return err
+			}
+			if disk.format != ImageFormatCow {
+				return newError(
+					EBadArgument,
+					"disk %s is in %s format, only %s disks can be reduced",
+					diskID,
+					disk.format,
+					ImageFormatCow,
+				)
+			}
+			if err := m.checkDiskNotInUse(diskID); err != nil {
+				return err
+			}
+			if err := disk.Lock(); err != nil {
+				return err
+			}
+			result = m.startMockDiskOperation(disk, func() *diskWithData {
+				// Reducing trims the image to the data written to it.
+				return m.shrinkMockDisk(disk, disk.dataSize())
+			})
+			return nil
+		})
+	return
+}
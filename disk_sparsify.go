@@ -0,0 +1,70 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) SparsifyDisk(diskID DiskID, retries ...RetryStrategy) (Disk, error) {
+	progress, err := o.StartSparsifyDisk(diskID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return progress.Wait(retries...)
+}
+
+func (o *oVirtClient) StartSparsifyDisk(diskID DiskID, retries ...RetryStrategy) (DiskUpdate, error) {
+	return o.startDiskJob(
+		diskID,
+		"sparsify",
+		func(diskService *ovirtsdk.DiskService, correlationID string) error {
+			_, err := diskService.Sparsify().Query("correlation_id", correlationID).Send()
+			return err
+		},
+		retries,
+	)
+}
+
+func (m *mockClient) SparsifyDisk(diskID DiskID, retries ...RetryStrategy) (Disk, error) {
+	progress, err := m.StartSparsifyDisk(diskID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return progress.Wait(retries...)
+}
+
+func (m *mockClient) StartSparsifyDisk(diskID DiskID, retries ...RetryStrategy) (result DiskUpdate, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	err = retry(
+		fmt.Sprintf("sparsifying disk %s", diskID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			disk, ok := m.disks[diskID]
+			if !ok {
+				return newError(ENotFound, "disk with ID %s not found", diskID)
+			}
+			if err := disk.checkImageDisk("sparsify"); err != nil {
+				return err
+			}
+			if !disk.sparse {
+				return newError(EBadArgument, "disk %s is preallocated, only thin provisioned disks can be sparsified", diskID)
+			}
+			if err := m.checkDiskNotInUse(diskID); err != nil {
+				return err
+			}
+			if err := disk.Lock(); err != nil {
+				return err
+			}
+			result = m.startMockDiskOperation(disk, func() *diskWithData {
+				// Sparsifying releases the blocks the guest does not use, which we approximate with the zero blocks.
+				return m.shrinkMockDisk(disk, disk.usedSize())
+			})
+			return nil
+		})
+	return
+}
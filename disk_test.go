@@ -95,6 +95,60 @@ func TestDiskCreationAndUpdate(t *testing.T) {
 	checkDiskAfterCreation(updatedDisk, t, "changed_disk_name")
 }
 
+func TestDiskCreationWithOptionalParameters(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	disk := assertCanCreateDiskWithParameters(
+		t,
+		helper,
+		ovirtclient.ImageFormatCow,
+		ovirtclient.CreateDiskParams().
+			MustWithSparse(true).
+			MustWithDescription("Disk for incremental backups").
+			MustWithWipeAfterDelete(true).
+			MustWithBackup(ovirtclient.DiskBackupIncremental).
+			MustWithContentType(ovirtclient.DiskContentTypeData),
+	)
+	if disk.Description() != "Disk for incremental backups" {
+		t.Fatalf("Incorrect disk description after creation: %s", disk.Description())
+	}
+	if !disk.WipeAfterDelete() {
+		t.Fatalf("Wipe after delete is not set after disk creation.")
+	}
+	if disk.Shareable() {
+		t.Fatalf("The disk is shareable even though it was not requested.")
+	}
+	if disk.Backup() != ovirtclient.DiskBackupIncremental {
+		t.Fatalf("Incorrect disk backup mode after creation: %s", disk.Backup())
+	}
+	if disk.ContentType() != ovirtclient.DiskContentTypeData {
+		t.Fatalf("Incorrect disk content type after creation: %s", disk.ContentType())
+	}
+}
+
+func TestShareableDiskRejectsCowFormat(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	_, err := helper.GetClient().CreateDisk(
+		helper.GetStorageDomainID(),
+		ovirtclient.ImageFormatCow,
+		1048576,
+		ovirtclient.CreateDiskParams().MustWithShareable(true),
+	)
+	if err == nil {
+		t.Fatalf("Creating a shareable disk in the %s format did not result in an error.", ovirtclient.ImageFormatCow)
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf(
+			"Creating a shareable disk in the %s format did not result in an EBadArgument error (%v).",
+			ovirtclient.ImageFormatCow,
+			err,
+		)
+	}
+}
+
 func checkDiskAfterCreation(disk ovirtclient.Disk, t *testing.T, name string) {
 	if disk.ProvisionedSize() < 512 {
 		t.Fatalf("Incorrect provisioned disk size after creation: %d", disk.ProvisionedSize())
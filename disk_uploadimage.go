@@ -10,6 +10,7 @@ import (
 	"io"
 	"net/http"
 	"sync"
+	"time"
 
 	ovirtsdk4 "github.com/ovirt/go-ovirt"
 )
@@ -102,20 +103,10 @@ func (o *oVirtClient) StartUploadToDisk(
 		return nil, err
 	}
 
-	format, qcowSize, err := extractQCOWParameters(size, reader)
+	format, qcowSize, err := extractUploadToDiskParameters(disk, size, reader)
 	if err != nil {
 		return nil, err
 	}
-
-	if qcowSize > disk.ProvisionedSize() {
-		return nil, newError(
-			EBadArgument,
-			"the virtual image size (%d bytes) is larger than the target disk %s (%d bytes)",
-			qcowSize,
-			diskID,
-			disk.ProvisionedSize(),
-		)
-	}
 	ctx, cancel := context.WithCancel(context.Background())
 	progress := &uploadToDiskProgress{
 		client:        o,
@@ -130,11 +121,32 @@ func (o *oVirtClient) StartUploadToDisk(
 		qcowSize:      qcowSize,
 		reader:        reader,
 		retries:       retries,
+		startTime:     time.Now(),
 	}
 	go progress.Do()
 	return progress, nil
 }
 
+// extractUploadToDiskParameters determines the format and virtual size of the image and checks if it fits into the
+// target disk.
+func extractUploadToDiskParameters(disk Disk, size uint64, reader io.ReadSeekCloser) (ImageFormat, uint64, error) {
+	format, qcowSize, err := extractQCOWParameters(size, reader)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if qcowSize > disk.ProvisionedSize() {
+		return "", 0, newError(
+			EBadArgument,
+			"the virtual image size (%d bytes) is larger than the target disk %s (%d bytes)",
+			qcowSize,
+			disk.ID(),
+			disk.ProvisionedSize(),
+		)
+	}
+	return format, qcowSize, nil
+}
+
 type uploadToDiskProgress struct {
 	client           *oVirtClient
 	lock             *sync.Mutex
@@ -150,6 +162,9 @@ type uploadToDiskProgress struct {
 	err              error
 	format           ImageFormat
 	qcowSize         uint64
+	transferID       ImageTransferID
+	tries            uint
+	startTime        time.Time
 }
 
 func (u *uploadToDiskProgress) Close() error {
@@ -188,7 +203,11 @@ func (u *uploadToDiskProgress) transfer() error {
 	)
 	transferURL := ""
 	var err error
-	if transferURL, err = transfer.initialize(); err != nil {
+	transferURL, err = transfer.initialize()
+	u.lock.Lock()
+	u.transferID = transfer.id()
+	u.lock.Unlock()
+	if err != nil {
 		return transfer.finalize(err)
 	}
 	err = u.transferImage(transfer, transferURL)
@@ -226,6 +245,7 @@ func (u *uploadToDiskProgress) putRequest(transferURL string, transfer imageTran
 
 	u.lock.Lock()
 	u.transferredBytes = 0
+	u.tries++
 	u.lock.Unlock()
 
 	putRequest, err := http.NewRequest(http.MethodPut, transferURL, u)
@@ -283,6 +303,39 @@ func (u *uploadToDiskProgress) Done() <-chan struct{} {
 	return u.done
 }
 
+func (u *uploadToDiskProgress) TransferID() ImageTransferID {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	return u.transferID
+}
+
+func (u *uploadToDiskProgress) Chunks() []UploadChunkProgress {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	completed := false
+	select {
+	case <-u.done:
+		completed = u.err == nil
+	default:
+	}
+	return []UploadChunkProgress{
+		&uploadChunk{
+			lock:          &sync.Mutex{},
+			offset:        0,
+			length:        u.totalBytes,
+			uploadedBytes: u.transferredBytes,
+			completed:     completed,
+			tries:         u.tries,
+		},
+	}
+}
+
+func (u *uploadToDiskProgress) Throughput() uint64 {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	return calculateThroughput(u.transferredBytes, u.startTime)
+}
+
 func (u *uploadToDiskProgress) Read(p []byte) (n int, err error) {
 	select {
 	case <-u.ctx.Done():
@@ -340,6 +393,7 @@ func (o *oVirtClient) StartUploadToNewDisk(
 			qcowSize:      qcowSize,
 			reader:        reader,
 			retries:       retries,
+			startTime:     time.Now(),
 		},
 
 		storageDomainID: storageDomainID,
@@ -450,38 +504,19 @@ func (m *mockClient) StartUploadToDisk(
 		return nil, err
 	}
 
-	imageFormat, qcowSize, err := extractQCOWParameters(size, reader)
-	if err != nil {
+	if err := m.checkUploadToDisk(disk, size, reader); err != nil {
 		return nil, err
 	}
 
-	if qcowSize > disk.TotalSize() {
-		return nil, newError(
-			EBadArgument,
-			"the specified size (%d bytes) is larger than the target disk %s (%d bytes)",
-			size,
-			diskID,
-			disk.TotalSize(),
-		)
-	}
-
-	if imageFormat != disk.Format() {
-		return nil, newError(
-			EBadArgument,
-			"the mock facility doesn't support uploading %s images to %s disks,"+
-				" please upload in the disk format in your tests.",
-			imageFormat,
-			disk.Format(),
-		)
-	}
-
 	progress := &mockImageUploadProgress{
-		err:    nil,
-		disk:   disk,
-		client: m,
-		reader: reader,
-		size:   size,
-		done:   make(chan struct{}),
+		err:        nil,
+		disk:       disk,
+		client:     m,
+		reader:     reader,
+		size:       size,
+		done:       make(chan struct{}),
+		transferID: ImageTransferID(m.GenerateUUID()),
+		startTime:  time.Now(),
 	}
 
 	// Lock the disk to simulate the upload being initialized.
@@ -545,12 +580,14 @@ func (m *mockClient) StartUploadToNewDisk(
 	disk.Unlock()
 
 	progress := &mockImageUploadProgress{
-		err:    nil,
-		disk:   disk,
-		client: m,
-		reader: reader,
-		size:   size,
-		done:   make(chan struct{}),
+		err:        nil,
+		disk:       disk,
+		client:     m,
+		reader:     reader,
+		size:       size,
+		done:       make(chan struct{}),
+		transferID: ImageTransferID(m.GenerateUUID()),
+		startTime:  time.Now(),
 	}
 
 	// Lock the disk to simulate the upload being initialized.
@@ -590,6 +627,8 @@ type mockImageUploadProgress struct {
 	size          uint64
 	uploadedBytes uint64
 	done          chan struct{}
+	transferID    ImageTransferID
+	startTime     time.Time
 }
 
 func (m *mockImageUploadProgress) Disk() Disk {
@@ -616,6 +655,33 @@ func (m *mockImageUploadProgress) Done() <-chan struct{} {
 	return m.done
 }
 
+func (m *mockImageUploadProgress) TransferID() ImageTransferID {
+	return m.transferID
+}
+
+func (m *mockImageUploadProgress) Chunks() []UploadChunkProgress {
+	completed := false
+	select {
+	case <-m.done:
+		completed = m.err == nil
+	default:
+	}
+	return []UploadChunkProgress{
+		&uploadChunk{
+			lock:          &sync.Mutex{},
+			offset:        0,
+			length:        m.size,
+			uploadedBytes: m.uploadedBytes,
+			completed:     completed,
+			tries:         1,
+		},
+	}
+}
+
+func (m *mockImageUploadProgress) Throughput() uint64 {
+	return calculateThroughput(m.uploadedBytes, m.startTime)
+}
+
 func (m *mockImageUploadProgress) do() {
 	defer func() {
 		m.disk.Unlock()
@@ -627,8 +693,12 @@ func (m *mockImageUploadProgress) do() {
 		m.err = fmt.Errorf("failed to seek to start of image file (%w)", err)
 		return
 	}
-	m.disk.data, err = io.ReadAll(m.reader)
+	var data []byte
+	data, err = io.ReadAll(m.reader)
 	m.err = err
+	if err == nil {
+		m.disk.setData(data)
+	}
 	if err != nil {
 		m.uploadedBytes = m.size
 	}
@@ -0,0 +1,793 @@
+//
+// This file implements the ranged, parallel image upload of the oVirt client.
+//
+
+package ovirtclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) RangedUploadToDisk(
+	diskID DiskID,
+	size uint64,
+	reader io.ReadSeekCloser,
+	params RangedUploadParameters,
+	retries ...RetryStrategy,
+) error {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	progress, err := o.StartRangedUploadToDisk(diskID, size, reader, params, retries...)
+	if err != nil {
+		return err
+	}
+	<-progress.Done()
+	return progress.Err()
+}
+
+func (o *oVirtClient) StartRangedUploadToDisk(
+	diskID DiskID,
+	size uint64,
+	reader io.ReadSeekCloser,
+	params RangedUploadParameters,
+	retries ...RetryStrategy,
+) (UploadImageProgress, error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if params == nil {
+		params = RangedUploadParams()
+	}
+	if err := validateRangedUploadParams(params); err != nil {
+		return nil, err
+	}
+	o.logger.Infof("Starting ranged disk image upload...")
+	disk, err := o.GetDisk(diskID, retries...)
+	if err != nil {
+		return nil, err
+	}
+
+	format, _, err := extractUploadToDiskParameters(disk, size, reader)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	progress := &oVirtRangedUploadProgress{
+		rangedUploadProgress: newRangedUploadProgress(disk, size, params),
+		client:               o,
+		ctx:                  ctx,
+		cancel:               cancel,
+		correlationID:        fmt.Sprintf("image_upload_%s", generateRandomID(5, o.nonSecureRandom)),
+		format:               format,
+		readerAt:             newUploadReaderAt(reader),
+		params:               params,
+		retries:              retries,
+	}
+	go progress.do()
+	return progress, nil
+}
+
+type oVirtRangedUploadProgress struct {
+	*rangedUploadProgress
+
+	client        *oVirtClient
+	ctx           context.Context
+	cancel        func()
+	correlationID string
+	format        ImageFormat
+	readerAt      io.ReaderAt
+	params        RangedUploadParameters
+	retries       []RetryStrategy
+}
+
+func (u *oVirtRangedUploadProgress) do() {
+	defer func() {
+		close(u.done)
+		u.cancel()
+	}()
+
+	err := u.transfer()
+
+	u.lock.Lock()
+	u.err = err
+	u.lock.Unlock()
+}
+
+func (u *oVirtRangedUploadProgress) transfer() error {
+	transfer := newImageTransfer(
+		u.client,
+		u.client.logger,
+		u.Disk().ID(),
+		u.correlationID,
+		u.retries,
+		ovirtsdk4.IMAGETRANSFERDIRECTION_UPLOAD,
+		ovirtsdk4.DiskFormat(u.format),
+		u.updateDisk,
+	)
+	var transferURL string
+	var err error
+	if transferID := u.params.TransferID(); transferID != nil {
+		transferURL, err = transfer.resume(*transferID)
+	} else {
+		transferURL, err = transfer.initialize()
+	}
+	u.setTransferID(transfer.id())
+	if err != nil {
+		return u.fail(transfer, err)
+	}
+	if err := u.transferChunks(transfer, transferURL); err != nil {
+		return u.fail(transfer, err)
+	}
+	if err := u.flush(transfer, transferURL); err != nil {
+		return u.fail(transfer, err)
+	}
+	return transfer.finalize(nil)
+}
+
+// fail pauses the transfer if the upload is resumable, otherwise aborts it.
+func (u *oVirtRangedUploadProgress) fail(transfer imageTransfer, err error) error {
+	if !u.params.Resumable() || transfer.id() == "" {
+		return transfer.finalize(err)
+	}
+	if pauseErr := transfer.pause(); pauseErr != nil {
+		u.client.logger.Warningf(
+			"Failed to pause image transfer %s for disk %s, aborting transfer. (%v)",
+			transfer.id(),
+			u.Disk().ID(),
+			pauseErr,
+		)
+		return transfer.finalize(err)
+	}
+	return wrap(
+		err,
+		EUnidentified,
+		"ranged upload to disk %s failed, image transfer %s has been paused and can be resumed",
+		u.Disk().ID(),
+		transfer.id(),
+	)
+}
+
+// transferChunks uploads all chunks that have not been completed yet to the transfer URL.
+func (u *oVirtRangedUploadProgress) transferChunks(transfer imageTransfer, transferURL string) error {
+	chunkURL, err := url.Parse(transferURL)
+	if err != nil {
+		return wrap(err, EUnidentified, "failed to parse transfer URL %s", transferURL)
+	}
+	// We ask ImageIO not to flush after each chunk and flush once at the end instead.
+	query := chunkURL.Query()
+	query.Set("flush", "n")
+	chunkURL.RawQuery = query.Encode()
+
+	return runUploadChunks(
+		u.ctx,
+		u.chunks,
+		u.params.Connections(),
+		func(ctx context.Context, chunk *uploadChunk) error {
+			return retry(
+				fmt.Sprintf(
+					"uploading bytes %d-%d of image for disk %s",
+					chunk.offset,
+					chunk.offset+chunk.length-1,
+					u.Disk().ID(),
+				),
+				u.client.logger,
+				append(u.retries[:len(u.retries):len(u.retries)], ContextStrategy(ctx)),
+				func() error {
+					return u.putChunk(ctx, transfer, chunkURL.String(), chunk)
+				},
+			)
+		},
+	)
+}
+
+// putChunk performs a single HTTP PUT request with a Content-Range header to upload a chunk.
+func (u *oVirtRangedUploadProgress) putChunk(
+	ctx context.Context,
+	transfer imageTransfer,
+	chunkURL string,
+	chunk *uploadChunk,
+) error {
+	chunk.startTry()
+	body := &uploadChunkReader{
+		ctx:    ctx,
+		reader: io.NewSectionReader(u.readerAt, int64(chunk.offset), int64(chunk.length)),
+		chunk:  chunk,
+	}
+	putRequest, err := http.NewRequestWithContext(ctx, http.MethodPut, chunkURL, body)
+	if err != nil {
+		return wrap(err, EUnidentified, "failed to create HTTP request")
+	}
+	putRequest.Header.Add("content-type", "application/octet-stream")
+	putRequest.Header.Add(
+		"content-range",
+		fmt.Sprintf("bytes %d-%d/*", chunk.offset, chunk.offset+chunk.length-1),
+	)
+	putRequest.ContentLength = int64(chunk.length)
+	response, err := u.client.httpClient.Do(putRequest)
+	if err != nil {
+		return wrap(
+			err,
+			EUnidentified,
+			"failed to upload image chunk at offset %d",
+			chunk.offset,
+		)
+	}
+	return u.finishRequest(transfer, response, func() {
+		chunk.complete()
+	})
+}
+
+// flush sends a flush request to ImageIO to make sure all uploaded chunks are written to the storage.
+func (u *oVirtRangedUploadProgress) flush(transfer imageTransfer, transferURL string) error {
+	return retry(
+		fmt.Sprintf("flushing uploaded image for disk %s", u.Disk().ID()),
+		u.client.logger,
+		u.retries,
+		func() error {
+			patchRequest, err := http.NewRequestWithContext(
+				u.ctx,
+				http.MethodPatch,
+				transferURL,
+				strings.NewReader(`{"op":"flush"}`),
+			)
+			if err != nil {
+				return wrap(err, EUnidentified, "failed to create HTTP request")
+			}
+			patchRequest.Header.Add("content-type", "application/json")
+			response, err := u.client.httpClient.Do(patchRequest)
+			if err != nil {
+				return wrap(err, EUnidentified, "failed to flush uploaded image")
+			}
+			return u.finishRequest(transfer, response, func() {})
+		},
+	)
+}
+
+func (u *oVirtRangedUploadProgress) finishRequest(
+	transfer imageTransfer,
+	response *http.Response,
+	onSuccess func(),
+) error {
+	if err := transfer.checkStatusCode(response.StatusCode); err != nil {
+		_ = response.Body.Close()
+		return err
+	}
+	if err := response.Body.Close(); err != nil {
+		return wrap(
+			err,
+			EUnidentified,
+			"failed to close response body while uploading image",
+		)
+	}
+	onSuccess()
+	return nil
+}
+
+func (m *mockClient) RangedUploadToDisk(
+	diskID DiskID,
+	size uint64,
+	reader io.ReadSeekCloser,
+	params RangedUploadParameters,
+	retries ...RetryStrategy,
+) error {
+	progress, err := m.StartRangedUploadToDisk(diskID, size, reader, params, retries...)
+	if err != nil {
+		return err
+	}
+	<-progress.Done()
+	return progress.Err()
+}
+
+func (m *mockClient) StartRangedUploadToDisk(
+	diskID DiskID,
+	size uint64,
+	reader io.ReadSeekCloser,
+	params RangedUploadParameters,
+	retries ...RetryStrategy,
+) (UploadImageProgress, error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	if params == nil {
+		params = RangedUploadParams()
+	}
+	if err := validateRangedUploadParams(params); err != nil {
+		return nil, err
+	}
+	disk, err := m.getDisk(diskID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.checkUploadToDisk(disk, size, reader); err != nil {
+		return nil, err
+	}
+
+	transfer, err := m.getOrCreateImageTransfer(disk, size, params)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	progress := &mockRangedUploadProgress{
+		rangedUploadProgress: newRangedUploadProgress(disk, size, params),
+		client:               m,
+		ctx:                  ctx,
+		cancel:               cancel,
+		disk:                 disk,
+		transfer:             transfer,
+		readerAt:             newUploadReaderAt(reader),
+		params:               params,
+		retries:              retries,
+	}
+	progress.setTransferID(transfer.id)
+	go progress.do()
+	return progress, nil
+}
+
+// checkUploadToDisk checks if the image can be uploaded to the disk in the mock.
+func (m *mockClient) checkUploadToDisk(disk *diskWithData, size uint64, reader io.ReadSeekCloser) error {
+	imageFormat, qcowSize, err := extractQCOWParameters(size, reader)
+	if err != nil {
+		return err
+	}
+	if qcowSize > disk.TotalSize() {
+		return newError(
+			EBadArgument,
+			"the specified size (%d bytes) is larger than the target disk %s (%d bytes)",
+			size,
+			disk.ID(),
+			disk.TotalSize(),
+		)
+	}
+	if imageFormat != disk.Format() {
+		return newError(
+			EBadArgument,
+			"the mock facility doesn't support uploading %s images to %s disks,"+
+				" please upload in the disk format in your tests.",
+			imageFormat,
+			disk.Format(),
+		)
+	}
+	return nil
+}
+
+// getOrCreateImageTransfer returns the existing image transfer if the parameters contain a transfer ID, or locks the
+// disk and creates a new transfer otherwise.
+func (m *mockClient) getOrCreateImageTransfer(
+	disk *diskWithData,
+	size uint64,
+	params RangedUploadParameters,
+) (*mockImageTransfer, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if transferID := params.TransferID(); transferID != nil {
+		transfer, ok := m.imageTransfers[*transferID]
+		if !ok {
+			return nil, newError(ENotFound, "image transfer with ID %s not found", *transferID)
+		}
+		if transfer.diskID != disk.ID() {
+			return nil, newError(
+				EBadArgument,
+				"image transfer belongs to disk %s instead of %s",
+				transfer.diskID,
+				disk.ID(),
+			)
+		}
+		if uint64(len(transfer.data)) != size {
+			return nil, newError(
+				EBadArgument,
+				"image transfer %s was started with a size of %d bytes instead of %d bytes",
+				transfer.id,
+				len(transfer.data),
+				size,
+			)
+		}
+		return transfer, nil
+	}
+
+	// Lock the disk to simulate the upload being initialized.
+	if err := disk.Lock(); err != nil {
+		return nil, err
+	}
+	transfer := &mockImageTransfer{
+		id:     ImageTransferID(m.GenerateUUID()),
+		diskID: disk.ID(),
+		data:   make([]byte, size),
+	}
+	m.imageTransfers[transfer.id] = transfer
+	return transfer, nil
+}
+
+// mockImageTransfer is an image transfer in the mock that has not been finalized yet.
+type mockImageTransfer struct {
+	id     ImageTransferID
+	diskID DiskID
+	data   []byte
+}
+
+type mockRangedUploadProgress struct {
+	*rangedUploadProgress
+
+	client   *mockClient
+	ctx      context.Context
+	cancel   func()
+	disk     *diskWithData
+	transfer *mockImageTransfer
+	readerAt io.ReaderAt
+	params   RangedUploadParameters
+	retries  []RetryStrategy
+}
+
+func (m *mockRangedUploadProgress) do() {
+	defer func() {
+		close(m.done)
+		m.cancel()
+	}()
+
+	err := runUploadChunks(
+		m.ctx,
+		m.chunks,
+		m.params.Connections(),
+		func(ctx context.Context, chunk *uploadChunk) error {
+			return retry(
+				fmt.Sprintf("uploading bytes at offset %d of image for disk %s", chunk.offset, m.disk.ID()),
+				m.client.logger,
+				append(m.retries[:len(m.retries):len(m.retries)], ContextStrategy(ctx)),
+				func() error {
+					return m.copyChunk(chunk)
+				},
+			)
+		},
+	)
+
+	m.client.lock.Lock()
+	defer m.client.lock.Unlock()
+	switch {
+	case err == nil:
+		m.disk.setData(m.transfer.data)
+		delete(m.client.imageTransfers, m.transfer.id)
+		m.disk.Unlock()
+	case m.params.Resumable():
+		// Keep the transfer and the disk locked so the upload can be resumed.
+		err = wrap(
+			err,
+			EUnidentified,
+			"ranged upload to disk %s failed, image transfer %s has been paused and can be resumed",
+			m.disk.ID(),
+			m.transfer.id,
+		)
+	default:
+		delete(m.client.imageTransfers, m.transfer.id)
+		m.disk.Unlock()
+	}
+
+	m.lock.Lock()
+	m.err = err
+	m.lock.Unlock()
+}
+
+func (m *mockRangedUploadProgress) copyChunk(chunk *uploadChunk) error {
+	chunk.startTry()
+	buf := make([]byte, chunk.length)
+	reader := &uploadChunkReader{
+		ctx:    m.ctx,
+		reader: io.NewSectionReader(m.readerAt, int64(chunk.offset), int64(chunk.length)),
+		chunk:  chunk,
+	}
+	if _, err := io.ReadFull(reader, buf); err != nil {
+		return wrap(err, ELocalIO, "failed to read image chunk at offset %d", chunk.offset)
+	}
+	m.client.lock.Lock()
+	copy(m.transfer.data[chunk.offset:], buf)
+	m.client.lock.Unlock()
+	chunk.complete()
+	return nil
+}
+
+// rangedUploadProgress holds the progress information shared between the engine and the mock implementation of
+// ranged uploads.
+type rangedUploadProgress struct {
+	lock       *sync.Mutex
+	done       chan struct{}
+	disk       Disk
+	transferID ImageTransferID
+	chunks     []*uploadChunk
+	totalBytes uint64
+	startTime  time.Time
+	err        error
+}
+
+// validateRangedUploadParams checks the parameters that are not validated when RangedUploadParameters is
+// implemented outside the builder. A zero chunk size or connection count would make the upload hang.
+func validateRangedUploadParams(params RangedUploadParameters) error {
+	if params.ChunkSize() == 0 {
+		return newError(EBadArgument, "chunk size must be positive")
+	}
+	if params.Connections() == 0 {
+		return newError(EBadArgument, "at least one connection is required")
+	}
+	return nil
+}
+
+func newRangedUploadProgress(disk Disk, size uint64, params RangedUploadParameters) *rangedUploadProgress {
+	return &rangedUploadProgress{
+		lock:       &sync.Mutex{},
+		done:       make(chan struct{}),
+		disk:       disk,
+		chunks:     newUploadChunks(size, params.ChunkSize(), params.CompletedRanges()),
+		totalBytes: size,
+		startTime:  time.Now(),
+	}
+}
+
+func (r *rangedUploadProgress) updateDisk(disk Disk) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.disk = disk
+}
+
+func (r *rangedUploadProgress) setTransferID(transferID ImageTransferID) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.transferID = transferID
+}
+
+func (r *rangedUploadProgress) Disk() Disk {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.disk
+}
+
+func (r *rangedUploadProgress) UploadedBytes() uint64 {
+	uploadedBytes := uint64(0)
+	for _, chunk := range r.chunks {
+		uploadedBytes += chunk.UploadedBytes()
+	}
+	return uploadedBytes
+}
+
+func (r *rangedUploadProgress) TotalBytes() uint64 {
+	return r.totalBytes
+}
+
+func (r *rangedUploadProgress) Err() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.err
+}
+
+func (r *rangedUploadProgress) Done() <-chan struct{} {
+	return r.done
+}
+
+func (r *rangedUploadProgress) TransferID() ImageTransferID {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.transferID
+}
+
+func (r *rangedUploadProgress) Chunks() []UploadChunkProgress {
+	result := make([]UploadChunkProgress, len(r.chunks))
+	for i, chunk := range r.chunks {
+		result[i] = chunk
+	}
+	return result
+}
+
+func (r *rangedUploadProgress) Throughput() uint64 {
+	// Chunks completed in a previous attempt do not count towards the throughput.
+	uploadedBytes := uint64(0)
+	for _, chunk := range r.chunks {
+		if !chunk.skipped {
+			uploadedBytes += chunk.UploadedBytes()
+		}
+	}
+	return calculateThroughput(uploadedBytes, r.startTime)
+}
+
+// runUploadChunks uploads all chunks that are not completed using the specified number of concurrent workers. It
+// stops scheduling new chunks after the first failure and returns the first error.
+func runUploadChunks(
+	ctx context.Context,
+	chunks []*uploadChunk,
+	connections uint,
+	upload func(ctx context.Context, chunk *uploadChunk) error,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var firstErr error
+	errLock := &sync.Mutex{}
+	work := make(chan *uploadChunk)
+	wg := &sync.WaitGroup{}
+	for i := uint(0); i < connections; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for chunk := range work {
+				if err := upload(ctx, chunk); err != nil {
+					errLock.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					errLock.Unlock()
+					cancel()
+				}
+			}
+		}()
+	}
+
+dispatch:
+	for _, chunk := range chunks {
+		if chunk.Completed() {
+			continue
+		}
+		select {
+		case work <- chunk:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(work)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	for _, chunk := range chunks {
+		if !chunk.Completed() {
+			// This happens if the parent context is cancelled before all chunks are scheduled.
+			return newError(ETimeout, "image upload cancelled before chunk at offset %d was uploaded", chunk.offset)
+		}
+	}
+	return nil
+}
+
+// newUploadChunks splits an image of the specified size into chunks. Chunks that are fully contained in one of the
+// completed ranges are marked as completed.
+func newUploadChunks(size uint64, chunkSize uint64, completedRanges []ImageRange) []*uploadChunk {
+	var chunks []*uploadChunk
+	for offset := uint64(0); offset < size; offset += chunkSize {
+		length := chunkSize
+		if offset+length > size {
+			length = size - offset
+		}
+		chunk := &uploadChunk{
+			lock:   &sync.Mutex{},
+			offset: offset,
+			length: length,
+		}
+		for _, completedRange := range completedRanges {
+			if completedRange.Start <= offset && completedRange.End >= offset+length {
+				chunk.completed = true
+				chunk.skipped = true
+				chunk.uploadedBytes = length
+				break
+			}
+		}
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
+// uploadChunk is the implementation of UploadChunkProgress.
+type uploadChunk struct {
+	lock          *sync.Mutex
+	offset        uint64
+	length        uint64
+	uploadedBytes uint64
+	completed     bool
+	// skipped indicates that the chunk was completed in a previous upload attempt.
+	skipped bool
+	tries   uint
+}
+
+func (u *uploadChunk) Offset() uint64 {
+	return u.offset
+}
+
+func (u *uploadChunk) Length() uint64 {
+	return u.length
+}
+
+func (u *uploadChunk) UploadedBytes() uint64 {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	return u.uploadedBytes
+}
+
+func (u *uploadChunk) Completed() bool {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	return u.completed
+}
+
+func (u *uploadChunk) Tries() uint {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	return u.tries
+}
+
+func (u *uploadChunk) startTry() {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	u.tries++
+	u.uploadedBytes = 0
+}
+
+func (u *uploadChunk) addUploadedBytes(n int) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	u.uploadedBytes += uint64(n)
+}
+
+func (u *uploadChunk) complete() {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	u.uploadedBytes = u.length
+	u.completed = true
+}
+
+// uploadChunkReader reads the data of a chunk and records the progress.
+type uploadChunkReader struct {
+	ctx    context.Context
+	reader io.Reader
+	chunk  *uploadChunk
+}
+
+func (u *uploadChunkReader) Read(p []byte) (n int, err error) {
+	select {
+	case <-u.ctx.Done():
+		return 0, newError(ETimeout, "timeout while uploading image")
+	default:
+	}
+	n, err = u.reader.Read(p)
+	u.chunk.addUploadedBytes(n)
+	return
+}
+
+// newUploadReaderAt returns the reader itself if it supports io.ReaderAt, otherwise it wraps the reader to serialize
+// access through seeking.
+func newUploadReaderAt(reader io.ReadSeekCloser) io.ReaderAt {
+	if readerAt, ok := reader.(io.ReaderAt); ok {
+		return readerAt
+	}
+	return &seekingReaderAt{
+		lock:   &sync.Mutex{},
+		reader: reader,
+	}
+}
+
+// seekingReaderAt implements io.ReaderAt on top of an io.ReadSeeker by seeking before each read.
+type seekingReaderAt struct {
+	lock   *sync.Mutex
+	reader io.ReadSeeker
+}
+
+func (s *seekingReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, err := s.reader.Seek(off, io.SeekStart); err != nil {
+		return 0, err
+	}
+	n, err := io.ReadFull(s.reader, p)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// calculateThroughput returns the average number of bytes per second since the start time.
+func calculateThroughput(bytes uint64, startTime time.Time) uint64 {
+	elapsed := time.Since(startTime).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+	return uint64(float64(bytes) / elapsed)
+}
@@ -0,0 +1,210 @@
+package ovirtclient_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestRangedImageUpload(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	fh, size := getTestImageFile(t)
+	testImageData, _ := getTestImageData(t)
+
+	disk := assertCanCreateDisk(t, helper)
+
+	progress, err := client.StartRangedUploadToDisk(
+		disk.ID(),
+		size,
+		fh,
+		ovirtclient.RangedUploadParams().MustWithChunkSize(128).MustWithConnections(3),
+	)
+	if err != nil {
+		t.Fatalf("Failed to start ranged upload to disk %s (%v)", disk.ID(), err)
+	}
+	<-progress.Done()
+	if err := progress.Err(); err != nil {
+		t.Fatalf("Ranged upload to disk %s failed (%v)", disk.ID(), err)
+	}
+
+	chunks := progress.Chunks()
+	if expected := int((size + 127) / 128); len(chunks) != expected {
+		t.Fatalf("Incorrect number of chunks (expected: %d, got: %d)", expected, len(chunks))
+	}
+	for _, chunk := range chunks {
+		if !chunk.Completed() {
+			t.Fatalf("Chunk at offset %d is not completed after a successful upload.", chunk.Offset())
+		}
+	}
+	if progress.UploadedBytes() != size {
+		t.Fatalf("Incorrect number of uploaded bytes (expected: %d, got: %d)", size, progress.UploadedBytes())
+	}
+	if progress.TransferID() == "" {
+		t.Fatalf("No transfer ID returned after upload.")
+	}
+
+	data := downloadImage(t, client, progress)
+	if !bytes.Equal(data[:len(testImageData)], testImageData) {
+		t.Fatal(fmt.Errorf("the downloaded image did not match the original upload"))
+	}
+}
+
+func TestRangedImageUploadResume(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	if _, ok := helper.GetClient().(ovirtclient.MockClient); !ok {
+		t.Skip("Interrupting an upload reliably is only possible with the mock client.")
+	}
+	client := helper.GetClient()
+	testImageData, size := getTestImageData(t)
+
+	disk := assertCanCreateDisk(t, helper)
+
+	failingReader := &failingReadSeeker{bytes.NewReader(testImageData), 256}
+	progress, err := client.StartRangedUploadToDisk(
+		disk.ID(),
+		size,
+		failingReader,
+		ovirtclient.RangedUploadParams().MustWithChunkSize(128).MustWithConnections(1).MustWithResumable(true),
+		ovirtclient.MaxTries(1),
+	)
+	if err != nil {
+		t.Fatalf("Failed to start ranged upload to disk %s (%v)", disk.ID(), err)
+	}
+	<-progress.Done()
+	if progress.Err() == nil {
+		t.Fatalf("Upload with a failing reader did not fail.")
+	}
+	var completedRanges []ovirtclient.ImageRange
+	for _, chunk := range progress.Chunks() {
+		if chunk.Completed() {
+			completedRanges = append(
+				completedRanges,
+				ovirtclient.ImageRange{Start: chunk.Offset(), End: chunk.Offset() + chunk.Length()},
+			)
+		}
+	}
+	if len(completedRanges) != 2 {
+		t.Fatalf("Incorrect number of completed chunks before the failure (expected: 2, got: %d)", len(completedRanges))
+	}
+
+	fh, _ := getTestImageFile(t)
+	resumed, err := client.StartRangedUploadToDisk(
+		disk.ID(),
+		size,
+		fh,
+		ovirtclient.RangedUploadParams().
+			MustWithChunkSize(128).
+			MustWithTransferID(progress.TransferID()).
+			MustWithCompletedRanges(completedRanges),
+	)
+	if err != nil {
+		t.Fatalf("Failed to resume ranged upload to disk %s (%v)", disk.ID(), err)
+	}
+	<-resumed.Done()
+	if err := resumed.Err(); err != nil {
+		t.Fatalf("Resumed upload to disk %s failed (%v)", disk.ID(), err)
+	}
+	for _, chunk := range resumed.Chunks() {
+		if chunk.Offset() < 256 && chunk.Tries() != 0 {
+			t.Fatalf("Chunk at offset %d was uploaded again despite being completed.", chunk.Offset())
+		}
+	}
+
+	data := downloadImage(t, client, resumed)
+	if !bytes.Equal(data[:len(testImageData)], testImageData) {
+		t.Fatal(fmt.Errorf("the downloaded image did not match the original upload"))
+	}
+}
+
+func TestRangedImageUploadResumeNonExistentTransfer(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	fh, size := getTestImageFile(t)
+
+	disk := assertCanCreateDisk(t, helper)
+
+	err := client.RangedUploadToDisk(
+		disk.ID(),
+		size,
+		fh,
+		ovirtclient.RangedUploadParams().MustWithTransferID(ovirtclient.ImageTransferID(helper.GenerateRandomID(5))),
+	)
+	if err == nil {
+		t.Fatalf("Resuming a non-existent image transfer did not result in an error.")
+	}
+}
+
+func TestRangedImageUploadRejectsZeroChunkSizeAndConnections(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	fh, size := getTestImageFile(t)
+
+	disk := assertCanCreateDisk(t, helper)
+
+	for name, params := range map[string]*customRangedUploadParams{
+		"chunk size":  {chunkSize: 0, connections: 1},
+		"connections": {chunkSize: 128, connections: 0},
+	} {
+		_, err := client.StartRangedUploadToDisk(disk.ID(), size, fh, params)
+		if err == nil {
+			t.Fatalf("Starting a ranged upload with a zero %s did not result in an error.", name)
+		}
+		if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+			t.Fatalf("Starting a ranged upload with a zero %s did not result in an EBadArgument error (%v).", name, err)
+		}
+	}
+}
+
+// customRangedUploadParams implements RangedUploadParameters without the validation in the builder.
+type customRangedUploadParams struct {
+	chunkSize   uint64
+	connections uint
+}
+
+func (c *customRangedUploadParams) ChunkSize() uint64 {
+	return c.chunkSize
+}
+
+func (c *customRangedUploadParams) Connections() uint {
+	return c.connections
+}
+
+func (c *customRangedUploadParams) TransferID() *ovirtclient.ImageTransferID {
+	return nil
+}
+
+func (c *customRangedUploadParams) CompletedRanges() []ovirtclient.ImageRange {
+	return nil
+}
+
+func (c *customRangedUploadParams) Resumable() bool {
+	return false
+}
+
+// failingReadSeeker returns an error when reading beyond the specified offset.
+type failingReadSeeker struct {
+	io.ReadSeeker
+	failAt int64
+}
+
+func (f *failingReadSeeker) Close() error {
+	return nil
+}
+
+func (f *failingReadSeeker) ReadAt(p []byte, off int64) (int, error) {
+	if off+int64(len(p)) > f.failAt {
+		return 0, fmt.Errorf("simulated read failure at offset %d", off)
+	}
+	if _, err := f.Seek(off, io.SeekStart); err != nil {
+		return 0, err
+	}
+	return io.ReadFull(f.ReadSeeker, p)
+}
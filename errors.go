@@ -87,16 +87,23 @@ const ELocalIO ErrorCode = "local_io_error"
 // conflicting way. For example, you tried to attach a disk that is already attached.
 const EConflict ErrorCode = "conflict"
 
-// EHotPlugFailed indicates that a disk could not be hot plugged.
+// EHotPlugFailed indicates that a disk or NIC could not be hot plugged or unplugged.
 const EHotPlugFailed ErrorCode = "hot_plug_failed"
 
 // EInvalidGrant is an error returned from the oVirt Engine when the SSO token expired. In this case we must reconnect
 // and retry the API call.
 const EInvalidGrant ErrorCode = "invalid_grant"
 
+// EThrottled indicates that the oVirt Engine responded with HTTP 429 or 503 and asked the client to retry later with
+// a Retry-After header. The retry function waits at least as long as requested before retrying.
+const EThrottled ErrorCode = "throttled"
+
 // ECannotRunVM indicates an error with the VM configuration which prevents it from being run.
 const ECannotRunVM ErrorCode = "cannot_run_vm"
 
+// EBackupFailed indicates that a VM backup has entered the failed phase.
+const EBackupFailed ErrorCode = "backup_failed"
+
 // CanRecover returns true if there is a way to automatically recoverFailure from this error. For the actual recovery an
 // appropriate recovery strategy must be passed to the retry function.
 func (e ErrorCode) CanRecover() bool {
@@ -137,6 +144,8 @@ func (e ErrorCode) CanAutoRetry() bool {
 		return false
 	case ECannotRunVM:
 		return false
+	case EBackupFailed:
+		return false
 	default:
 		return true
 	}
@@ -270,7 +279,10 @@ func wrap(err error, code ErrorCode, format string, args ...interface{}) EngineE
 func realIdentify(err error) EngineError {
 	var authErr *ovirtsdk.AuthError
 	var notFoundErr *ovirtsdk.NotFoundError
+	var retryAfterErr *retryAfterError
 	switch {
+	case errors.As(err, &retryAfterErr):
+		return wrap(err, EThrottled, "the oVirt Engine is overloaded")
 	case strings.Contains(err.Error(), "Cannot run VM without at least one bootable disk."):
 		return wrap(
 			err,
@@ -315,6 +327,10 @@ func realIdentify(err error) EngineError {
 		return wrap(err, EVMLocked, "the VM is locked")
 	case strings.Contains(err.Error(), "Failed to hot-plug disk"):
 		return wrap(err, EHotPlugFailed, "failed to hot-plug disk")
+	case strings.Contains(err.Error(), "Failed to activate VM Network Interface"):
+		return wrap(err, EHotPlugFailed, "failed to hot-plug NIC")
+	case strings.Contains(err.Error(), "Failed to deactivate VM Network Interface"):
+		return wrap(err, EHotPlugFailed, "failed to hot-unplug NIC")
 	case strings.Contains(err.Error(), "Related operation is currently in progress."):
 		return wrap(err, ERelatedOperationInProgress, "a related operation is in progress")
 	case strings.Contains(err.Error(), "Disk configuration") && strings.Contains(err.Error(), " is incompatible with the storage domain type."):
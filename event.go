@@ -0,0 +1,506 @@
+package ovirtclient
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// EventID is the identifier of an event in the engine's audit log. Event IDs are numeric and increase with each new
+// event.
+type EventID string
+
+// EventClient contains the functions for reading the engine's audit log events.
+type EventClient interface {
+	// ListEvents lists the events matching the parameters, ordered from the oldest to the newest. If params is nil,
+	// all events are returned.
+	ListEvents(params EventListParameters, retries ...RetryStrategy) ([]Event, error)
+	// WatchEvents polls the engine for new events matching the parameters and sends them on the returned channel in
+	// the order they occurred. If no SinceID is specified only events occurring after the call are sent. Each event
+	// is sent only once. Errors while polling are logged and polling continues, reconnecting to the engine if
+	// required. The channel is closed when ctx is cancelled.
+	//
+	// The retry strategies are applied to each poll. If none are passed, the default read timeouts bound by ctx
+	// are used.
+	WatchEvents(ctx context.Context, params EventListParameters, retries ...RetryStrategy) (<-chan Event, error)
+}
+
+// Event is a single entry in the engine's audit log.
+type Event interface {
+	// ID returns the identifier of the event.
+	ID() EventID
+	// Index returns the position of the event in the audit log. Newer events have a higher index.
+	Index() int64
+	// Code returns the audit log type of the event, which identifies the kind of event.
+	Code() int64
+	// Severity returns the severity of the event.
+	Severity() EventSeverity
+	// Description returns the human-readable description of the event.
+	Description() string
+	// Time returns the time the event occurred.
+	Time() time.Time
+	// CorrelationID returns the correlation ID of the operation that triggered the event, or an empty string.
+	CorrelationID() string
+	// VMID returns the ID of the VM the event relates to, if any.
+	VMID() *VMID
+	// HostID returns the ID of the host the event relates to, if any.
+	HostID() *HostID
+	// ClusterID returns the ID of the cluster the event relates to, if any.
+	ClusterID() *ClusterID
+	// StorageDomainID returns the ID of the storage domain the event relates to, if any.
+	StorageDomainID() *StorageDomainID
+	// TemplateID returns the ID of the template the event relates to, if any.
+	TemplateID() *TemplateID
+}
+
+// EventSeverity is the severity of an event.
+type EventSeverity string
+
+const (
+	// EventSeverityNormal indicates an informational event.
+	EventSeverityNormal EventSeverity = "normal"
+	// EventSeverityWarning indicates an event that may require attention.
+	EventSeverityWarning EventSeverity = "warning"
+	// EventSeverityError indicates a failure.
+	EventSeverityError EventSeverity = "error"
+	// EventSeverityAlert indicates a failure that requires immediate attention.
+	EventSeverityAlert EventSeverity = "alert"
+)
+
+// EventSeverityList is a list of EventSeverity.
+type EventSeverityList []EventSeverity
+
+// EventSeverityValues returns all possible EventSeverity values, ordered from the least to the most severe.
+func EventSeverityValues() EventSeverityList {
+	return []EventSeverity{
+		EventSeverityNormal,
+		EventSeverityWarning,
+		EventSeverityError,
+		EventSeverityAlert,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l EventSeverityList) Strings() []string {
+	result := make([]string, len(l))
+	for i, severity := range l {
+		result[i] = string(severity)
+	}
+	return result
+}
+
+// Validate returns an error if the event severity doesn't have a valid value.
+func (s EventSeverity) Validate() error {
+	if s.rank() < 0 {
+		return newError(
+			EBadArgument,
+			"invalid event severity: %s must be one of: %s",
+			s,
+			strings.Join(EventSeverityValues().Strings(), ", "),
+		)
+	}
+	return nil
+}
+
+// AtLeast returns true if the severity is equal to or more severe than the other severity.
+func (s EventSeverity) AtLeast(other EventSeverity) bool {
+	return s.rank() >= other.rank()
+}
+
+func (s EventSeverity) rank() int {
+	for i, severity := range EventSeverityValues() {
+		if severity == s {
+			return i
+		}
+	}
+	return -1
+}
+
+// EventListParameters contains the filters for listing and watching events. Each filter is a pointer, where a nil
+// value means the events are not filtered by that field. All filters are combined as an AND filter.
+type EventListParameters interface {
+	// MinimumSeverity filters for events at or above the specified severity.
+	MinimumSeverity() *EventSeverity
+	// VMID filters for events relating to the specified VM.
+	VMID() *VMID
+	// HostID filters for events relating to the specified host.
+	HostID() *HostID
+	// ClusterID filters for events relating to the specified cluster.
+	ClusterID() *ClusterID
+	// SinceID filters for events newer than the event with the specified ID.
+	SinceID() *EventID
+	// PollInterval returns the time WatchEvents waits between polls. It has no effect on ListEvents.
+	PollInterval() time.Duration
+}
+
+// BuildableEventListParameters is a buildable version of EventListParameters.
+type BuildableEventListParameters interface {
+	EventListParameters
+
+	// WithMinimumSeverity sets the minimum severity of the returned events.
+	WithMinimumSeverity(severity EventSeverity) (BuildableEventListParameters, error)
+	// MustWithMinimumSeverity is identical to WithMinimumSeverity, but panics instead of returning an error.
+	MustWithMinimumSeverity(severity EventSeverity) BuildableEventListParameters
+
+	// WithVMID sets the VM the returned events must relate to.
+	WithVMID(vmID VMID) (BuildableEventListParameters, error)
+	// MustWithVMID is identical to WithVMID, but panics instead of returning an error.
+	MustWithVMID(vmID VMID) BuildableEventListParameters
+
+	// WithHostID sets the host the returned events must relate to.
+	WithHostID(hostID HostID) (BuildableEventListParameters, error)
+	// MustWithHostID is identical to WithHostID, but panics instead of returning an error.
+	MustWithHostID(hostID HostID) BuildableEventListParameters
+
+	// WithClusterID sets the cluster the returned events must relate to.
+	WithClusterID(clusterID ClusterID) (BuildableEventListParameters, error)
+	// MustWithClusterID is identical to WithClusterID, but panics instead of returning an error.
+	MustWithClusterID(clusterID ClusterID) BuildableEventListParameters
+
+	// WithSinceID sets the event after which events are returned.
+	WithSinceID(eventID EventID) (BuildableEventListParameters, error)
+	// MustWithSinceID is identical to WithSinceID, but panics instead of returning an error.
+	MustWithSinceID(eventID EventID) BuildableEventListParameters
+
+	// WithPollInterval sets the time WatchEvents waits between polls.
+	WithPollInterval(interval time.Duration) (BuildableEventListParameters, error)
+	// MustWithPollInterval is identical to WithPollInterval, but panics instead of returning an error.
+	MustWithPollInterval(interval time.Duration) BuildableEventListParameters
+}
+
+// EventListParams creates a buildable set of parameters for ListEvents and WatchEvents. The default poll interval
+// is 5 seconds.
+func EventListParams() BuildableEventListParameters {
+	return &eventListParams{
+		lock:         &sync.Mutex{},
+		pollInterval: 5 * time.Second,
+	}
+}
+
+type eventListParams struct {
+	lock *sync.Mutex
+
+	minimumSeverity *EventSeverity
+	vmID            *VMID
+	hostID          *HostID
+	clusterID       *ClusterID
+	sinceID         *EventID
+	pollInterval    time.Duration
+}
+
+func (e *eventListParams) MinimumSeverity() *EventSeverity {
+	return e.minimumSeverity
+}
+
+func (e *eventListParams) VMID() *VMID {
+	return e.vmID
+}
+
+func (e *eventListParams) HostID() *HostID {
+	return e.hostID
+}
+
+func (e *eventListParams) ClusterID() *ClusterID {
+	return e.clusterID
+}
+
+func (e *eventListParams) SinceID() *EventID {
+	return e.sinceID
+}
+
+func (e *eventListParams) PollInterval() time.Duration {
+	return e.pollInterval
+}
+
+func (e *eventListParams) WithMinimumSeverity(severity EventSeverity) (BuildableEventListParameters, error) {
+	if err := severity.Validate(); err != nil {
+		return nil, err
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.minimumSeverity = &severity
+	return e, nil
+}
+
+func (e *eventListParams) MustWithMinimumSeverity(severity EventSeverity) BuildableEventListParameters {
+	builder, err := e.WithMinimumSeverity(severity)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (e *eventListParams) WithVMID(vmID VMID) (BuildableEventListParameters, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.vmID = &vmID
+	return e, nil
+}
+
+func (e *eventListParams) MustWithVMID(vmID VMID) BuildableEventListParameters {
+	builder, err := e.WithVMID(vmID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (e *eventListParams) WithHostID(hostID HostID) (BuildableEventListParameters, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.hostID = &hostID
+	return e, nil
+}
+
+func (e *eventListParams) MustWithHostID(hostID HostID) BuildableEventListParameters {
+	builder, err := e.WithHostID(hostID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (e *eventListParams) WithClusterID(clusterID ClusterID) (BuildableEventListParameters, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.clusterID = &clusterID
+	return e, nil
+}
+
+func (e *eventListParams) MustWithClusterID(clusterID ClusterID) BuildableEventListParameters {
+	builder, err := e.WithClusterID(clusterID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (e *eventListParams) WithSinceID(eventID EventID) (BuildableEventListParameters, error) {
+	if _, err := eventIndex(eventID); err != nil {
+		return nil, err
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.sinceID = &eventID
+	return e, nil
+}
+
+func (e *eventListParams) MustWithSinceID(eventID EventID) BuildableEventListParameters {
+	builder, err := e.WithSinceID(eventID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (e *eventListParams) WithPollInterval(interval time.Duration) (BuildableEventListParameters, error) {
+	if interval <= 0 {
+		return nil, newError(EBadArgument, "the poll interval must be positive (got: %s)", interval)
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.pollInterval = interval
+	return e, nil
+}
+
+func (e *eventListParams) MustWithPollInterval(interval time.Duration) BuildableEventListParameters {
+	builder, err := e.WithPollInterval(interval)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// eventIndex converts an event ID into the numeric index used by the engine for the from parameter.
+func eventIndex(eventID EventID) (int64, error) {
+	index, err := strconv.ParseInt(string(eventID), 10, 64)
+	if err != nil || index < 0 {
+		return 0, newError(EBadArgument, "invalid event ID: %s, event IDs must be non-negative numbers", eventID)
+	}
+	return index, nil
+}
+
+// eventSinceIndex returns the index after which events should be returned based on the parameters, or -1 if all
+// events should be returned.
+func eventSinceIndex(params EventListParameters) (int64, error) {
+	if params == nil || params.SinceID() == nil {
+		return -1, nil
+	}
+	return eventIndex(*params.SinceID())
+}
+
+// withEventSinceIndex returns a copy of the parameters that only matches events after the specified index.
+func withEventSinceIndex(params EventListParameters, index int64) EventListParameters {
+	sinceID := EventID(strconv.FormatInt(index, 10))
+	result := &eventListParams{
+		lock:    &sync.Mutex{},
+		sinceID: &sinceID,
+	}
+	if params != nil {
+		result.minimumSeverity = params.MinimumSeverity()
+		result.vmID = params.VMID()
+		result.hostID = params.HostID()
+		result.clusterID = params.ClusterID()
+		result.pollInterval = params.PollInterval()
+	}
+	return result
+}
+
+// eventSearch returns the engine search query for the filters the engine can apply itself. The engine can only
+// search events by the names of related objects, so the ID filters are applied by eventMatches.
+func eventSearch(params EventListParameters) string {
+	if params == nil || params.MinimumSeverity() == nil {
+		return ""
+	}
+	var conditions []string
+	for _, severity := range EventSeverityValues() {
+		if severity.AtLeast(*params.MinimumSeverity()) {
+			conditions = append(conditions, "severity="+string(severity))
+		}
+	}
+	return strings.Join(conditions, " or ")
+}
+
+// eventMatches returns true if the event matches the filters of the parameters, except SinceID.
+func eventMatches(e Event, params EventListParameters) bool {
+	if params == nil {
+		return true
+	}
+	if severity := params.MinimumSeverity(); severity != nil && !e.Severity().AtLeast(*severity) {
+		return false
+	}
+	if vmID := params.VMID(); vmID != nil && (e.VMID() == nil || *e.VMID() != *vmID) {
+		return false
+	}
+	if hostID := params.HostID(); hostID != nil && (e.HostID() == nil || *e.HostID() != *hostID) {
+		return false
+	}
+	if clusterID := params.ClusterID(); clusterID != nil && (e.ClusterID() == nil || *e.ClusterID() != *clusterID) {
+		return false
+	}
+	return true
+}
+
+func convertSDKEvent(sdkObject *ovirtsdk.Event) (Event, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("event", "id")
+	}
+	index, ok := sdkObject.Index()
+	if !ok {
+		return nil, newFieldNotFound("event", "index")
+	}
+	code, ok := sdkObject.Code()
+	if !ok {
+		return nil, newFieldNotFound("event", "code")
+	}
+	severity, ok := sdkObject.Severity()
+	if !ok {
+		return nil, newFieldNotFound("event", "severity")
+	}
+	description, _ := sdkObject.Description()
+	eventTime, _ := sdkObject.Time()
+	correlationID, _ := sdkObject.CorrelationId()
+	result := &event{
+		id:            EventID(id),
+		index:         index,
+		code:          code,
+		severity:      EventSeverity(severity),
+		description:   description,
+		time:          eventTime,
+		correlationID: correlationID,
+	}
+	if sdkVM, ok := sdkObject.Vm(); ok {
+		if vmID, ok := sdkVM.Id(); ok {
+			result.vmID = (*VMID)(&vmID)
+		}
+	}
+	if sdkHost, ok := sdkObject.Host(); ok {
+		if hostID, ok := sdkHost.Id(); ok {
+			result.hostID = (*HostID)(&hostID)
+		}
+	}
+	if sdkCluster, ok := sdkObject.Cluster(); ok {
+		if clusterID, ok := sdkCluster.Id(); ok {
+			result.clusterID = (*ClusterID)(&clusterID)
+		}
+	}
+	if sdkStorageDomain, ok := sdkObject.StorageDomain(); ok {
+		if storageDomainID, ok := sdkStorageDomain.Id(); ok {
+			result.storageDomainID = (*StorageDomainID)(&storageDomainID)
+		}
+	}
+	if sdkTemplate, ok := sdkObject.Template(); ok {
+		if templateID, ok := sdkTemplate.Id(); ok {
+			result.templateID = (*TemplateID)(&templateID)
+		}
+	}
+	return result, nil
+}
+
+type event struct {
+	id              EventID
+	index           int64
+	code            int64
+	severity        EventSeverity
+	description     string
+	time            time.Time
+	correlationID   string
+	vmID            *VMID
+	hostID          *HostID
+	clusterID       *ClusterID
+	storageDomainID *StorageDomainID
+	templateID      *TemplateID
+}
+
+func (e *event) ID() EventID {
+	return e.id
+}
+
+func (e *event) Index() int64 {
+	return e.index
+}
+
+func (e *event) Code() int64 {
+	return e.code
+}
+
+func (e *event) Severity() EventSeverity {
+	return e.severity
+}
+
+func (e *event) Description() string {
+	return e.description
+}
+
+func (e *event) Time() time.Time {
+	return e.time
+}
+
+func (e *event) CorrelationID() string {
+	return e.correlationID
+}
+
+func (e *event) VMID() *VMID {
+	return e.vmID
+}
+
+func (e *event) HostID() *HostID {
+	return e.hostID
+}
+
+func (e *event) ClusterID() *ClusterID {
+	return e.clusterID
+}
+
+func (e *event) StorageDomainID() *StorageDomainID {
+	return e.storageDomainID
+}
+
+func (e *event) TemplateID() *TemplateID {
+	return e.templateID
+}
@@ -0,0 +1,103 @@
+package ovirtclient
+
+import (
+	"sort"
+)
+
+func (o *oVirtClient) ListEvents(params EventListParameters, retries ...RetryStrategy) (result []Event, err error) {
+	result, _, err = o.listEvents(params, retries)
+	return result, err
+}
+
+// listEvents lists the events matching the parameters. It also returns the highest event index the engine returned,
+// including events that did not match the filters, or the since index of the parameters if there were no events.
+func (o *oVirtClient) listEvents(
+	params EventListParameters,
+	retries []RetryStrategy,
+) (result []Event, lastIndex int64, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	sinceIndex, err := eventSinceIndex(params)
+	if err != nil {
+		return nil, 0, err
+	}
+	result = []Event{}
+	lastIndex = sinceIndex
+	err = retry(
+		"listing events",
+		o.logger,
+		retries,
+		func() error {
+			request := o.conn.SystemService().EventsService().List()
+			if sinceIndex >= 0 {
+				request.From(sinceIndex)
+			}
+			if search := eventSearch(params); search != "" {
+				request.Search(search)
+			}
+			response, e := request.Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Events()
+			if !ok {
+				return nil
+			}
+			result = make([]Event, 0, len(sdkObjects.Slice()))
+			lastIndex = sinceIndex
+			for i, sdkObject := range sdkObjects.Slice() {
+				item, e := convertSDKEvent(sdkObject)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert event during listing item #%d", i)
+				}
+				if item.Index() > lastIndex {
+					lastIndex = item.Index()
+				}
+				// The engine treats from as a hint and cannot search by ID, so we filter again.
+				if item.Index() > sinceIndex && eventMatches(item, params) {
+					result = append(result, item)
+				}
+			}
+			sort.SliceStable(result, func(i, j int) bool {
+				return result[i].Index() < result[j].Index()
+			})
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) ListEvents(params EventListParameters, retries ...RetryStrategy) ([]Event, error) {
+	result, _, err := m.listEvents(params, retries)
+	return result, err
+}
+
+func (m *mockClient) listEvents(
+	params EventListParameters,
+	retries []RetryStrategy,
+) (result []Event, lastIndex int64, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	sinceIndex, err := eventSinceIndex(params)
+	if err != nil {
+		return nil, 0, err
+	}
+	err = retry(
+		"listing events",
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			result = []Event{}
+			lastIndex = sinceIndex
+			for _, item := range m.events.items {
+				if item.index > lastIndex {
+					lastIndex = item.index
+				}
+				if item.index > sinceIndex && eventMatches(item, params) {
+					result = append(result, item)
+				}
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,52 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// The mock events use the codes of the matching audit log types of the engine.
+const (
+	mockEventCodeVMStarted int64 = 32
+	mockEventCodeVMStopped int64 = 33
+	mockEventCodeVMCreated int64 = 34
+	mockEventCodeVMDown    int64 = 61
+	mockEventCodeVMRemoved int64 = 113
+)
+
+// mockEventLog stores the events emitted by the mock client. It is shared between all copies of the mock client
+// created by WithContext.
+type mockEventLog struct {
+	items     []*event
+	nextIndex int64
+}
+
+func newMockEventLog() *mockEventLog {
+	return &mockEventLog{
+		nextIndex: 1,
+	}
+}
+
+// addVMEvent records an event for the specified VM. The caller must hold the lock of the mock client.
+func (m *mockClient) addVMEvent(item *vm, code int64, format string, args ...interface{}) {
+	vmID := item.id
+	clusterID := item.clusterID
+	index := m.events.nextIndex
+	m.events.nextIndex++
+	e := &event{
+		id:          EventID(strconv.FormatInt(index, 10)),
+		index:       index,
+		code:        code,
+		severity:    EventSeverityNormal,
+		description: fmt.Sprintf(format, args...),
+		time:        time.Now(),
+		vmID:        &vmID,
+		clusterID:   &clusterID,
+	}
+	if item.hostID != nil {
+		hostID := *item.hostID
+		e.hostID = &hostID
+	}
+	m.events.items = append(m.events.items, e)
+}
@@ -0,0 +1,83 @@
+package ovirtclient_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestWatchEventsReceivesVMEvents(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	events, err := client.WatchEvents(
+		ctx,
+		ovirtclient.EventListParams().
+			MustWithClusterID(helper.GetClusterID()).
+			MustWithPollInterval(100*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("Failed to start watching events (%v)", err)
+	}
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	assertEventReceived(t, events, vm.ID())
+}
+
+func TestListEventsFiltersByVM(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm1 := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	_ = assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+
+	events, err := client.ListEvents(ovirtclient.EventListParams().MustWithVMID(vm1.ID()))
+	if err != nil {
+		t.Fatalf("Failed to list events (%v)", err)
+	}
+	if len(events) == 0 {
+		t.Fatalf("No events were returned for VM %s.", vm1.ID())
+	}
+	for i, event := range events {
+		if event.VMID() == nil || *event.VMID() != vm1.ID() {
+			t.Fatalf("Event %s does not relate to VM %s.", event.ID(), vm1.ID())
+		}
+		if i > 0 && events[i-1].Index() >= event.Index() {
+			t.Fatalf("The events are not ordered by their index.")
+		}
+	}
+
+	events, err = client.ListEvents(
+		ovirtclient.EventListParams().
+			MustWithVMID(vm1.ID()).
+			MustWithSinceID(events[len(events)-1].ID()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to list events (%v)", err)
+	}
+	if len(events) != 0 {
+		t.Fatalf("Events older than the since ID were returned.")
+	}
+}
+
+func TestEventListParamsRejectsInvalidSinceID(t *testing.T) {
+	t.Parallel()
+	if _, err := ovirtclient.EventListParams().WithSinceID("not-a-number"); err == nil {
+		t.Fatalf("Setting a non-numeric since ID did not result in an error.")
+	}
+}
+
+func assertEventReceived(t *testing.T, events <-chan ovirtclient.Event, vmID ovirtclient.VMID) {
+	for event := range events {
+		if event.VMID() != nil && *event.VMID() == vmID {
+			return
+		}
+	}
+	t.Fatalf("No event was received for VM %s before the watch ended.", vmID)
+}
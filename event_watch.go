@@ -0,0 +1,129 @@
+package ovirtclient
+
+import (
+	"context"
+	"time"
+)
+
+func (o *oVirtClient) WatchEvents(
+	ctx context.Context,
+	params EventListParameters,
+	retries ...RetryStrategy,
+) (<-chan Event, error) {
+	sinceIndex, err := eventSinceIndex(params)
+	if err != nil {
+		return nil, err
+	}
+	if sinceIndex < 0 {
+		sinceIndex, err = o.lastEventIndex(ctx, retries)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return watchEvents(ctx, o, o.logger, params, sinceIndex, retries)
+}
+
+// lastEventIndex returns the index of the newest event on the engine, or -1 if there are no events.
+func (o *oVirtClient) lastEventIndex(ctx context.Context, retries []RetryStrategy) (result int64, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o.WithContext(ctx)))
+	result = -1
+	err = retry(
+		"fetching the newest event",
+		o.logger,
+		retries,
+		func() error {
+			// The engine returns the events from the newest to the oldest.
+			response, e := o.conn.SystemService().EventsService().List().Max(1).Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Events()
+			if !ok || len(sdkObjects.Slice()) == 0 {
+				return nil
+			}
+			index, ok := sdkObjects.Slice()[0].Index()
+			if !ok {
+				return newFieldNotFound("event", "index")
+			}
+			result = index
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) WatchEvents(
+	ctx context.Context,
+	params EventListParameters,
+	retries ...RetryStrategy,
+) (<-chan Event, error) {
+	sinceIndex, err := eventSinceIndex(params)
+	if err != nil {
+		return nil, err
+	}
+	if sinceIndex < 0 {
+		m.lock.Lock()
+		sinceIndex = m.events.nextIndex - 1
+		m.lock.Unlock()
+	}
+	return watchEvents(ctx, m, m.logger, params, sinceIndex, retries)
+}
+
+// eventLister is implemented by the clients to list events along with the highest event index the engine returned.
+type eventLister interface {
+	listEvents(params EventListParameters, retries []RetryStrategy) ([]Event, int64, error)
+}
+
+// watchEvents polls the events after sinceIndex until ctx is cancelled. Only events with an index higher than the last
+// polled event are sent, so events returned by multiple polls are sent only once. The index advances past events that
+// do not match the filters too, so they are not fetched again on the next poll.
+func watchEvents(
+	ctx context.Context,
+	client Client,
+	logger Logger,
+	params EventListParameters,
+	sinceIndex int64,
+	retries []RetryStrategy,
+) (<-chan Event, error) {
+	pollInterval := 5 * time.Second
+	if params != nil && params.PollInterval() > 0 {
+		pollInterval = params.PollInterval()
+	}
+	// Using a client bound to ctx makes the default retries stop when ctx is cancelled.
+	lister, ok := client.WithContext(ctx).(eventLister)
+	if !ok {
+		return nil, newError(EBug, "the client does not support listing events with their last index")
+	}
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+		for {
+			items, lastIndex, err := lister.listEvents(withEventSinceIndex(params, sinceIndex), retries)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				logger.Warningf("Failed to poll events after index %d, retrying. (%v)", sinceIndex, err)
+			}
+			for _, item := range items {
+				if item.Index() <= sinceIndex {
+					continue
+				}
+				select {
+				case events <- item:
+					sinceIndex = item.Index()
+				case <-ctx.Done():
+					return
+				}
+			}
+			if err == nil && lastIndex > sinceIndex {
+				sinceIndex = lastIndex
+			}
+			select {
+			case <-time.After(pollInterval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
@@ -13,6 +13,23 @@ type HostID string
 type HostClient interface {
 	ListHosts(retries ...RetryStrategy) ([]Host, error)
 	GetHost(id HostID, retries ...RetryStrategy) (Host, error)
+	// DeactivateHost puts the host into maintenance mode. The engine migrates all VMs off the host before it enters
+	// HostStatusMaintenance. This should be waited for via the WaitForHostStatus call.
+	DeactivateHost(id HostID, retries ...RetryStrategy) error
+	// ActivateHost brings a host out of maintenance mode. The activation takes time and should be waited for via the
+	// WaitForHostStatus call.
+	ActivateHost(id HostID, retries ...RetryStrategy) error
+	// RestartHost restarts the host using its configured power management. The host should be in maintenance mode
+	// before it is restarted.
+	RestartHost(id HostID, retries ...RetryStrategy) error
+	// WaitForHostStatus waits for the host to reach the desired status.
+	WaitForHostStatus(id HostID, status HostStatus, retries ...RetryStrategy) (Host, error)
+	// AddTagToHost adds the specified tag to a host.
+	AddTagToHost(id HostID, tagID TagID, retries ...RetryStrategy) error
+	// RemoveTagFromHost removes the specified tag from a host.
+	RemoveTagFromHost(id HostID, tagID TagID, retries ...RetryStrategy) error
+	// ListHostTags lists the tags attached to a host.
+	ListHostTags(id HostID, retries ...RetryStrategy) ([]Tag, error)
 }
 
 // HostData is the core of Host, providing only data access functions.
@@ -23,6 +40,27 @@ type HostData interface {
 	ClusterID() ClusterID
 	// Status returns the status of this host.
 	Status() HostStatus
+	// Name returns the name of the host.
+	Name() string
+	// Address returns the address the engine uses to reach the host.
+	Address() string
+	// CPUTopo returns the CPU topology of the host. It may be nil if the engine has not yet retrieved the CPU
+	// details from the host.
+	CPUTopo() HostCPUTopo
+	// Memory returns the amount of physical memory in the host in bytes.
+	Memory() int64
+	// RunningVMCount returns the number of VMs currently running on the host.
+	RunningVMCount() uint
+}
+
+// HostCPUTopo describes the CPU topology of a host.
+type HostCPUTopo interface {
+	// Cores is the number of CPU cores per socket.
+	Cores() uint
+	// Threads is the number of CPU threads in a core.
+	Threads() uint
+	// Sockets is the number of sockets.
+	Sockets() uint
 }
 
 // Host is the representation of a host returned from the oVirt Engine API. Hosts, also known as hypervisors, are the
@@ -32,6 +70,30 @@ type HostData interface {
 // See https://www.ovirt.org/documentation/administration_guide/#chap-Hosts for details.
 type Host interface {
 	HostData
+
+	// Deactivate puts the current host into maintenance mode.
+	Deactivate(retries ...RetryStrategy) error
+	// Activate brings the current host out of maintenance mode.
+	Activate(retries ...RetryStrategy) error
+	// Restart restarts the current host using its power management.
+	Restart(retries ...RetryStrategy) error
+	// WaitForStatus waits for the current host to reach the desired status.
+	WaitForStatus(status HostStatus, retries ...RetryStrategy) (Host, error)
+	// ListNICs lists the network interfaces of the current host.
+	ListNICs(retries ...RetryStrategy) ([]HostNIC, error)
+	// SetupNetworks changes the network configuration of the current host. See SetupHostNetworks for details.
+	SetupNetworks(params SetupHostNetworksParameters, retries ...RetryStrategy) error
+	// DiscoverISCSITargets lists the iSCSI targets offered by a portal as seen from the current host. See
+	// DiscoverISCSITargets on the client for details.
+	DiscoverISCSITargets(address string, port uint, retries ...RetryStrategy) ([]ISCSITarget, error)
+	// ListLUNs lists the LUNs visible to the current host. See ListHostLUNs for details.
+	ListLUNs(retries ...RetryStrategy) ([]LUN, error)
+	// AddTag adds the specified tag to the current host.
+	AddTag(tagID TagID, retries ...RetryStrategy) error
+	// RemoveTag removes the specified tag from the current host.
+	RemoveTag(tagID TagID, retries ...RetryStrategy) error
+	// ListTags lists the tags attached to the current host.
+	ListTags(retries ...RetryStrategy) ([]Tag, error)
 }
 
 // HostStatus represents the complex states an oVirt host can be in.
@@ -113,6 +175,16 @@ func (l HostStatusList) Strings() []string {
 	return result
 }
 
+// Validate returns an error if the host status doesn't have a valid value.
+func (s HostStatus) Validate() error {
+	for _, v := range HostStatusValues() {
+		if v == s {
+			return nil
+		}
+	}
+	return newError(EBadArgument, "invalid value for host status: %s", s)
+}
+
 func convertSDKHost(sdkHost *ovirtsdk4.Host, client Client) (Host, error) {
 	id, ok := sdkHost.Id()
 	if !ok {
@@ -130,20 +202,67 @@ func convertSDKHost(sdkHost *ovirtsdk4.Host, client Client) (Host, error) {
 	if !ok {
 		return nil, newError(EFieldMissing, "failed to fetch cluster ID from host %s", id)
 	}
+	name, ok := sdkHost.Name()
+	if !ok {
+		return nil, newError(EFieldMissing, "returned host %s did not contain a name", id)
+	}
+	address, _ := sdkHost.Address()
+	memory, _ := sdkHost.Memory()
+	var runningVMCount uint
+	if summary, ok := sdkHost.Summary(); ok {
+		if active, ok := summary.Active(); ok {
+			runningVMCount = uint(active)
+		}
+	}
 	return &host{
-		client:    client,
-		id:        HostID(id),
-		status:    HostStatus(status),
-		clusterID: ClusterID(clusterID),
+		client:         client,
+		id:             HostID(id),
+		name:           name,
+		address:        address,
+		status:         HostStatus(status),
+		clusterID:      ClusterID(clusterID),
+		cpuTopo:        convertSDKHostCPUTopo(sdkHost),
+		memory:         memory,
+		runningVMCount: runningVMCount,
 	}, nil
 }
 
+func convertSDKHostCPUTopo(sdkHost *ovirtsdk4.Host) *hostCPUTopo {
+	cpu, ok := sdkHost.Cpu()
+	if !ok {
+		return nil
+	}
+	topo, ok := cpu.Topology()
+	if !ok {
+		return nil
+	}
+	cores, _ := topo.Cores()
+	threads, _ := topo.Threads()
+	sockets, _ := topo.Sockets()
+	return &hostCPUTopo{
+		cores:   uint(cores),
+		threads: uint(threads),
+		sockets: uint(sockets),
+	}
+}
+
 type host struct {
 	client Client
 
-	id        HostID
-	clusterID ClusterID
-	status    HostStatus
+	id             HostID
+	name           string
+	address        string
+	clusterID      ClusterID
+	status         HostStatus
+	cpuTopo        *hostCPUTopo
+	memory         int64
+	runningVMCount uint
+}
+
+// clone creates a copy of the host, so the mock client can store a changed host without altering the ones it already
+// handed out.
+func (h host) clone() *host {
+	return &h
 }
 
 func (h host) ID() HostID {
@@ -157,3 +276,88 @@ func (h host) ClusterID() ClusterID {
 func (h host) Status() HostStatus {
 	return h.status
 }
+
+func (h host) Name() string {
+	return h.name
+}
+
+func (h host) Address() string {
+	return h.address
+}
+
+func (h host) CPUTopo() HostCPUTopo {
+	if h.cpuTopo == nil {
+		return nil
+	}
+	return h.cpuTopo
+}
+
+func (h host) Memory() int64 {
+	return h.memory
+}
+
+func (h host) RunningVMCount() uint {
+	return h.runningVMCount
+}
+
+func (h host) Deactivate(retries ...RetryStrategy) error {
+	return h.client.DeactivateHost(h.id, retries...)
+}
+
+func (h host) Activate(retries ...RetryStrategy) error {
+	return h.client.ActivateHost(h.id, retries...)
+}
+
+func (h host) Restart(retries ...RetryStrategy) error {
+	return h.client.RestartHost(h.id, retries...)
+}
+
+func (h host) WaitForStatus(status HostStatus, retries ...RetryStrategy) (Host, error) {
+	return h.client.WaitForHostStatus(h.id, status, retries...)
+}
+
+func (h host) ListNICs(retries ...RetryStrategy) ([]HostNIC, error) {
+	return h.client.ListHostNICs(h.id, retries...)
+}
+
+func (h host) SetupNetworks(params SetupHostNetworksParameters, retries ...RetryStrategy) error {
+	return h.client.SetupHostNetworks(h.id, params, retries...)
+}
+
+func (h host) DiscoverISCSITargets(address string, port uint, retries ...RetryStrategy) ([]ISCSITarget, error) {
+	return h.client.DiscoverISCSITargets(h.id, address, port, retries...)
+}
+
+func (h host) ListLUNs(retries ...RetryStrategy) ([]LUN, error) {
+	return h.client.ListHostLUNs(h.id, retries...)
+}
+
+func (h host) AddTag(tagID TagID, retries ...RetryStrategy) error {
+	return h.client.AddTagToHost(h.id, tagID, retries...)
+}
+
+func (h host) RemoveTag(tagID TagID, retries ...RetryStrategy) error {
+	return h.client.RemoveTagFromHost(h.id, tagID, retries...)
+}
+
+func (h host) ListTags(retries ...RetryStrategy) ([]Tag, error) {
+	return h.client.ListHostTags(h.id, retries...)
+}
+
+type hostCPUTopo struct {
+	cores   uint
+	threads uint
+	sockets uint
+}
+
+func (h *hostCPUTopo) Cores() uint {
+	return h.cores
+}
+
+func (h *hostCPUTopo) Threads() uint {
+	return h.threads
+}
+
+func (h *hostCPUTopo) Sockets() uint {
+	return h.sockets
+}
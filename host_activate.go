@@ -0,0 +1,58 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+)
+
+func (o *oVirtClient) ActivateHost(id HostID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("activating host %s", id),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().HostsService().HostService(string(id)).Activate().Send()
+			return err
+		})
+	return
+}
+
+func (m *mockClient) ActivateHost(id HostID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("activating host %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			item, ok := m.hosts[id]
+			if !ok {
+				return newError(ENotFound, "host with ID %s not found", id)
+			}
+			switch item.status {
+			case HostStatusUp, HostStatusUnassigned:
+				return nil
+			case HostStatusMaintenance, HostStatusNonOperational, HostStatusError:
+			default:
+				return newError(EConflict, "host %s cannot be activated in status %s", id, item.status)
+			}
+
+			activating := item.clone()
+			activating.status = HostStatusUnassigned
+			m.hosts[id] = activating
+			go func() {
+				time.Sleep(2 * time.Second)
+				m.lock.Lock()
+				defer m.lock.Unlock()
+				if item, ok := m.hosts[id]; ok && item.status == HostStatusUnassigned {
+					activated := item.clone()
+					activated.status = HostStatusUp
+					m.hosts[id] = activated
+				}
+			}()
+			return nil
+		})
+}
@@ -0,0 +1,93 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+)
+
+func (o *oVirtClient) DeactivateHost(id HostID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("deactivating host %s", id),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().HostsService().HostService(string(id)).Deactivate().Send()
+			return err
+		})
+	return
+}
+
+func (m *mockClient) DeactivateHost(id HostID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("putting host %s into maintenance", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			item, ok := m.hosts[id]
+			if !ok {
+				return newError(ENotFound, "host with ID %s not found", id)
+			}
+			switch item.status {
+			case HostStatusMaintenance, HostStatusPreparingForMaintenance:
+				return nil
+			case HostStatusUp, HostStatusNonOperational, HostStatusError:
+			default:
+				return newError(EConflict, "host %s cannot be put into maintenance in status %s", id, item.status)
+			}
+
+			// All VMs must be migrated off the host before it can enter maintenance.
+			runningVMs := m.runningVMsOnHost(id)
+			targets := make(map[VMID]HostID, len(runningVMs))
+			for _, runningVM := range runningVMs {
+				if runningVM.placementPolicy != nil && runningVM.placementPolicy.affinity != nil &&
+					*runningVM.placementPolicy.affinity == VMAffinityPinned {
+					return newError(
+						EConflict,
+						"host %s cannot be put into maintenance because the pinned VM %s is running on it",
+						id,
+						runningVM.id,
+					)
+				}
+				targetHostID, err := m.findMigrationTarget(runningVM, item.clusterID, nil)
+				if err != nil {
+					return wrap(err, EConflict, "host %s cannot be put into maintenance", id)
+				}
+				targets[runningVM.id] = targetHostID
+			}
+
+			preparing := item.clone()
+			preparing.status = HostStatusPreparingForMaintenance
+			m.hosts[id] = preparing
+			for _, runningVM := range runningVMs {
+				migratingVM := runningVM.clone()
+				migratingVM.status = VMStatusMigrating
+				m.vms[migratingVM.id] = migratingVM
+			}
+			go func() {
+				time.Sleep(2 * time.Second)
+				m.lock.Lock()
+				defer m.lock.Unlock()
+				for vmID, targetHostID := range targets {
+					targetHostID := targetHostID
+					if runningVM, ok := m.vms[vmID]; ok && runningVM.status == VMStatusMigrating {
+						migratedVM := runningVM.clone()
+						migratedVM.hostID = &targetHostID
+						migratedVM.status = VMStatusUp
+						m.vms[vmID] = migratedVM
+					}
+				}
+				m.updateHostVMCounts()
+				if item, ok := m.hosts[id]; ok && item.status == HostStatusPreparingForMaintenance {
+					inMaintenance := item.clone()
+					inMaintenance.status = HostStatusMaintenance
+					m.hosts[id] = inMaintenance
+				}
+			}()
+			return nil
+		})
+}
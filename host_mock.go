@@ -0,0 +1,25 @@
+package ovirtclient
+
+// runningVMsOnHost returns the VMs that are currently running on the specified host. Must be called with the lock
+// held.
+func (m *mockClient) runningVMsOnHost(hostID HostID) []*vm {
+	var result []*vm
+	for _, item := range m.vms {
+		if item.hostID != nil && *item.hostID == hostID && item.status != VMStatusDown {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// updateHostVMCounts updates the running VM count on all hosts. It must be called whenever a VM starts, stops or
+// moves to a different host. Must be called with the lock held.
+func (m *mockClient) updateHostVMCounts() {
+	for id, h := range m.hosts {
+		if runningVMCount := uint(len(m.runningVMsOnHost(id))); h.runningVMCount != runningVMCount {
+			updated := h.clone()
+			updated.runningVMCount = runningVMCount
+			m.hosts[id] = updated
+		}
+	}
+}
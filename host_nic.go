@@ -0,0 +1,779 @@
+package ovirtclient
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// HostNICID is the identifier of a network interface on a host.
+type HostNICID string
+
+// HostNICClient contains the functions for inspecting and configuring the network interfaces of hosts.
+type HostNICClient interface {
+	// ListHostNICs lists the network interfaces of a host, including bonds.
+	ListHostNICs(hostID HostID, retries ...RetryStrategy) ([]HostNIC, error)
+	// SetupHostNetworks changes the network configuration of a host. The parameters are validated against the
+	// current network interfaces of the host before the changes are sent to the engine. If CommitOnSuccess is not
+	// set, the changes are lost when the host reboots.
+	SetupHostNetworks(hostID HostID, params SetupHostNetworksParameters, retries ...RetryStrategy) error
+}
+
+// HostNIC is a network interface or a bond on a host.
+type HostNIC interface {
+	// ID returns the identifier of the network interface.
+	ID() HostNICID
+	// HostID returns the identifier of the host the network interface belongs to.
+	HostID() HostID
+	// Name returns the name of the network interface on the host, for example eth0 or bond0.
+	Name() string
+	// MAC returns the MAC address of the network interface.
+	MAC() string
+	// Speed returns the speed of the network interface in bits per second, or 0 if the speed is unknown.
+	Speed() uint64
+	// MTU returns the MTU of the network interface in bytes.
+	MTU() uint
+	// NetworkID returns the logical network attached to the network interface, if any.
+	NetworkID() *NetworkID
+	// BootProtocol returns how the IPv4 address of the network interface is configured.
+	BootProtocol() HostNICBootProtocol
+	// IPv4Address returns the IPv4 address of the network interface, or nil if it has none.
+	IPv4Address() net.IP
+	// Bond returns the bonding configuration if the network interface is a bond, or nil otherwise.
+	Bond() HostNICBond
+	// BondMasterID returns the bond the network interface is a slave of, if any.
+	BondMasterID() *HostNICID
+}
+
+// HostNICBond is the bonding configuration of a bond on a host.
+type HostNICBond interface {
+	// Mode returns the bonding mode.
+	Mode() BondMode
+	// SlaveIDs returns the network interfaces that are part of the bond.
+	SlaveIDs() []HostNICID
+}
+
+// BondMode is the Linux bonding mode of a bond.
+type BondMode string
+
+const (
+	// BondModeBalanceRR transmits packets in sequential order over all slaves (mode 0).
+	BondModeBalanceRR BondMode = "balance-rr"
+	// BondModeActiveBackup uses only one slave at a time and fails over to another slave (mode 1).
+	BondModeActiveBackup BondMode = "active-backup"
+	// BondModeBalanceXOR selects the slave based on a hash of the source and destination addresses (mode 2).
+	BondModeBalanceXOR BondMode = "balance-xor"
+	// BondModeBroadcast transmits all packets on all slaves (mode 3).
+	BondModeBroadcast BondMode = "broadcast"
+	// BondMode8023AD uses IEEE 802.3ad dynamic link aggregation, which requires switch support (mode 4).
+	BondMode8023AD BondMode = "802.3ad"
+	// BondModeBalanceTLB balances outgoing traffic based on the load of each slave (mode 5).
+	BondModeBalanceTLB BondMode = "balance-tlb"
+	// BondModeBalanceALB balances incoming and outgoing traffic based on the load of each slave (mode 6).
+	BondModeBalanceALB BondMode = "balance-alb"
+)
+
+// BondModeList is a list of BondMode.
+type BondModeList []BondMode
+
+// BondModeValues returns all possible BondMode values, ordered by their mode number.
+func BondModeValues() BondModeList {
+	return []BondMode{
+		BondModeBalanceRR,
+		BondModeActiveBackup,
+		BondModeBalanceXOR,
+		BondModeBroadcast,
+		BondMode8023AD,
+		BondModeBalanceTLB,
+		BondModeBalanceALB,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l BondModeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, mode := range l {
+		result[i] = string(mode)
+	}
+	return result
+}
+
+// Validate returns an error if the bond mode doesn't have a valid value.
+func (m BondMode) Validate() error {
+	for _, mode := range BondModeValues() {
+		if mode == m {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid bond mode: %s must be one of: %s",
+		m,
+		strings.Join(BondModeValues().Strings(), ", "),
+	)
+}
+
+// number returns the numeric mode used in the bonding options of the engine.
+func (m BondMode) number() string {
+	for i, mode := range BondModeValues() {
+		if mode == m {
+			return strconv.Itoa(i)
+		}
+	}
+	return ""
+}
+
+// parseBondMode parses the mode option of a bond, which the engine may report either as a number or a name.
+func parseBondMode(value string) BondMode {
+	for _, mode := range BondModeValues() {
+		if mode.number() == value || string(mode) == value {
+			return mode
+		}
+	}
+	return BondMode(value)
+}
+
+// HostNICBootProtocol describes how the IP address of a host network interface is configured.
+type HostNICBootProtocol string
+
+const (
+	// HostNICBootProtocolNone indicates that no IP address is configured.
+	HostNICBootProtocolNone HostNICBootProtocol = "none"
+	// HostNICBootProtocolDHCP indicates that the IP address is obtained via DHCP.
+	HostNICBootProtocolDHCP HostNICBootProtocol = "dhcp"
+	// HostNICBootProtocolStatic indicates a statically configured IP address.
+	HostNICBootProtocolStatic HostNICBootProtocol = "static"
+	// HostNICBootProtocolAutoconf indicates IPv6 stateless address autoconfiguration.
+	HostNICBootProtocolAutoconf HostNICBootProtocol = "autoconf"
+	// HostNICBootProtocolPolyDHCPAutoconf indicates IPv6 DHCP combined with stateless address autoconfiguration.
+	HostNICBootProtocolPolyDHCPAutoconf HostNICBootProtocol = "poly_dhcp_autoconf"
+)
+
+// HostNICBootProtocolList is a list of HostNICBootProtocol.
+type HostNICBootProtocolList []HostNICBootProtocol
+
+// HostNICBootProtocolValues returns all possible HostNICBootProtocol values.
+func HostNICBootProtocolValues() HostNICBootProtocolList {
+	return []HostNICBootProtocol{
+		HostNICBootProtocolNone,
+		HostNICBootProtocolDHCP,
+		HostNICBootProtocolStatic,
+		HostNICBootProtocolAutoconf,
+		HostNICBootProtocolPolyDHCPAutoconf,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l HostNICBootProtocolList) Strings() []string {
+	result := make([]string, len(l))
+	for i, protocol := range l {
+		result[i] = string(protocol)
+	}
+	return result
+}
+
+// Validate returns an error if the boot protocol doesn't have a valid value.
+func (p HostNICBootProtocol) Validate() error {
+	for _, protocol := range HostNICBootProtocolValues() {
+		if protocol == p {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid host NIC boot protocol: %s must be one of: %s",
+		p,
+		strings.Join(HostNICBootProtocolValues().Strings(), ", "),
+	)
+}
+
+func convertSDKHostNIC(sdkObject *ovirtsdk4.HostNic, hostID HostID) (*hostNIC, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("host NIC", "id")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("host NIC", "name")
+	}
+	result := &hostNIC{
+		id:           HostNICID(id),
+		hostID:       hostID,
+		name:         name,
+		bootProtocol: HostNICBootProtocolNone,
+	}
+	if sdkMAC, ok := sdkObject.Mac(); ok {
+		result.mac, _ = sdkMAC.Address()
+	}
+	if speed, ok := sdkObject.Speed(); ok {
+		result.speed = uint64(speed)
+	}
+	if mtu, ok := sdkObject.Mtu(); ok {
+		result.mtu = uint(mtu)
+	}
+	if sdkNetwork, ok := sdkObject.Network(); ok {
+		if networkID, ok := sdkNetwork.Id(); ok {
+			result.networkID = (*NetworkID)(&networkID)
+		}
+	}
+	if bootProtocol, ok := sdkObject.BootProtocol(); ok {
+		result.bootProtocol = HostNICBootProtocol(bootProtocol)
+	}
+	if sdkIP, ok := sdkObject.Ip(); ok {
+		if address, ok := sdkIP.Address(); ok {
+			result.ipv4Address = net.ParseIP(address)
+		}
+	}
+	if sdkBonding, ok := sdkObject.Bonding(); ok {
+		result.bond = convertSDKBonding(sdkBonding)
+	}
+	return result, nil
+}
+
+func convertSDKBonding(sdkBonding *ovirtsdk4.Bonding) *hostNICBond {
+	result := &hostNICBond{}
+	if sdkOptions, ok := sdkBonding.Options(); ok {
+		for _, sdkOption := range sdkOptions.Slice() {
+			if name, _ := sdkOption.Name(); name == "mode" {
+				value, _ := sdkOption.Value()
+				result.mode = parseBondMode(value)
+			}
+		}
+	}
+	if sdkSlaves, ok := sdkBonding.Slaves(); ok {
+		for _, sdkSlave := range sdkSlaves.Slice() {
+			if slaveID, ok := sdkSlave.Id(); ok {
+				result.slaveIDs = append(result.slaveIDs, HostNICID(slaveID))
+			}
+		}
+	}
+	return result
+}
+
+// linkBondSlaves sets the bond master on the slaves of the bonds in the list.
+func linkBondSlaves(nics []*hostNIC) {
+	for _, master := range nics {
+		if master.bond == nil {
+			continue
+		}
+		for _, slaveID := range master.bond.slaveIDs {
+			for _, slave := range nics {
+				if slave.id == slaveID {
+					masterID := master.id
+					slave.bondMasterID = &masterID
+				}
+			}
+		}
+	}
+}
+
+type hostNIC struct {
+	id           HostNICID
+	hostID       HostID
+	name         string
+	mac          string
+	speed        uint64
+	mtu          uint
+	networkID    *NetworkID
+	bootProtocol HostNICBootProtocol
+	ipv4Address  net.IP
+	bond         *hostNICBond
+	bondMasterID *HostNICID
+}
+
+func (h *hostNIC) ID() HostNICID {
+	return h.id
+}
+
+func (h *hostNIC) HostID() HostID {
+	return h.hostID
+}
+
+func (h *hostNIC) Name() string {
+	return h.name
+}
+
+func (h *hostNIC) MAC() string {
+	return h.mac
+}
+
+func (h *hostNIC) Speed() uint64 {
+	return h.speed
+}
+
+func (h *hostNIC) MTU() uint {
+	return h.mtu
+}
+
+func (h *hostNIC) NetworkID() *NetworkID {
+	return h.networkID
+}
+
+func (h *hostNIC) BootProtocol() HostNICBootProtocol {
+	return h.bootProtocol
+}
+
+func (h *hostNIC) IPv4Address() net.IP {
+	return h.ipv4Address
+}
+
+func (h *hostNIC) Bond() HostNICBond {
+	if h.bond == nil {
+		return nil
+	}
+	return h.bond
+}
+
+func (h *hostNIC) BondMasterID() *HostNICID {
+	return h.bondMasterID
+}
+
+func (h *hostNIC) clone() *hostNIC {
+	result := *h
+	if h.networkID != nil {
+		networkID := *h.networkID
+		result.networkID = &networkID
+	}
+	if h.bondMasterID != nil {
+		bondMasterID := *h.bondMasterID
+		result.bondMasterID = &bondMasterID
+	}
+	if h.bond != nil {
+		result.bond = &hostNICBond{
+			mode:     h.bond.mode,
+			slaveIDs: append([]HostNICID{}, h.bond.slaveIDs...),
+		}
+	}
+	return &result
+}
+
+type hostNICBond struct {
+	mode     BondMode
+	slaveIDs []HostNICID
+}
+
+func (h *hostNICBond) Mode() BondMode {
+	return h.mode
+}
+
+func (h *hostNICBond) SlaveIDs() []HostNICID {
+	return h.slaveIDs
+}
+
+// SetupHostNetworksParameters contains the changes to the network configuration of a host.
+type SetupHostNetworksParameters interface {
+	// Bonds returns the bonds to create or modify.
+	Bonds() []HostBondParameters
+	// NetworkAttachments returns the logical networks to attach to network interfaces.
+	NetworkAttachments() []HostNetworkAttachmentParameters
+	// CommitOnSuccess returns true if the configuration should be persisted on the host once it has been applied
+	// successfully.
+	CommitOnSuccess() bool
+}
+
+// HostBondParameters describes a bond to create or modify.
+type HostBondParameters interface {
+	// Name returns the name of the bond, for example bond0.
+	Name() string
+	// Mode returns the bonding mode.
+	Mode() BondMode
+	// SlaveNames returns the names of the network interfaces that make up the bond.
+	SlaveNames() []string
+}
+
+// HostNetworkAttachmentParameters describes a logical network to attach to a network interface or bond.
+type HostNetworkAttachmentParameters interface {
+	// NetworkID returns the logical network to attach.
+	NetworkID() NetworkID
+	// HostNICName returns the name of the network interface or bond the network is attached to.
+	HostNICName() string
+	// IPConfig returns the IPv4 configuration of the network on the host, or nil if no IP address should be
+	// configured.
+	IPConfig() HostNetworkIPConfig
+}
+
+// HostNetworkIPConfig is the IPv4 configuration of a network attached to a host. Use HostNetworkDHCPIPConfig or
+// HostNetworkStaticIPConfig to create one.
+type HostNetworkIPConfig interface {
+	// BootProtocol returns how the IP address is configured.
+	BootProtocol() HostNICBootProtocol
+	// Address returns the static IP address, or nil when using DHCP.
+	Address() net.IP
+	// Netmask returns the netmask of the static IP address, or nil when using DHCP.
+	Netmask() net.IP
+	// Gateway returns the gateway of the static IP address. It may be nil.
+	Gateway() net.IP
+}
+
+// HostNetworkDHCPIPConfig creates an IP configuration that obtains the address via DHCP.
+func HostNetworkDHCPIPConfig() HostNetworkIPConfig {
+	return &hostNetworkIPConfig{
+		bootProtocol: HostNICBootProtocolDHCP,
+	}
+}
+
+// HostNetworkStaticIPConfig creates a static IPv4 configuration. The gateway may be nil.
+func HostNetworkStaticIPConfig(address net.IP, netmask net.IP, gateway net.IP) HostNetworkIPConfig {
+	return &hostNetworkIPConfig{
+		bootProtocol: HostNICBootProtocolStatic,
+		address:      address,
+		netmask:      netmask,
+		gateway:      gateway,
+	}
+}
+
+// BuildableSetupHostNetworksParameters is a buildable version of SetupHostNetworksParameters. The builder checks
+// that the changes are consistent with each other, for example that no network is attached to a bond slave.
+type BuildableSetupHostNetworksParameters interface {
+	SetupHostNetworksParameters
+
+	// WithBond adds a bond with the specified mode and at least two slaves.
+	WithBond(name string, mode BondMode, slaveNames ...string) (BuildableSetupHostNetworksParameters, error)
+	// MustWithBond is identical to WithBond, but panics instead of returning an error.
+	MustWithBond(name string, mode BondMode, slaveNames ...string) BuildableSetupHostNetworksParameters
+
+	// WithNetworkAttachment attaches a logical network to the network interface or bond with the specified name.
+	// The ipConfig may be nil if no IP address should be configured.
+	WithNetworkAttachment(
+		networkID NetworkID,
+		hostNICName string,
+		ipConfig HostNetworkIPConfig,
+	) (BuildableSetupHostNetworksParameters, error)
+	// MustWithNetworkAttachment is identical to WithNetworkAttachment, but panics instead of returning an error.
+	MustWithNetworkAttachment(
+		networkID NetworkID,
+		hostNICName string,
+		ipConfig HostNetworkIPConfig,
+	) BuildableSetupHostNetworksParameters
+
+	// WithCommitOnSuccess sets if the configuration should be persisted on the host once applied successfully.
+	WithCommitOnSuccess(commitOnSuccess bool) (BuildableSetupHostNetworksParameters, error)
+	// MustWithCommitOnSuccess is identical to WithCommitOnSuccess, but panics instead of returning an error.
+	MustWithCommitOnSuccess(commitOnSuccess bool) BuildableSetupHostNetworksParameters
+}
+
+// SetupHostNetworksParams creates a buildable set of parameters for SetupHostNetworks. CommitOnSuccess defaults to
+// true.
+func SetupHostNetworksParams() BuildableSetupHostNetworksParameters {
+	return &setupHostNetworksParams{
+		commitOnSuccess: true,
+	}
+}
+
+type setupHostNetworksParams struct {
+	bonds              []HostBondParameters
+	networkAttachments []HostNetworkAttachmentParameters
+	commitOnSuccess    bool
+}
+
+func (s *setupHostNetworksParams) Bonds() []HostBondParameters {
+	return s.bonds
+}
+
+func (s *setupHostNetworksParams) NetworkAttachments() []HostNetworkAttachmentParameters {
+	return s.networkAttachments
+}
+
+func (s *setupHostNetworksParams) CommitOnSuccess() bool {
+	return s.commitOnSuccess
+}
+
+func (s *setupHostNetworksParams) WithBond(
+	name string,
+	mode BondMode,
+	slaveNames ...string,
+) (BuildableSetupHostNetworksParameters, error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "the bond name must not be empty")
+	}
+	if err := mode.Validate(); err != nil {
+		return nil, err
+	}
+	if len(slaveNames) < 2 {
+		return nil, newError(EBadArgument, "bond %s must have at least two slaves (got: %d)", name, len(slaveNames))
+	}
+	if s.bondSlaveOf(name) != nil {
+		return nil, newError(EBadArgument, "bond %s is already a slave of bond %s", name, s.bondSlaveOf(name).Name())
+	}
+	for _, bond := range s.bonds {
+		if bond.Name() == name {
+			return nil, newError(EBadArgument, "bond %s is already configured", name)
+		}
+	}
+	for i, slaveName := range slaveNames {
+		if slaveName == name {
+			return nil, newError(EBadArgument, "bond %s cannot be its own slave", name)
+		}
+		for _, other := range slaveNames[:i] {
+			if other == slaveName {
+				return nil, newError(EBadArgument, "slave %s is listed twice in bond %s", slaveName, name)
+			}
+		}
+		if bond := s.bondSlaveOf(slaveName); bond != nil {
+			return nil, newError(EBadArgument, "%s is already a slave of bond %s", slaveName, bond.Name())
+		}
+		if s.isBond(slaveName) {
+			return nil, newError(EBadArgument, "bond %s cannot be a slave of bond %s", slaveName, name)
+		}
+		if attachment := s.networkAttachmentOn(slaveName); attachment != nil {
+			return nil, newError(
+				EBadArgument,
+				"%s cannot be a slave of bond %s, network %s is attached to it",
+				slaveName,
+				name,
+				attachment.NetworkID(),
+			)
+		}
+	}
+	s.bonds = append(s.bonds, &hostBondParams{
+		name:       name,
+		mode:       mode,
+		slaveNames: append([]string{}, slaveNames...),
+	})
+	return s, nil
+}
+
+func (s *setupHostNetworksParams) MustWithBond(
+	name string,
+	mode BondMode,
+	slaveNames ...string,
+) BuildableSetupHostNetworksParameters {
+	builder, err := s.WithBond(name, mode, slaveNames...)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (s *setupHostNetworksParams) WithNetworkAttachment(
+	networkID NetworkID,
+	hostNICName string,
+	ipConfig HostNetworkIPConfig,
+) (BuildableSetupHostNetworksParameters, error) {
+	if hostNICName == "" {
+		return nil, newError(EBadArgument, "the host NIC name must not be empty")
+	}
+	if err := validateHostNetworkIPConfig(ipConfig); err != nil {
+		return nil, err
+	}
+	for _, attachment := range s.networkAttachments {
+		if attachment.NetworkID() == networkID {
+			return nil, newError(
+				EBadArgument,
+				"network %s is already attached to %s",
+				networkID,
+				attachment.HostNICName(),
+			)
+		}
+	}
+	if bond := s.bondSlaveOf(hostNICName); bond != nil {
+		return nil, newError(
+			EBadArgument,
+			"cannot attach network %s to %s, it is a slave of bond %s",
+			networkID,
+			hostNICName,
+			bond.Name(),
+		)
+	}
+	s.networkAttachments = append(s.networkAttachments, &hostNetworkAttachmentParams{
+		networkID:   networkID,
+		hostNICName: hostNICName,
+		ipConfig:    ipConfig,
+	})
+	return s, nil
+}
+
+func (s *setupHostNetworksParams) MustWithNetworkAttachment(
+	networkID NetworkID,
+	hostNICName string,
+	ipConfig HostNetworkIPConfig,
+) BuildableSetupHostNetworksParameters {
+	builder, err := s.WithNetworkAttachment(networkID, hostNICName, ipConfig)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (s *setupHostNetworksParams) WithCommitOnSuccess(
+	commitOnSuccess bool,
+) (BuildableSetupHostNetworksParameters, error) {
+	s.commitOnSuccess = commitOnSuccess
+	return s, nil
+}
+
+func (s *setupHostNetworksParams) MustWithCommitOnSuccess(
+	commitOnSuccess bool,
+) BuildableSetupHostNetworksParameters {
+	builder, err := s.WithCommitOnSuccess(commitOnSuccess)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (s *setupHostNetworksParams) bondSlaveOf(name string) HostBondParameters {
+	for _, bond := range s.bonds {
+		for _, slaveName := range bond.SlaveNames() {
+			if slaveName == name {
+				return bond
+			}
+		}
+	}
+	return nil
+}
+
+func (s *setupHostNetworksParams) isBond(name string) bool {
+	for _, bond := range s.bonds {
+		if bond.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *setupHostNetworksParams) networkAttachmentOn(name string) HostNetworkAttachmentParameters {
+	for _, attachment := range s.networkAttachments {
+		if attachment.HostNICName() == name {
+			return attachment
+		}
+	}
+	return nil
+}
+
+type hostBondParams struct {
+	name       string
+	mode       BondMode
+	slaveNames []string
+}
+
+func (h *hostBondParams) Name() string {
+	return h.name
+}
+
+func (h *hostBondParams) Mode() BondMode {
+	return h.mode
+}
+
+func (h *hostBondParams) SlaveNames() []string {
+	return h.slaveNames
+}
+
+type hostNetworkAttachmentParams struct {
+	networkID   NetworkID
+	hostNICName string
+	ipConfig    HostNetworkIPConfig
+}
+
+func (h *hostNetworkAttachmentParams) NetworkID() NetworkID {
+	return h.networkID
+}
+
+func (h *hostNetworkAttachmentParams) HostNICName() string {
+	return h.hostNICName
+}
+
+func (h *hostNetworkAttachmentParams) IPConfig() HostNetworkIPConfig {
+	return h.ipConfig
+}
+
+type hostNetworkIPConfig struct {
+	bootProtocol HostNICBootProtocol
+	address      net.IP
+	netmask      net.IP
+	gateway      net.IP
+}
+
+func (h *hostNetworkIPConfig) BootProtocol() HostNICBootProtocol {
+	return h.bootProtocol
+}
+
+func (h *hostNetworkIPConfig) Address() net.IP {
+	return h.address
+}
+
+func (h *hostNetworkIPConfig) Netmask() net.IP {
+	return h.netmask
+}
+
+func (h *hostNetworkIPConfig) Gateway() net.IP {
+	return h.gateway
+}
+
+func validateHostNetworkIPConfig(ipConfig HostNetworkIPConfig) error {
+	if ipConfig == nil || ipConfig.BootProtocol() != HostNICBootProtocolStatic {
+		return nil
+	}
+	if ipConfig.Address().To4() == nil {
+		return newError(EBadArgument, "invalid static IPv4 address: %v", ipConfig.Address())
+	}
+	if ipConfig.Netmask().To4() == nil {
+		return newError(EBadArgument, "invalid IPv4 netmask: %v", ipConfig.Netmask())
+	}
+	if ipConfig.Gateway() != nil && ipConfig.Gateway().To4() == nil {
+		return newError(EBadArgument, "invalid IPv4 gateway: %v", ipConfig.Gateway())
+	}
+	return nil
+}
+
+// validateSetupHostNetworks checks the parameters against the current network interfaces of the host.
+func validateSetupHostNetworks(nics []HostNIC, params SetupHostNetworksParameters) error {
+	if params == nil {
+		return newError(EBadArgument, "the setup networks parameters must not be nil")
+	}
+	byName := map[string]HostNIC{}
+	byID := map[HostNICID]HostNIC{}
+	for _, nic := range nics {
+		byName[nic.Name()] = nic
+		byID[nic.ID()] = nic
+	}
+	newBonds := map[string]bool{}
+	newSlaves := map[string]bool{}
+	for _, bond := range params.Bonds() {
+		if existing, ok := byName[bond.Name()]; ok && existing.Bond() == nil {
+			return newError(EBadArgument, "cannot create bond %s, a network interface with that name exists", bond.Name())
+		}
+		newBonds[bond.Name()] = true
+		for _, slaveName := range bond.SlaveNames() {
+			slave, ok := byName[slaveName]
+			if !ok {
+				return newError(ENotFound, "network interface %s for bond %s not found", slaveName, bond.Name())
+			}
+			if slave.Bond() != nil {
+				return newError(EBadArgument, "bond %s cannot be a slave of bond %s", slaveName, bond.Name())
+			}
+			if masterID := slave.BondMasterID(); masterID != nil {
+				master, ok := byID[*masterID]
+				if !ok {
+					return newError(
+						EBug,
+						"network interface %s is a slave of bond %s, which is not in the network interface list",
+						slaveName,
+						*masterID,
+					)
+				}
+				if master.Name() != bond.Name() {
+					return newError(EBadArgument, "%s is already a slave of bond %s", slaveName, master.Name())
+				}
+			}
+			newSlaves[slaveName] = true
+		}
+	}
+	for _, attachment := range params.NetworkAttachments() {
+		nic, ok := byName[attachment.HostNICName()]
+		if !ok && !newBonds[attachment.HostNICName()] {
+			return newError(ENotFound, "network interface %s not found", attachment.HostNICName())
+		}
+		if newSlaves[attachment.HostNICName()] || (ok && nic.BondMasterID() != nil) {
+			return newError(
+				EBadArgument,
+				"cannot attach network %s to %s, it is a bond slave",
+				attachment.NetworkID(),
+				attachment.HostNICName(),
+			)
+		}
+	}
+	return nil
+}
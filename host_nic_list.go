@@ -0,0 +1,60 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListHostNICs(hostID HostID, retries ...RetryStrategy) (result []HostNIC, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []HostNIC{}
+	err = retry(
+		fmt.Sprintf("listing NICs of host %s", hostID),
+		o.logger,
+		retries,
+		func() error {
+			response, e := o.conn.SystemService().HostsService().HostService(string(hostID)).NicsService().List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Nics()
+			if !ok {
+				return nil
+			}
+			nics := make([]*hostNIC, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				nics[i], e = convertSDKHostNIC(sdkObject, hostID)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert host NIC during listing item #%d", i)
+				}
+			}
+			linkBondSlaves(nics)
+			result = make([]HostNIC, len(nics))
+			for i, nic := range nics {
+				result[i] = nic
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) ListHostNICs(hostID HostID, retries ...RetryStrategy) (result []HostNIC, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	err = retry(
+		fmt.Sprintf("listing network interfaces of host %s", hostID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, ok := m.hosts[hostID]; !ok {
+				return newError(ENotFound, "host with ID %s not found", hostID)
+			}
+			result = make([]HostNIC, len(m.hostNICs[hostID]))
+			for i, nic := range m.hostNICs[hostID] {
+				result[i] = nic.clone()
+			}
+			return nil
+		})
+	return
+}
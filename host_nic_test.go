@@ -0,0 +1,106 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestListHostNICs(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	hosts, err := helper.GetClient().ListHosts()
+	if err != nil {
+		t.Fatalf("Failed to list hosts (%v)", err)
+	}
+	for _, host := range hosts {
+		nics, err := host.ListNICs()
+		if err != nil {
+			t.Fatalf("Failed to list NICs of host %s (%v)", host.ID(), err)
+		}
+		if len(nics) == 0 {
+			t.Fatalf("No NICs found on host %s.", host.ID())
+		}
+		for _, nic := range nics {
+			if nic.Name() == "" || nic.HostID() != host.ID() {
+				t.Fatalf("Incorrect name or host ID on NIC %s.", nic.ID())
+			}
+		}
+	}
+}
+
+func TestSetupHostNetworksWithBond(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	if _, ok := helper.GetClient().(ovirtclient.MockClient); !ok {
+		t.Skip("Changing the network configuration of a live host may cut it off from the engine.")
+	}
+	client := helper.GetClient()
+	host := findIdleHost(t, helper)
+
+	network, err := client.CreateNetwork(assertCanGetTestDatacenterID(t, helper), helper.GenerateTestResourceName(t), nil)
+	if err != nil {
+		t.Fatalf("Failed to create network (%v)", err)
+	}
+	if err := network.AssignToCluster(host.ClusterID(), false); err != nil {
+		t.Fatalf("Failed to assign network %s to cluster %s (%v)", network.ID(), host.ClusterID(), err)
+	}
+
+	err = host.SetupNetworks(
+		ovirtclient.SetupHostNetworksParams().
+			MustWithBond("bond0", ovirtclient.BondModeActiveBackup, "eth2", "eth3").
+			MustWithNetworkAttachment(network.ID(), "bond0", ovirtclient.HostNetworkDHCPIPConfig()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to set up networks on host %s (%v)", host.ID(), err)
+	}
+
+	nics, err := host.ListNICs()
+	if err != nil {
+		t.Fatalf("Failed to list NICs of host %s (%v)", host.ID(), err)
+	}
+	bond := findHostNIC(nics, "bond0")
+	if bond == nil || bond.Bond() == nil {
+		t.Fatalf("Bond bond0 was not created on host %s.", host.ID())
+	}
+	if bond.Bond().Mode() != ovirtclient.BondModeActiveBackup || len(bond.Bond().SlaveIDs()) != 2 {
+		t.Fatalf("Incorrect bond configuration on host %s.", host.ID())
+	}
+	if bond.NetworkID() == nil || *bond.NetworkID() != network.ID() {
+		t.Fatalf("Network %s is not attached to bond0.", network.ID())
+	}
+	if slave := findHostNIC(nics, "eth2"); slave.BondMasterID() == nil || *slave.BondMasterID() != bond.ID() {
+		t.Fatalf("eth2 is not listed as a slave of bond0.")
+	}
+
+	err = host.SetupNetworks(ovirtclient.SetupHostNetworksParams().MustWithNetworkAttachment(network.ID(), "eth3", nil))
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Attaching a network to an existing bond slave did not result in an EBadArgument error (%v)", err)
+	}
+}
+
+func TestSetupHostNetworksParamsRejectsNetworkOnBondSlave(t *testing.T) {
+	t.Parallel()
+
+	params := ovirtclient.SetupHostNetworksParams().
+		MustWithBond("bond0", ovirtclient.BondMode8023AD, "eth1", "eth2")
+	if _, err := params.WithNetworkAttachment("network", "eth1", nil); err == nil {
+		t.Fatalf("Attaching a network to a bond slave did not result in an error.")
+	}
+	if _, err := params.WithBond("bond1", ovirtclient.BondMode8023AD, "eth2", "eth3"); err == nil {
+		t.Fatalf("Adding a NIC to two bonds did not result in an error.")
+	}
+	if _, err := params.WithBond("bond1", ovirtclient.BondMode8023AD, "eth3"); err == nil {
+		t.Fatalf("Creating a bond with a single slave did not result in an error.")
+	}
+}
+
+func findHostNIC(nics []ovirtclient.HostNIC, name string) ovirtclient.HostNIC {
+	for _, nic := range nics {
+		if nic.Name() == name {
+			return nic
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) RestartHost(id HostID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("restarting host %s", id),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(id)).
+				Fence().
+				FenceType(string(ovirtsdk.FENCETYPE_RESTART)).
+				Send()
+			return err
+		})
+	return
+}
+
+func (m *mockClient) RestartHost(id HostID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("restarting host %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			item, ok := m.hosts[id]
+			if !ok {
+				return newError(ENotFound, "host with ID %s not found", id)
+			}
+			if item.status == HostStatusReboot {
+				return nil
+			}
+			if runningVMs := m.runningVMsOnHost(id); len(runningVMs) > 0 {
+				return newError(
+					EConflict,
+					"host %s cannot be restarted while %d VMs are running on it",
+					id,
+					len(runningVMs),
+				)
+			}
+
+			previousStatus := item.status
+			rebooting := item.clone()
+			rebooting.status = HostStatusReboot
+			m.hosts[id] = rebooting
+			go func() {
+				time.Sleep(2 * time.Second)
+				m.lock.Lock()
+				defer m.lock.Unlock()
+				if item, ok := m.hosts[id]; ok && item.status == HostStatusReboot {
+					restarted := item.clone()
+					restarted.status = previousStatus
+					m.hosts[id] = restarted
+				}
+			}()
+			return nil
+		})
+}
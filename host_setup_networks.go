@@ -0,0 +1,225 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"net"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) SetupHostNetworks(
+	hostID HostID,
+	params SetupHostNetworksParameters,
+	retries ...RetryStrategy,
+) (err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	nics, err := o.ListHostNICs(hostID, retries...)
+	if err != nil {
+		return err
+	}
+	if err := validateSetupHostNetworks(nics, params); err != nil {
+		return err
+	}
+	bonds, err := buildSDKHostBonds(params)
+	if err != nil {
+		return err
+	}
+	attachments, err := buildSDKNetworkAttachments(params)
+	if err != nil {
+		return err
+	}
+
+	err = retry(
+		fmt.Sprintf("setting up networks on host %s", hostID),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().HostsService().HostService(string(hostID)).SetupNetworks().
+				ModifiedBonds(bonds).
+				ModifiedNetworkAttachments(attachments).
+				CommitOnSuccess(params.CommitOnSuccess()).
+				Send()
+			return err
+		})
+	return
+}
+
+func buildSDKHostBonds(params SetupHostNetworksParameters) (*ovirtsdk4.HostNicSlice, error) {
+	result := &ovirtsdk4.HostNicSlice{}
+	for _, bond := range params.Bonds() {
+		slaves := make([]*ovirtsdk4.HostNic, len(bond.SlaveNames()))
+		for i, slaveName := range bond.SlaveNames() {
+			slaves[i] = ovirtsdk4.NewHostNicBuilder().Name(slaveName).MustBuild()
+		}
+		sdkBond, err := ovirtsdk4.NewHostNicBuilder().
+			Name(bond.Name()).
+			Bonding(
+				ovirtsdk4.NewBondingBuilder().
+					OptionsOfAny(ovirtsdk4.NewOptionBuilder().Name("mode").Value(bond.Mode().number()).MustBuild()).
+					SlavesOfAny(slaves...).
+					MustBuild(),
+			).
+			Build()
+		if err != nil {
+			return nil, wrap(err, EBug, "failed to build bond %s", bond.Name())
+		}
+		result.SetSlice(append(result.Slice(), sdkBond))
+	}
+	return result, nil
+}
+
+func buildSDKNetworkAttachments(params SetupHostNetworksParameters) (*ovirtsdk4.NetworkAttachmentSlice, error) {
+	result := &ovirtsdk4.NetworkAttachmentSlice{}
+	for _, attachment := range params.NetworkAttachments() {
+		builder := ovirtsdk4.NewNetworkAttachmentBuilder().
+			Network(ovirtsdk4.NewNetworkBuilder().Id(string(attachment.NetworkID())).MustBuild()).
+			HostNic(ovirtsdk4.NewHostNicBuilder().Name(attachment.HostNICName()).MustBuild())
+		if ipConfig := attachment.IPConfig(); ipConfig != nil {
+			ipBuilder := ovirtsdk4.NewIpBuilder().Version(ovirtsdk4.IPVERSION_V4)
+			if ipConfig.Address() != nil {
+				ipBuilder.Address(ipConfig.Address().String())
+			}
+			if ipConfig.Netmask() != nil {
+				ipBuilder.Netmask(ipConfig.Netmask().String())
+			}
+			if ipConfig.Gateway() != nil {
+				ipBuilder.Gateway(ipConfig.Gateway().String())
+			}
+			builder.IpAddressAssignmentsOfAny(
+				ovirtsdk4.NewIpAddressAssignmentBuilder().
+					AssignmentMethod(ovirtsdk4.BootProtocol(ipConfig.BootProtocol())).
+					Ip(ipBuilder.MustBuild()).
+					MustBuild(),
+			)
+		}
+		sdkAttachment, err := builder.Build()
+		if err != nil {
+			return nil, wrap(err, EBug, "failed to build network attachment for network %s", attachment.NetworkID())
+		}
+		result.SetSlice(append(result.Slice(), sdkAttachment))
+	}
+	return result, nil
+}
+
+func (m *mockClient) SetupHostNetworks(
+	hostID HostID,
+	params SetupHostNetworksParameters,
+	retries ...RetryStrategy,
+) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("setting up networks on host %s", hostID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			h, ok := m.hosts[hostID]
+			if !ok {
+				return newError(ENotFound, "host with ID %s not found", hostID)
+			}
+			// We apply the changes to copies so that the NICs returned earlier do not change.
+			newNICs := make([]*hostNIC, len(m.hostNICs[hostID]))
+			nics := make([]HostNIC, len(m.hostNICs[hostID]))
+			for i, nic := range m.hostNICs[hostID] {
+				newNICs[i] = nic.clone()
+				nics[i] = newNICs[i]
+			}
+			if err := validateSetupHostNetworks(nics, params); err != nil {
+				return err
+			}
+			for _, attachment := range params.NetworkAttachments() {
+				if _, ok := m.networks[attachment.NetworkID()]; !ok {
+					return newError(ENotFound, "network with ID %s not found", attachment.NetworkID())
+				}
+				if _, ok := m.clusterNetworks[h.clusterID][attachment.NetworkID()]; !ok {
+					return newError(
+						EBadArgument,
+						"network %s is not assigned to cluster %s of host %s",
+						attachment.NetworkID(),
+						h.clusterID,
+						hostID,
+					)
+				}
+			}
+
+			for _, bond := range params.Bonds() {
+				newNICs = m.applyMockHostBond(hostID, newNICs, bond)
+			}
+			for _, attachment := range params.NetworkAttachments() {
+				applyMockNetworkAttachment(newNICs, attachment)
+			}
+			m.hostNICs[hostID] = newNICs
+			return nil
+		})
+}
+
+// applyMockHostBond creates or modifies a bond in the NIC list and returns the new list.
+func (m *mockClient) applyMockHostBond(hostID HostID, nics []*hostNIC, params HostBondParameters) []*hostNIC {
+	var bond *hostNIC
+	for _, nic := range nics {
+		if nic.name == params.Name() {
+			bond = nic
+		}
+	}
+	if bond == nil {
+		bond = &hostNIC{
+			id:           HostNICID(m.GenerateUUID()),
+			hostID:       hostID,
+			name:         params.Name(),
+			mtu:          1500,
+			bootProtocol: HostNICBootProtocolNone,
+		}
+		nics = append(nics, bond)
+	}
+	bond.bond = &hostNICBond{
+		mode: params.Mode(),
+	}
+	bond.speed = 0
+	for _, nic := range nics {
+		if nic.bondMasterID != nil && *nic.bondMasterID == bond.id {
+			nic.bondMasterID = nil
+		}
+		for _, slaveName := range params.SlaveNames() {
+			if nic.name != slaveName {
+				continue
+			}
+			bondID := bond.id
+			nic.bondMasterID = &bondID
+			bond.bond.slaveIDs = append(bond.bond.slaveIDs, nic.id)
+			bond.speed += nic.speed
+			if bond.mac == "" {
+				bond.mac = nic.mac
+			}
+		}
+	}
+	return nics
+}
+
+// applyMockNetworkAttachment moves the network to the NIC specified in the attachment.
+func applyMockNetworkAttachment(nics []*hostNIC, attachment HostNetworkAttachmentParameters) {
+	for _, nic := range nics {
+		if nic.networkID != nil && *nic.networkID == attachment.NetworkID() {
+			nic.networkID = nil
+			nic.bootProtocol = HostNICBootProtocolNone
+			nic.ipv4Address = nil
+		}
+	}
+	for _, nic := range nics {
+		if nic.name != attachment.HostNICName() {
+			continue
+		}
+		networkID := attachment.NetworkID()
+		nic.networkID = &networkID
+		nic.bootProtocol = HostNICBootProtocolNone
+		nic.ipv4Address = nil
+		if ipConfig := attachment.IPConfig(); ipConfig != nil {
+			nic.bootProtocol = ipConfig.BootProtocol()
+			nic.ipv4Address = ipConfig.Address()
+			if ipConfig.BootProtocol() == HostNICBootProtocolDHCP {
+				nic.ipv4Address = net.ParseIP("192.168.0.124")
+			}
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package ovirtclient
+
+import (
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// HostStorageClient contains the functions for discovering the block storage visible to hosts. The discovered LUNs
+// can be used to create block storage domains or direct LUN disks.
+type HostStorageClient interface {
+	// DiscoverISCSITargets lists the iSCSI targets offered by the portal at the specified address and port, as seen
+	// from the specified host. The host must be up.
+	DiscoverISCSITargets(hostID HostID, address string, port uint, retries ...RetryStrategy) ([]ISCSITarget, error)
+	// ListHostLUNs lists the iSCSI and Fibre Channel LUNs visible to the specified host. For iSCSI LUNs to show up,
+	// the host must be logged in to the target. The host must be up.
+	ListHostLUNs(hostID HostID, retries ...RetryStrategy) ([]LUN, error)
+}
+
+// ISCSITarget is an iSCSI target discovered on a portal.
+type ISCSITarget interface {
+	// Address returns the address of the iSCSI portal.
+	Address() string
+	// Port returns the port of the iSCSI portal.
+	Port() uint
+	// Target returns the name of the iSCSI target, for example iqn.2022-04.org.example:storage.
+	Target() string
+}
+
+// LUN is a logical unit on block storage.
+type LUN interface {
+	// ID returns the identifier of the LUN. For Fibre Channel LUNs this is the WWID.
+	ID() string
+	// StorageType returns the type of the block storage the LUN is located on. This is either StorageDomainTypeISCSI
+	// or StorageDomainTypeFCP.
+	StorageType() StorageDomainType
+	// Address returns the address of the iSCSI portal. Empty for Fibre Channel LUNs.
+	Address() string
+	// Port returns the port of the iSCSI portal. Zero for Fibre Channel LUNs.
+	Port() uint
+	// Target returns the name of the iSCSI target. Empty for Fibre Channel LUNs.
+	Target() string
+	// Size returns the size of the LUN in bytes.
+	Size() uint64
+	// VendorID returns the vendor of the storage device.
+	VendorID() string
+	// ProductID returns the product name of the storage device.
+	ProductID() string
+	// Serial returns the serial number of the LUN.
+	Serial() string
+	// DiskID returns the direct LUN disk using the LUN, or an empty string if the LUN is not used by a disk.
+	DiskID() DiskID
+	// StorageDomainID returns the storage domain using the LUN, or an empty string if the LUN is not used by a
+	// storage domain.
+	StorageDomainID() StorageDomainID
+}
+
+func convertSDKISCSITarget(sdkObject *ovirtsdk4.IscsiDetails) (ISCSITarget, error) {
+	target, ok := sdkObject.Target()
+	if !ok {
+		return nil, newFieldNotFound("iSCSI target", "target")
+	}
+	result := &iscsiTarget{
+		target: target,
+	}
+	result.address, _ = sdkObject.Address()
+	if port, ok := sdkObject.Port(); ok {
+		result.port = uint(port)
+	}
+	return result, nil
+}
+
+type iscsiTarget struct {
+	address string
+	port    uint
+	target  string
+}
+
+func (i *iscsiTarget) Address() string {
+	return i.address
+}
+
+func (i *iscsiTarget) Port() uint {
+	return i.port
+}
+
+func (i *iscsiTarget) Target() string {
+	return i.target
+}
+
+func convertSDKLogicalUnit(sdkObject *ovirtsdk4.LogicalUnit, storageType StorageDomainType) (*lun, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("logical unit", "ID")
+	}
+	result := &lun{
+		id:          id,
+		storageType: storageType,
+	}
+	result.address, _ = sdkObject.Address()
+	if port, ok := sdkObject.Port(); ok {
+		result.port = uint(port)
+	}
+	result.target, _ = sdkObject.Target()
+	if size, ok := sdkObject.Size(); ok {
+		result.size = uint64(size)
+	}
+	result.vendorID, _ = sdkObject.VendorId()
+	result.productID, _ = sdkObject.ProductId()
+	result.serial, _ = sdkObject.Serial()
+	if diskID, ok := sdkObject.DiskId(); ok {
+		result.diskID = DiskID(diskID)
+	}
+	if storageDomainID, ok := sdkObject.StorageDomainId(); ok {
+		result.storageDomainID = StorageDomainID(storageDomainID)
+	}
+	return result, nil
+}
+
+func convertSDKHostStorageLUNs(sdkObject *ovirtsdk4.HostStorage) ([]*lun, error) {
+	storageType, ok := sdkObject.Type()
+	if !ok {
+		return nil, newFieldNotFound("host storage", "type")
+	}
+	sdkLogicalUnits, ok := sdkObject.LogicalUnits()
+	if !ok {
+		return nil, nil
+	}
+	result := make([]*lun, len(sdkLogicalUnits.Slice()))
+	for i, sdkLogicalUnit := range sdkLogicalUnits.Slice() {
+		var err error
+		result[i], err = convertSDKLogicalUnit(sdkLogicalUnit, StorageDomainType(storageType))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// convertSDKDiskLUNStorage converts the storage of a direct LUN disk. A LUN disk is backed by exactly one LUN.
+func convertSDKDiskLUNStorage(sdkObject *ovirtsdk4.HostStorage) (*lun, error) {
+	luns, err := convertSDKHostStorageLUNs(sdkObject)
+	if err != nil {
+		return nil, err
+	}
+	if len(luns) != 1 {
+		return nil, newError(EFieldMissing, "expected exactly one logical unit on LUN disk, found %d", len(luns))
+	}
+	return luns[0], nil
+}
+
+type lun struct {
+	id              string
+	storageType     StorageDomainType
+	address         string
+	port            uint
+	target          string
+	size            uint64
+	vendorID        string
+	productID       string
+	serial          string
+	diskID          DiskID
+	storageDomainID StorageDomainID
+}
+
+func (l *lun) ID() string {
+	return l.id
+}
+
+func (l *lun) StorageType() StorageDomainType {
+	return l.storageType
+}
+
+func (l *lun) Address() string {
+	return l.address
+}
+
+func (l *lun) Port() uint {
+	return l.port
+}
+
+func (l *lun) Target() string {
+	return l.target
+}
+
+func (l *lun) Size() uint64 {
+	return l.size
+}
+
+func (l *lun) VendorID() string {
+	return l.vendorID
+}
+
+func (l *lun) ProductID() string {
+	return l.productID
+}
+
+func (l *lun) Serial() string {
+	return l.serial
+}
+
+func (l *lun) DiskID() DiskID {
+	return l.diskID
+}
+
+func (l *lun) StorageDomainID() StorageDomainID {
+	return l.storageDomainID
+}
+
+func (l *lun) clone() *lun {
+	result := *l
+	return &result
+}
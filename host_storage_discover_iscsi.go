@@ -0,0 +1,99 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) DiscoverISCSITargets(
+	hostID HostID,
+	address string,
+	port uint,
+	retries ...RetryStrategy,
+) (result []ISCSITarget, err error) {
+	if err := validateISCSIPortal(address, port); err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []ISCSITarget{}
+	err = retry(
+		fmt.Sprintf("discovering iSCSI targets on %s:%d from host %s", address, port, hostID),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().HostsService().HostService(string(hostID)).
+				DiscoverIscsi().
+				Iscsi(ovirtsdk4.NewIscsiDetailsBuilder().Address(address).Port(int64(port)).MustBuild()).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObjects, ok := response.DiscoveredTargets()
+			if !ok {
+				return nil
+			}
+			result = make([]ISCSITarget, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], err = convertSDKISCSITarget(sdkObject)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert iSCSI target during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) DiscoverISCSITargets(
+	hostID HostID,
+	address string,
+	port uint,
+	retries ...RetryStrategy,
+) (result []ISCSITarget, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if err := validateISCSIPortal(address, port); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("discovering iSCSI targets at %s:%d from host %s", address, port, hostID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if err := m.checkHostCanAccessStorage(hostID); err != nil {
+				return err
+			}
+			result = []ISCSITarget{}
+			seen := map[string]bool{}
+			for _, l := range m.luns {
+				if l.storageType != StorageDomainTypeISCSI || l.address != address || l.port != port || seen[l.target] {
+					continue
+				}
+				seen[l.target] = true
+				result = append(result, &iscsiTarget{
+					address: l.address,
+					port:    l.port,
+					target:  l.target,
+				})
+			}
+			if len(result) == 0 {
+				return newError(ENotFound, "no iSCSI portal found at %s:%d", address, port)
+			}
+			return nil
+		})
+	return
+}
+
+func validateISCSIPortal(address string, port uint) error {
+	if address == "" {
+		return newError(EBadArgument, "iSCSI portal address cannot be empty")
+	}
+	if port == 0 || port > 65535 {
+		return newError(EBadArgument, "invalid iSCSI portal port: %d", port)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListHostLUNs(hostID HostID, retries ...RetryStrategy) (result []LUN, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []LUN{}
+	err = retry(
+		fmt.Sprintf("listing LUNs of host %s", hostID),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().HostsService().HostService(string(hostID)).
+				StorageService().
+				List().
+				ReportStatus(false).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObjects, ok := response.Storages()
+			if !ok {
+				return nil
+			}
+			result = []LUN{}
+			for i, sdkObject := range sdkObjects.Slice() {
+				luns, err := convertSDKHostStorageLUNs(sdkObject)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert host storage during listing item #%d", i)
+				}
+				for _, l := range luns {
+					result = append(result, l)
+				}
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) ListHostLUNs(hostID HostID, retries ...RetryStrategy) (result []LUN, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	err = retry(
+		fmt.Sprintf("listing LUNs visible to host %s", hostID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if err := m.checkHostCanAccessStorage(hostID); err != nil {
+				return err
+			}
+			result = make([]LUN, len(m.luns))
+			for i, l := range m.luns {
+				item := l.clone()
+				if d := m.findLUNDisk(l.id); d != nil {
+					item.diskID = d.id
+				}
+				result[i] = item
+			}
+			return nil
+		})
+	return
+}
+
+// checkHostCanAccessStorage returns an error if the host does not exist or is not up. The caller must hold the lock
+// of the mock client.
+func (m *mockClient) checkHostCanAccessStorage(hostID HostID) error {
+	h, ok := m.hosts[hostID]
+	if !ok {
+		return newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	if h.status != HostStatusUp {
+		return newError(EConflict, "host %s is in status %s and cannot access storage", hostID, h.status)
+	}
+	return nil
+}
+
+// findLUNDisk returns the direct LUN disk using the specified LUN, or nil if the LUN is not used by a disk. The
+// caller must hold the lock of the mock client.
+func (m *mockClient) findLUNDisk(lunID string) *diskWithData {
+	for _, d := range m.disks {
+		if d.lunStorage != nil && d.lunStorage.id == lunID {
+			return d
+		}
+	}
+	return nil
+}
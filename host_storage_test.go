@@ -0,0 +1,95 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestLUNDiskCreation(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+	host := findUpHost(t, helper)
+	l := findFreeISCSILUN(t, host)
+
+	t.Logf("Discovering iSCSI targets on %s:%d...", l.Address(), l.Port())
+	targets, err := host.DiscoverISCSITargets(l.Address(), l.Port())
+	if err != nil {
+		t.Fatalf("Failed to discover iSCSI targets on %s:%d. (%v)", l.Address(), l.Port(), err)
+	}
+	found := false
+	for _, target := range targets {
+		if target.Target() == l.Target() {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("Target %s of LUN %s was not discovered on %s:%d.", l.Target(), l.ID(), l.Address(), l.Port())
+	}
+
+	t.Logf("Creating LUN disk on LUN %s...", l.ID())
+	storage := ovirtclient.MustNewISCSILUNDiskParams(l.Address(), l.Port(), l.Target(), l.ID())
+	disk, err := client.CreateLUNDisk(
+		storage,
+		ovirtclient.CreateDiskParams().MustWithAlias("lun_disk_test").MustWithShareable(true),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create LUN disk on LUN %s. (%v)", l.ID(), err)
+	}
+	t.Cleanup(func() {
+		if err := disk.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("Failed to remove LUN disk %s. (%v)", disk.ID(), err)
+		}
+	})
+	lunStorage := disk.LUNStorage()
+	if lunStorage == nil {
+		t.Fatalf("The LUN disk has no LUN storage.")
+	}
+	if lunStorage.ID() != l.ID() || lunStorage.StorageType() != ovirtclient.StorageDomainTypeISCSI {
+		t.Fatalf("The LUN disk is backed by the incorrect LUN %s (%s).", lunStorage.ID(), lunStorage.StorageType())
+	}
+	if !disk.Shareable() {
+		t.Fatalf("The LUN disk is not shareable.")
+	}
+
+	luns, err := host.ListLUNs()
+	if err != nil {
+		t.Fatalf("Failed to list LUNs of host %s. (%v)", host.ID(), err)
+	}
+	for _, item := range luns {
+		if item.ID() == l.ID() && item.DiskID() != disk.ID() {
+			t.Fatalf("LUN %s is not reported as used by disk %s.", l.ID(), disk.ID())
+		}
+	}
+
+	_, err = client.CreateLUNDisk(storage, nil, ovirtclient.MaxTries(1))
+	if err == nil {
+		t.Fatalf("Creating a second LUN disk on LUN %s did not result in an error.", l.ID())
+	}
+}
+
+func TestLUNDiskRejectsImageParameters(t *testing.T) {
+	helper := getHelper(t)
+
+	_, err := helper.GetClient().CreateLUNDisk(
+		ovirtclient.MustNewFCPLUNDiskParams("3600a098038304437415d4b6a59682f44"),
+		ovirtclient.CreateDiskParams().MustWithSparse(true),
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Creating a sparse LUN disk did not result in an EBadArgument error (%v).", err)
+	}
+}
+
+func findFreeISCSILUN(t *testing.T, host ovirtclient.Host) ovirtclient.LUN {
+	luns, err := host.ListLUNs()
+	if err != nil {
+		t.Fatalf("Failed to list LUNs of host %s. (%v)", host.ID(), err)
+	}
+	for _, l := range luns {
+		if l.StorageType() == ovirtclient.StorageDomainTypeISCSI && l.DiskID() == "" && l.StorageDomainID() == "" {
+			return l
+		}
+	}
+	t.Skipf("No free iSCSI LUN found on host %s.", host.ID())
+	return nil
+}
@@ -0,0 +1,48 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) AddTagToHost(id HostID, tagID TagID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("adding tag %s to host %s", tagID, id),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				HostsService().
+				HostService(string(id)).
+				TagsService().
+				Add().
+				Tag(ovirtsdk.NewTagBuilder().Id(string(tagID)).MustBuild()).
+				Send()
+			return err
+		})
+	return
+}
+
+func (m *mockClient) AddTagToHost(id HostID, tagID TagID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("adding tag %s to host %s", tagID, id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, ok := m.hosts[id]; !ok {
+				return newError(ENotFound, "host with ID %s not found", id)
+			}
+			if _, ok := m.tags[tagID]; !ok {
+				return newError(ENotFound, "tag with ID %s not found", tagID)
+			}
+			m.tagIDsByHost[id] = addMockTagID(m.tagIDsByHost[id], tagID)
+			return nil
+		})
+}
@@ -0,0 +1,58 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListHostTags(id HostID, retries ...RetryStrategy) (result []Tag, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("listing tags for host %s", id),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().HostsService().HostService(string(id)).TagsService().List().Send()
+			if err != nil {
+				return err
+			}
+			sdkObjects, ok := response.Tags()
+			if !ok {
+				return newError(
+					ENotFound,
+					"no tags returned when getting host %s tags",
+					id,
+				)
+			}
+			result = make([]Tag, len(sdkObjects.Slice()))
+			for i, sdkTag := range sdkObjects.Slice() {
+				result[i], err = convertSDKTag(sdkTag, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert tag of host %s", id)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) ListHostTags(id HostID, retries ...RetryStrategy) (result []Tag, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	err = retry(
+		fmt.Sprintf("listing tags for host %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, ok := m.hosts[id]; !ok {
+				return newError(ENotFound, "host with ID %s not found", id)
+			}
+			result = make([]Tag, len(m.tagIDsByHost[id]))
+			for i, tagID := range m.tagIDsByHost[id] {
+				result[i] = m.tags[tagID]
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,46 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestHostTagAssignment(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	hosts, err := helper.GetClient().ListHosts()
+	if err != nil {
+		t.Fatalf("Failed to list hosts (%v)", err)
+	}
+	if len(hosts) == 0 {
+		t.Fatalf("No hosts found.")
+	}
+	host := hosts[0]
+	tag := assertCanCreateTag(t, helper, fmt.Sprintf("%s-%s", t.Name(), helper.GenerateRandomID(5)), "")
+
+	if err := host.AddTag(tag.ID()); err != nil {
+		t.Fatalf("Failed to add tag %s to host %s (%v)", tag.ID(), host.ID(), err)
+	}
+	t.Cleanup(func() {
+		_ = host.RemoveTag(tag.ID())
+	})
+	hostTags, err := host.ListTags()
+	if err != nil {
+		t.Fatalf("Failed to list tags of host %s (%v)", host.ID(), err)
+	}
+	if !containsTag(hostTags, tag) {
+		t.Fatalf("Tag %s was not found on host %s.", tag.ID(), host.ID())
+	}
+
+	if err := host.RemoveTag(tag.ID()); err != nil {
+		t.Fatalf("Failed to remove tag %s from host %s (%v)", tag.ID(), host.ID(), err)
+	}
+	hostTags, err = host.ListTags()
+	if err != nil {
+		t.Fatalf("Failed to list tags of host %s (%v)", host.ID(), err)
+	}
+	if containsTag(hostTags, tag) {
+		t.Fatalf("Tag %s is still on host %s after removal.", tag.ID(), host.ID())
+	}
+}
@@ -0,0 +1,130 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestHostData(t *testing.T) {
+	helper := getHelper(t)
+
+	hosts, err := helper.GetClient().ListHosts()
+	if err != nil {
+		t.Fatalf("Failed to list hosts (%v)", err)
+	}
+	if len(hosts) == 0 {
+		t.Fatalf("No hosts found.")
+	}
+	for _, host := range hosts {
+		if host.Name() == "" {
+			t.Fatalf("Host %s has no name.", host.ID())
+		}
+		if host.Status() == ovirtclient.HostStatusUp && host.Memory() <= 0 {
+			t.Fatalf("Host %s is up but reports no memory.", host.ID())
+		}
+	}
+}
+
+func TestHostMaintenanceCycle(t *testing.T) {
+	helper := getHelper(t)
+
+	host := findIdleHost(t, helper)
+	if err := host.Deactivate(); err != nil {
+		t.Fatalf("Failed to put host %s into maintenance (%v)", host.ID(), err)
+	}
+	t.Cleanup(func() {
+		host, err := helper.GetClient().GetHost(host.ID())
+		if err != nil {
+			t.Fatalf("Failed to fetch host %s after test (%v)", host.ID(), err)
+		}
+		if host.Status() == ovirtclient.HostStatusUp {
+			return
+		}
+		if _, err := host.WaitForStatus(ovirtclient.HostStatusMaintenance); err != nil {
+			t.Fatalf("Failed to wait for host %s to enter maintenance after test (%v)", host.ID(), err)
+		}
+		if err := host.Activate(); err != nil {
+			t.Fatalf("Failed to activate host %s after test (%v)", host.ID(), err)
+		}
+		if _, err := host.WaitForStatus(ovirtclient.HostStatusUp); err != nil {
+			t.Fatalf("Failed to wait for host %s to come up after test (%v)", host.ID(), err)
+		}
+	})
+	if _, err := host.WaitForStatus(ovirtclient.HostStatusMaintenance); err != nil {
+		t.Fatalf("Host %s did not enter maintenance (%v)", host.ID(), err)
+	}
+	if err := host.Activate(); err != nil {
+		t.Fatalf("Failed to activate host %s (%v)", host.ID(), err)
+	}
+	if _, err := host.WaitForStatus(ovirtclient.HostStatusUp); err != nil {
+		t.Fatalf("Host %s did not come up after activation (%v)", host.ID(), err)
+	}
+}
+
+func TestHostWithPinnedVMCannotEnterMaintenance(t *testing.T) {
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().WithPlacementPolicy(
+			ovirtclient.NewVMPlacementPolicyParameters().MustWithAffinity(ovirtclient.VMAffinityPinned),
+		),
+	)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanUploadDiskImage(t, helper, disk)
+	assertCanAttachDiskWithParams(
+		t,
+		vm,
+		disk,
+		ovirtclient.CreateDiskAttachmentParams().MustWithBootable(true).MustWithActive(true),
+	)
+	assertCanStartVM(t, helper, vm)
+	vm = assertVMWillStart(t, vm)
+
+	err := helper.GetClient().DeactivateHost(*vm.HostID(), ovirtclient.MaxTries(1))
+	if err == nil {
+		t.Fatalf("Putting a host with a pinned VM into maintenance did not result in an error.")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Putting a host with a pinned VM into maintenance did not return an EConflict error (%v)", err)
+	}
+}
+
+func TestWaitForHostStatusRejectsInvalidStatus(t *testing.T) {
+	helper := getHelper(t)
+
+	hosts, err := helper.GetClient().ListHosts()
+	if err != nil {
+		t.Fatalf("Failed to list hosts (%v)", err)
+	}
+	_, err = hosts[0].WaitForStatus("invalid")
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Waiting for an invalid host status did not return an EBadArgument error (%v)", err)
+	}
+}
+
+// findIdleHost returns a host that can be put into maintenance without affecting running VMs. The test is skipped
+// if no such host exists or if it is the only host in its cluster.
+func findIdleHost(t *testing.T, helper ovirtclient.TestHelper) ovirtclient.Host {
+	hosts, err := helper.GetClient().ListHosts()
+	if err != nil {
+		t.Fatalf("Failed to list hosts (%v)", err)
+	}
+	upHostsPerCluster := map[ovirtclient.ClusterID]int{}
+	for _, host := range hosts {
+		if host.Status() == ovirtclient.HostStatusUp {
+			upHostsPerCluster[host.ClusterID()]++
+		}
+	}
+	for _, host := range hosts {
+		if host.Status() == ovirtclient.HostStatusUp && host.RunningVMCount() == 0 &&
+			upHostsPerCluster[host.ClusterID()] > 1 {
+			return host
+		}
+	}
+	t.Skipf("No idle host found that can be put into maintenance.")
+	return nil
+}
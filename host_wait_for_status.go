@@ -0,0 +1,49 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) WaitForHostStatus(id HostID, status HostStatus, retries ...RetryStrategy) (host Host, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("waiting for host %s status %s", id, status),
+		o.logger,
+		retries,
+		func() error {
+			host, err = o.GetHost(id, retries...)
+			if err != nil {
+				return err
+			}
+			if host.Status() != status {
+				return newError(EPending, "host status is %s, not %s", host.Status(), status)
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) WaitForHostStatus(id HostID, status HostStatus, retries ...RetryStrategy) (host Host, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(m))
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("waiting for host %s status %s", id, status),
+		m.logger,
+		retries,
+		func() error {
+			host, err = m.GetHost(id, retries...)
+			if err != nil {
+				return err
+			}
+			if host.Status() != status {
+				return newError(EPending, "host status is %s, not %s", host.Status(), status)
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,316 @@
+package ovirtclient
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ImageInfo describes a local disk image as determined by InspectImage. The QCOW-specific fields are only filled for
+// QCOW2 images.
+type ImageInfo interface {
+	// Format returns the format of the image.
+	Format() ImageFormat
+	// VirtualSize returns the size of the disk represented by the image in bytes.
+	VirtualSize() uint64
+	// FileSize returns the size of the image file in bytes.
+	FileSize() uint64
+	// QCOWVersion returns the version of the QCOW format, or 0 for raw images.
+	QCOWVersion() uint32
+	// ClusterSize returns the cluster size of a QCOW image in bytes, or 0 for raw images.
+	ClusterSize() uint64
+	// HasBackingFile returns true if the image refers to a backing file. oVirt does not accept such images.
+	HasBackingFile() bool
+	// BackingFile returns the name of the backing file if HasBackingFile returns true.
+	BackingFile() string
+	// Encrypted returns true if the image is encrypted.
+	Encrypted() bool
+	// Compressed returns true if the image contains compressed clusters.
+	Compressed() bool
+	// Corrupt returns true if the image has been marked as corrupt by QEMU.
+	Corrupt() bool
+	// Truncated returns true if the image file is shorter than its metadata tables indicate.
+	Truncated() bool
+	// IncompatibleFeatures returns the names of the incompatible features set in a QCOW version 3 image.
+	IncompatibleFeatures() []string
+
+	// Validate checks if the image can be uploaded to oVirt and returns an EBadArgument error if not.
+	Validate() error
+}
+
+// InspectImage reads the header and metadata tables of a local disk image and returns the image information. Images
+// that are not QCOW2 images are treated as raw images. The reader is returned to its original position afterwards.
+//
+// InspectImage only returns an error if the image cannot be read or is not a valid QCOW image. Call Validate on the
+// returned ImageInfo to check if the image can be uploaded.
+func InspectImage(reader io.ReadSeeker) (ImageInfo, error) {
+	position, err := reader.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, wrap(err, EBadArgument, "failed to determine the current position of the image reader")
+	}
+	fileSize, err := reader.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, wrap(err, EBadArgument, "failed to determine the image size")
+	}
+	info, inspectErr := inspectImage(reader, uint64(fileSize))
+	if _, err := reader.Seek(position, io.SeekStart); err != nil {
+		return nil, wrap(err, EBadArgument, "failed to return the image reader to its original position")
+	}
+	if inspectErr != nil {
+		return nil, inspectErr
+	}
+	return info, nil
+}
+
+func inspectImage(reader io.ReadSeeker, fileSize uint64) (*imageInfo, error) {
+	header := make([]byte, qcowV3HeaderSize)
+	if err := readImageAt(reader, header, 0); err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, wrap(err, EBadArgument, "failed to read image header")
+	}
+	if fileSize < uint64(len(qcowMagicBytes)) || string(header[:len(qcowMagicBytes)]) != qcowMagicBytes {
+		return &imageInfo{
+			format:      ImageFormatRaw,
+			virtualSize: fileSize,
+			fileSize:    fileSize,
+		}, nil
+	}
+	if fileSize < qcowV2HeaderSize {
+		return nil, newError(EBadArgument, "the QCOW image is truncated (%d bytes), the header is incomplete", fileSize)
+	}
+	info, err := parseQCOWHeader(header, fileSize)
+	if err != nil {
+		return nil, err
+	}
+	if err := info.readBackingFile(reader); err != nil {
+		return nil, err
+	}
+	if err := info.scanL2Tables(reader); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// parseQCOWHeader parses the QCOW header fields. See https://github.com/qemu/qemu/blob/master/docs/interop/qcow2.txt
+// for the description of the format.
+func parseQCOWHeader(header []byte, fileSize uint64) (*imageInfo, error) {
+	version := binary.BigEndian.Uint32(header[4:8])
+	if version != 2 && version != 3 {
+		return nil, newError(EBadArgument, "unsupported QCOW version %d, only versions 2 and 3 are supported", version)
+	}
+	clusterBits := binary.BigEndian.Uint32(header[20:24])
+	if clusterBits < 9 || clusterBits > 21 {
+		return nil, newError(EBadArgument, "invalid QCOW cluster size (2^%d bytes)", clusterBits)
+	}
+	info := &imageInfo{
+		format:                ImageFormatCow,
+		virtualSize:           binary.BigEndian.Uint64(header[qcowSizeStartByte : qcowSizeStartByte+8]),
+		fileSize:              fileSize,
+		qcowVersion:           version,
+		clusterSize:           1 << clusterBits,
+		backingFileOffset:     binary.BigEndian.Uint64(header[8:16]),
+		backingFileSize:       binary.BigEndian.Uint32(header[16:20]),
+		encrypted:             binary.BigEndian.Uint32(header[32:36]) != 0,
+		l1Size:                binary.BigEndian.Uint32(header[36:40]),
+		l1TableOffset:         binary.BigEndian.Uint64(header[40:48]),
+		refcountTableOffset:   binary.BigEndian.Uint64(header[48:56]),
+		refcountTableClusters: binary.BigEndian.Uint32(header[56:60]),
+	}
+	if version == 3 {
+		if fileSize < qcowV3HeaderSize-8 {
+			return nil, newError(EBadArgument, "the QCOW image is truncated (%d bytes), the header is incomplete", fileSize)
+		}
+		info.incompatibleFeatures = binary.BigEndian.Uint64(header[72:80])
+	}
+	l1End := info.l1TableOffset + uint64(info.l1Size)*8
+	refcountEnd := info.refcountTableOffset + uint64(info.refcountTableClusters)*info.clusterSize
+	info.truncated = l1End > fileSize || refcountEnd > fileSize
+	return info, nil
+}
+
+// qcowIncompatibleFeatureNames contains the names of the known incompatible feature bits.
+var qcowIncompatibleFeatureNames = []string{ //nolint:gochecknoglobals
+	"dirty",
+	"corrupt",
+	"external_data_file",
+	"compression_type",
+	"extended_l2",
+}
+
+const (
+	qcowIncompatibleFeatureDirty   = 1 << 0
+	qcowIncompatibleFeatureCorrupt = 1 << 1
+)
+
+type imageInfo struct {
+	format                ImageFormat
+	virtualSize           uint64
+	fileSize              uint64
+	qcowVersion           uint32
+	clusterSize           uint64
+	backingFileOffset     uint64
+	backingFileSize       uint32
+	backingFile           string
+	encrypted             bool
+	compressed            bool
+	truncated             bool
+	incompatibleFeatures  uint64
+	l1Size                uint32
+	l1TableOffset         uint64
+	refcountTableOffset   uint64
+	refcountTableClusters uint32
+}
+
+func (i *imageInfo) Format() ImageFormat {
+	return i.format
+}
+
+func (i *imageInfo) VirtualSize() uint64 {
+	return i.virtualSize
+}
+
+func (i *imageInfo) FileSize() uint64 {
+	return i.fileSize
+}
+
+func (i *imageInfo) QCOWVersion() uint32 {
+	return i.qcowVersion
+}
+
+func (i *imageInfo) ClusterSize() uint64 {
+	return i.clusterSize
+}
+
+func (i *imageInfo) HasBackingFile() bool {
+	return i.backingFileOffset != 0
+}
+
+func (i *imageInfo) BackingFile() string {
+	return i.backingFile
+}
+
+func (i *imageInfo) Encrypted() bool {
+	return i.encrypted
+}
+
+func (i *imageInfo) Compressed() bool {
+	return i.compressed
+}
+
+func (i *imageInfo) Corrupt() bool {
+	return i.incompatibleFeatures&qcowIncompatibleFeatureCorrupt != 0
+}
+
+func (i *imageInfo) Truncated() bool {
+	return i.truncated
+}
+
+func (i *imageInfo) IncompatibleFeatures() []string {
+	var result []string
+	for bit := uint(0); bit < 64; bit++ {
+		if i.incompatibleFeatures&(1<<bit) == 0 {
+			continue
+		}
+		if int(bit) < len(qcowIncompatibleFeatureNames) {
+			result = append(result, qcowIncompatibleFeatureNames[bit])
+		} else {
+			result = append(result, fmt.Sprintf("bit_%d", bit))
+		}
+	}
+	return result
+}
+
+func (i *imageInfo) Validate() error {
+	if i.format != ImageFormatCow {
+		return nil
+	}
+	if i.HasBackingFile() {
+		return newError(
+			EBadArgument,
+			"the QCOW image has a backing file (%s), which oVirt does not support; please convert it to a standalone image",
+			i.backingFile,
+		)
+	}
+	if i.encrypted {
+		return newError(EBadArgument, "the QCOW image is encrypted, which oVirt does not support")
+	}
+	if i.Corrupt() {
+		return newError(EBadArgument, "the QCOW image is marked as corrupt, please repair it using qemu-img check -r all")
+	}
+	if i.truncated {
+		return newError(
+			EBadArgument,
+			"the QCOW image is truncated (%d bytes), its metadata tables extend beyond the end of the file",
+			i.fileSize,
+		)
+	}
+	if unsupported := i.incompatibleFeatures &^ qcowIncompatibleFeatureDirty; unsupported != 0 {
+		return newError(
+			EBadArgument,
+			"the QCOW image uses unsupported incompatible features (%s)",
+			strings.Join((&imageInfo{incompatibleFeatures: unsupported}).IncompatibleFeatures(), ", "),
+		)
+	}
+	return nil
+}
+
+// readBackingFile reads the name of the backing file if the image has one.
+func (i *imageInfo) readBackingFile(reader io.ReadSeeker) error {
+	if !i.HasBackingFile() {
+		return nil
+	}
+	if i.backingFileSize > qcowMaxBackingFileNameSize ||
+		i.backingFileOffset+uint64(i.backingFileSize) > i.fileSize {
+		i.truncated = true
+		return nil
+	}
+	name := make([]byte, i.backingFileSize)
+	if err := readImageAt(reader, name, i.backingFileOffset); err != nil {
+		return wrap(err, EBadArgument, "failed to read QCOW backing file name")
+	}
+	i.backingFile = string(name)
+	return nil
+}
+
+// scanL2Tables reads the L1 and L2 tables to determine if the image contains compressed clusters. Tables located
+// beyond the end of the file mark the image as truncated.
+func (i *imageInfo) scanL2Tables(reader io.ReadSeeker) error {
+	if i.truncated || i.encrypted || i.l1Size == 0 {
+		return nil
+	}
+	l1Table := make([]byte, uint64(i.l1Size)*8)
+	if err := readImageAt(reader, l1Table, i.l1TableOffset); err != nil {
+		return wrap(err, EBadArgument, "failed to read QCOW L1 table")
+	}
+	l2Table := make([]byte, i.clusterSize)
+	for l1Index := uint32(0); l1Index < i.l1Size; l1Index++ {
+		l2Offset := binary.BigEndian.Uint64(l1Table[l1Index*8:]) & qcowL1EntryOffsetMask
+		if l2Offset == 0 {
+			continue
+		}
+		if l2Offset+i.clusterSize > i.fileSize {
+			i.truncated = true
+			return nil
+		}
+		if err := readImageAt(reader, l2Table, l2Offset); err != nil {
+			return wrap(err, EBadArgument, "failed to read QCOW L2 table at offset %d", l2Offset)
+		}
+		for l2Index := uint64(0); l2Index < i.clusterSize; l2Index += 8 {
+			if binary.BigEndian.Uint64(l2Table[l2Index:])&qcowL2EntryCompressed != 0 {
+				i.compressed = true
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// readImageAt fills the buffer with the data at the specified offset of the image.
+func readImageAt(reader io.ReadSeeker, p []byte, offset uint64) error {
+	if _, err := reader.Seek(int64(offset), io.SeekStart); err != nil {
+		return err
+	}
+	_, err := io.ReadFull(reader, p)
+	return err
+}
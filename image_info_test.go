@@ -0,0 +1,198 @@
+package ovirtclient_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestInspectImageRaw(t *testing.T) {
+	t.Parallel()
+	testImageData, size := getTestImageData(t)
+	reader := bytes.NewReader(testImageData)
+
+	info, err := ovirtclient.InspectImage(reader)
+	if err != nil {
+		t.Fatalf("Failed to inspect raw image (%v)", err)
+	}
+	if info.Format() != ovirtclient.ImageFormatRaw {
+		t.Fatalf("Incorrect image format (expected: %s, got: %s)", ovirtclient.ImageFormatRaw, info.Format())
+	}
+	if info.VirtualSize() != size {
+		t.Fatalf("Incorrect virtual size (expected: %d, got: %d)", size, info.VirtualSize())
+	}
+	if err := info.Validate(); err != nil {
+		t.Fatalf("Raw image failed validation (%v)", err)
+	}
+	if position, _ := reader.Seek(0, io.SeekCurrent); position != 0 {
+		t.Fatalf("The reader was not returned to its original position (got: %d)", position)
+	}
+}
+
+func TestInspectImageQCOW(t *testing.T) {
+	t.Parallel()
+	image := newTestQCOWImage(testQCOWImageOptions{})
+
+	info, err := ovirtclient.InspectImage(bytes.NewReader(image))
+	if err != nil {
+		t.Fatalf("Failed to inspect QCOW image (%v)", err)
+	}
+	if info.Format() != ovirtclient.ImageFormatCow {
+		t.Fatalf("Incorrect image format (expected: %s, got: %s)", ovirtclient.ImageFormatCow, info.Format())
+	}
+	if info.QCOWVersion() != 3 {
+		t.Fatalf("Incorrect QCOW version (expected: 3, got: %d)", info.QCOWVersion())
+	}
+	if info.ClusterSize() != testQCOWClusterSize {
+		t.Fatalf("Incorrect cluster size (expected: %d, got: %d)", testQCOWClusterSize, info.ClusterSize())
+	}
+	if info.VirtualSize() != testQCOWVirtualSize {
+		t.Fatalf("Incorrect virtual size (expected: %d, got: %d)", testQCOWVirtualSize, info.VirtualSize())
+	}
+	if info.HasBackingFile() || info.Compressed() || info.Encrypted() || info.Corrupt() || info.Truncated() {
+		t.Fatalf("Incorrect image properties detected on a plain QCOW image.")
+	}
+	if err := info.Validate(); err != nil {
+		t.Fatalf("Plain QCOW image failed validation (%v)", err)
+	}
+}
+
+func TestInspectImageCompressed(t *testing.T) {
+	t.Parallel()
+	image := newTestQCOWImage(testQCOWImageOptions{compressed: true})
+
+	info, err := ovirtclient.InspectImage(bytes.NewReader(image))
+	if err != nil {
+		t.Fatalf("Failed to inspect QCOW image (%v)", err)
+	}
+	if !info.Compressed() {
+		t.Fatalf("Compressed clusters were not detected.")
+	}
+	if err := info.Validate(); err != nil {
+		t.Fatalf("Compressed QCOW image failed validation (%v)", err)
+	}
+}
+
+func TestInspectImageRejectsInvalidImages(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		image []byte
+		check func(info ovirtclient.ImageInfo) bool
+	}{
+		"backing file": {
+			newTestQCOWImage(testQCOWImageOptions{backingFile: "base.qcow2"}),
+			func(info ovirtclient.ImageInfo) bool {
+				return info.HasBackingFile() && info.BackingFile() == "base.qcow2"
+			},
+		},
+		"encrypted": {
+			newTestQCOWImage(testQCOWImageOptions{encrypted: true}),
+			ovirtclient.ImageInfo.Encrypted,
+		},
+		"corrupt": {
+			newTestQCOWImage(testQCOWImageOptions{incompatibleFeatures: 1 << 1}),
+			ovirtclient.ImageInfo.Corrupt,
+		},
+		"truncated": {
+			newTestQCOWImage(testQCOWImageOptions{})[:2*testQCOWClusterSize],
+			ovirtclient.ImageInfo.Truncated,
+		},
+		"external data file": {
+			newTestQCOWImage(testQCOWImageOptions{incompatibleFeatures: 1 << 2}),
+			func(info ovirtclient.ImageInfo) bool {
+				features := info.IncompatibleFeatures()
+				return len(features) == 1 && features[0] == "external_data_file"
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			info, err := ovirtclient.InspectImage(bytes.NewReader(testCase.image))
+			if err != nil {
+				t.Fatalf("Failed to inspect QCOW image (%v)", err)
+			}
+			if !testCase.check(info) {
+				t.Fatalf("The image property was not detected.")
+			}
+			err = info.Validate()
+			if err == nil {
+				t.Fatalf("Validating the image did not result in an error.")
+			}
+			if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+				t.Fatalf("Validating the image returned an incorrect error code (%v)", err)
+			}
+		})
+	}
+}
+
+func TestUploadRejectsImageWithBackingFile(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	image := newTestQCOWImage(testQCOWImageOptions{backingFile: "base.qcow2"})
+
+	_, err := helper.GetClient().UploadToNewDisk(
+		helper.GetStorageDomainID(),
+		ovirtclient.ImageFormatCow,
+		uint64(len(image)),
+		ovirtclient.CreateDiskParams().MustWithAlias(helper.GenerateTestResourceName(t)),
+		&nopReadCloser{bytes.NewReader(image)},
+	)
+	if err == nil {
+		t.Fatalf("Uploading an image with a backing file did not result in an error.")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Uploading an image with a backing file returned an incorrect error code (%v)", err)
+	}
+}
+
+const (
+	testQCOWClusterBits  = 9
+	testQCOWClusterSize  = 1 << testQCOWClusterBits
+	testQCOWVirtualSize  = 1024 * 1024
+	testQCOWL1Offset     = testQCOWClusterSize
+	testQCOWRefcountOff  = 2 * testQCOWClusterSize
+	testQCOWL2Offset     = 3 * testQCOWClusterSize
+	testQCOWHeaderLength = 104
+)
+
+type testQCOWImageOptions struct {
+	backingFile          string
+	encrypted            bool
+	compressed           bool
+	incompatibleFeatures uint64
+}
+
+// newTestQCOWImage creates a minimal QCOW version 3 image consisting of the header, an L1 table with a single entry,
+// a refcount table and a single L2 table.
+func newTestQCOWImage(options testQCOWImageOptions) []byte {
+	image := make([]byte, 4*testQCOWClusterSize)
+	copy(image[0:4], "QFI\xfb")
+	binary.BigEndian.PutUint32(image[4:8], 3)
+	if options.backingFile != "" {
+		binary.BigEndian.PutUint64(image[8:16], 112)
+		binary.BigEndian.PutUint32(image[16:20], uint32(len(options.backingFile)))
+		copy(image[112:], options.backingFile)
+	}
+	binary.BigEndian.PutUint32(image[20:24], testQCOWClusterBits)
+	binary.BigEndian.PutUint64(image[24:32], testQCOWVirtualSize)
+	if options.encrypted {
+		binary.BigEndian.PutUint32(image[32:36], 2)
+	}
+	binary.BigEndian.PutUint32(image[36:40], 1)
+	binary.BigEndian.PutUint64(image[40:48], testQCOWL1Offset)
+	binary.BigEndian.PutUint64(image[48:56], testQCOWRefcountOff)
+	binary.BigEndian.PutUint32(image[56:60], 1)
+	binary.BigEndian.PutUint64(image[72:80], options.incompatibleFeatures)
+	binary.BigEndian.PutUint32(image[96:100], 4)
+	binary.BigEndian.PutUint32(image[100:104], testQCOWHeaderLength)
+
+	binary.BigEndian.PutUint64(image[testQCOWL1Offset:], testQCOWL2Offset|1<<63)
+	if options.compressed {
+		binary.BigEndian.PutUint64(image[testQCOWL2Offset:], 1<<62)
+	}
+	return image
+}
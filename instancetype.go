@@ -1,6 +1,10 @@
 package ovirtclient
 
-import ovirtsdk "github.com/ovirt/go-ovirt"
+import (
+	"strconv"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
 
 // InstanceTypeID is a type alias for instance type IDs.
 type InstanceTypeID string
@@ -9,17 +13,543 @@ type InstanceTypeID string
 type InstanceTypeClient interface {
 	GetInstanceType(id InstanceTypeID, retries ...RetryStrategy) (InstanceType, error)
 	ListInstanceTypes(retries ...RetryStrategy) ([]InstanceType, error)
+	// CreateInstanceType creates a new instance type. The params parameter may be nil, in which case the engine
+	// defaults are used.
+	CreateInstanceType(
+		name string,
+		params OptionalInstanceTypeParameters,
+		retries ...RetryStrategy,
+	) (InstanceType, error)
+	// UpdateInstanceType updates the fields of an instance type set in params.
+	UpdateInstanceType(
+		id InstanceTypeID,
+		params UpdateInstanceTypeParameters,
+		retries ...RetryStrategy,
+	) (InstanceType, error)
+	// RemoveInstanceType removes an instance type. Instance types used by VMs cannot be removed.
+	RemoveInstanceType(id InstanceTypeID, retries ...RetryStrategy) error
 }
 
 // InstanceTypeData is the data segment of the InstanceType type.
 type InstanceTypeData interface {
 	ID() InstanceTypeID
 	Name() string
+	// Description returns the description of the instance type.
+	Description() string
+	// CPU returns the CPU configuration VMs created from this instance type receive.
+	CPU() VMCPU
+	// Memory returns the memory size in bytes.
+	Memory() int64
+	// MemoryPolicy returns the memory policy.
+	MemoryPolicy() MemoryPolicy
+	// HugePages returns the hugepage setting, or nil if hugepages are not used.
+	HugePages() *VMHugePages
+	// HighAvailability returns the high availability settings.
+	HighAvailability() HighAvailability
+	// SerialConsole returns true if VMs created from this instance type have a serial console.
+	SerialConsole() bool
 }
 
 // InstanceType is a data structure that contains preconfigured instance parameters.
 type InstanceType interface {
 	InstanceTypeData
+
+	// Update updates the instance type. See UpdateInstanceType for details.
+	Update(params UpdateInstanceTypeParameters, retries ...RetryStrategy) (InstanceType, error)
+	// Remove removes the instance type. See RemoveInstanceType for details.
+	Remove(retries ...RetryStrategy) error
+}
+
+// HighAvailability describes if and with which priority the engine restarts a VM when it crashes or its host fails.
+type HighAvailability interface {
+	// Enabled returns true if the VM is highly available.
+	Enabled() bool
+	// Priority returns the priority of the VM when restarting. VMs with a higher priority are restarted first.
+	Priority() int
+}
+
+type highAvailability struct {
+	enabled  bool
+	priority int
+}
+
+func (h *highAvailability) Enabled() bool {
+	return h.enabled
+}
+
+func (h *highAvailability) Priority() int {
+	return h.priority
+}
+
+// OptionalInstanceTypeParameters contains the optional parameters for creating an instance type.
+type OptionalInstanceTypeParameters interface {
+	// Description returns the description of the instance type.
+	Description() *string
+	// CPU returns the CPU configuration of the instance type.
+	CPU() VMCPUParams
+	// Memory returns the memory size in bytes.
+	Memory() *int64
+	// MemoryPolicy returns the memory policy.
+	MemoryPolicy() *MemoryPolicyParameters
+	// HugePages returns the hugepage setting.
+	HugePages() *VMHugePages
+	// HighAvailability returns the high availability settings.
+	HighAvailability() HighAvailability
+	// SerialConsole returns if a serial console should be enabled.
+	SerialConsole() *bool
+}
+
+// BuildableInstanceTypeParameters is a buildable version of OptionalInstanceTypeParameters.
+type BuildableInstanceTypeParameters interface {
+	OptionalInstanceTypeParameters
+
+	// WithDescription sets the description of the instance type.
+	WithDescription(description string) (BuildableInstanceTypeParameters, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableInstanceTypeParameters
+
+	// WithCPU sets the CPU configuration of the instance type.
+	WithCPU(cpu VMCPUParams) (BuildableInstanceTypeParameters, error)
+	// MustWithCPU is identical to WithCPU, but panics instead of returning an error.
+	MustWithCPU(cpu VMCPUParams) BuildableInstanceTypeParameters
+
+	// WithMemory sets the memory size in bytes.
+	WithMemory(memory int64) (BuildableInstanceTypeParameters, error)
+	// MustWithMemory is identical to WithMemory, but panics instead of returning an error.
+	MustWithMemory(memory int64) BuildableInstanceTypeParameters
+
+	// WithMemoryPolicy sets the memory policy.
+	WithMemoryPolicy(memoryPolicy MemoryPolicyParameters) (BuildableInstanceTypeParameters, error)
+	// MustWithMemoryPolicy is identical to WithMemoryPolicy, but panics instead of returning an error.
+	MustWithMemoryPolicy(memoryPolicy MemoryPolicyParameters) BuildableInstanceTypeParameters
+
+	// WithHugePages sets the hugepage setting.
+	WithHugePages(hugePages VMHugePages) (BuildableInstanceTypeParameters, error)
+	// MustWithHugePages is identical to WithHugePages, but panics instead of returning an error.
+	MustWithHugePages(hugePages VMHugePages) BuildableInstanceTypeParameters
+
+	// WithHighAvailability enables or disables high availability with the specified restart priority.
+	WithHighAvailability(enabled bool, priority int) (BuildableInstanceTypeParameters, error)
+	// MustWithHighAvailability is identical to WithHighAvailability, but panics instead of returning an error.
+	MustWithHighAvailability(enabled bool, priority int) BuildableInstanceTypeParameters
+
+	// WithSerialConsole enables or disables the serial console.
+	WithSerialConsole(serialConsole bool) (BuildableInstanceTypeParameters, error)
+	// MustWithSerialConsole is identical to WithSerialConsole, but panics instead of returning an error.
+	MustWithSerialConsole(serialConsole bool) BuildableInstanceTypeParameters
+}
+
+// CreateInstanceTypeParams creates a buildable set of parameters for CreateInstanceType.
+func CreateInstanceTypeParams() BuildableInstanceTypeParameters {
+	return &instanceTypeParams{}
+}
+
+// UpdateInstanceTypeParameters contains the fields of an instance type to update. Each nil value leaves the field
+// unchanged.
+type UpdateInstanceTypeParameters interface {
+	OptionalInstanceTypeParameters
+
+	// Name returns the new name of the instance type.
+	Name() *string
+}
+
+// BuildableUpdateInstanceTypeParameters is a buildable version of UpdateInstanceTypeParameters.
+type BuildableUpdateInstanceTypeParameters interface {
+	UpdateInstanceTypeParameters
+
+	// WithName changes the name of the instance type.
+	WithName(name string) (BuildableUpdateInstanceTypeParameters, error)
+	// MustWithName is identical to WithName, but panics instead of returning an error.
+	MustWithName(name string) BuildableUpdateInstanceTypeParameters
+
+	// WithDescription changes the description of the instance type.
+	WithDescription(description string) (BuildableUpdateInstanceTypeParameters, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableUpdateInstanceTypeParameters
+
+	// WithCPU changes the CPU configuration of the instance type.
+	WithCPU(cpu VMCPUParams) (BuildableUpdateInstanceTypeParameters, error)
+	// MustWithCPU is identical to WithCPU, but panics instead of returning an error.
+	MustWithCPU(cpu VMCPUParams) BuildableUpdateInstanceTypeParameters
+
+	// WithMemory changes the memory size in bytes.
+	WithMemory(memory int64) (BuildableUpdateInstanceTypeParameters, error)
+	// MustWithMemory is identical to WithMemory, but panics instead of returning an error.
+	MustWithMemory(memory int64) BuildableUpdateInstanceTypeParameters
+
+	// WithMemoryPolicy changes the memory policy.
+	WithMemoryPolicy(memoryPolicy MemoryPolicyParameters) (BuildableUpdateInstanceTypeParameters, error)
+	// MustWithMemoryPolicy is identical to WithMemoryPolicy, but panics instead of returning an error.
+	MustWithMemoryPolicy(memoryPolicy MemoryPolicyParameters) BuildableUpdateInstanceTypeParameters
+
+	// WithHugePages changes the hugepage setting.
+	WithHugePages(hugePages VMHugePages) (BuildableUpdateInstanceTypeParameters, error)
+	// MustWithHugePages is identical to WithHugePages, but panics instead of returning an error.
+	MustWithHugePages(hugePages VMHugePages) BuildableUpdateInstanceTypeParameters
+
+	// WithHighAvailability changes the high availability settings.
+	WithHighAvailability(enabled bool, priority int) (BuildableUpdateInstanceTypeParameters, error)
+	// MustWithHighAvailability is identical to WithHighAvailability, but panics instead of returning an error.
+	MustWithHighAvailability(enabled bool, priority int) BuildableUpdateInstanceTypeParameters
+
+	// WithSerialConsole enables or disables the serial console.
+	WithSerialConsole(serialConsole bool) (BuildableUpdateInstanceTypeParameters, error)
+	// MustWithSerialConsole is identical to WithSerialConsole, but panics instead of returning an error.
+	MustWithSerialConsole(serialConsole bool) BuildableUpdateInstanceTypeParameters
+}
+
+// UpdateInstanceTypeParams creates a buildable set of parameters for UpdateInstanceType.
+func UpdateInstanceTypeParams() BuildableUpdateInstanceTypeParameters {
+	return &updateInstanceTypeParams{}
+}
+
+type instanceTypeParams struct {
+	description      *string
+	cpu              VMCPUParams
+	memory           *int64
+	memoryPolicy     *MemoryPolicyParameters
+	hugePages        *VMHugePages
+	highAvailability *highAvailability
+	serialConsole    *bool
+}
+
+func (i *instanceTypeParams) Description() *string {
+	return i.description
+}
+
+func (i *instanceTypeParams) CPU() VMCPUParams {
+	return i.cpu
+}
+
+func (i *instanceTypeParams) Memory() *int64 {
+	return i.memory
+}
+
+func (i *instanceTypeParams) MemoryPolicy() *MemoryPolicyParameters {
+	return i.memoryPolicy
+}
+
+func (i *instanceTypeParams) HugePages() *VMHugePages {
+	return i.hugePages
+}
+
+func (i *instanceTypeParams) HighAvailability() HighAvailability {
+	if i.highAvailability == nil {
+		return nil
+	}
+	return i.highAvailability
+}
+
+func (i *instanceTypeParams) SerialConsole() *bool {
+	return i.serialConsole
+}
+
+func (i *instanceTypeParams) WithDescription(description string) (BuildableInstanceTypeParameters, error) {
+	i.description = &description
+	return i, nil
+}
+
+func (i *instanceTypeParams) MustWithDescription(description string) BuildableInstanceTypeParameters {
+	builder, err := i.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (i *instanceTypeParams) WithCPU(cpu VMCPUParams) (BuildableInstanceTypeParameters, error) {
+	i.cpu = cpu
+	return i, nil
+}
+
+func (i *instanceTypeParams) MustWithCPU(cpu VMCPUParams) BuildableInstanceTypeParameters {
+	builder, err := i.WithCPU(cpu)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (i *instanceTypeParams) WithMemory(memory int64) (BuildableInstanceTypeParameters, error) {
+	if err := validateInstanceTypeMemory(memory); err != nil {
+		return nil, err
+	}
+	i.memory = &memory
+	return i, nil
+}
+
+func (i *instanceTypeParams) MustWithMemory(memory int64) BuildableInstanceTypeParameters {
+	builder, err := i.WithMemory(memory)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (i *instanceTypeParams) WithMemoryPolicy(
+	memoryPolicy MemoryPolicyParameters,
+) (BuildableInstanceTypeParameters, error) {
+	i.memoryPolicy = &memoryPolicy
+	return i, nil
+}
+
+func (i *instanceTypeParams) MustWithMemoryPolicy(memoryPolicy MemoryPolicyParameters) BuildableInstanceTypeParameters {
+	builder, err := i.WithMemoryPolicy(memoryPolicy)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (i *instanceTypeParams) WithHugePages(hugePages VMHugePages) (BuildableInstanceTypeParameters, error) {
+	if err := hugePages.Validate(); err != nil {
+		return nil, err
+	}
+	i.hugePages = &hugePages
+	return i, nil
+}
+
+func (i *instanceTypeParams) MustWithHugePages(hugePages VMHugePages) BuildableInstanceTypeParameters {
+	builder, err := i.WithHugePages(hugePages)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (i *instanceTypeParams) WithHighAvailability(
+	enabled bool,
+	priority int,
+) (BuildableInstanceTypeParameters, error) {
+	ha, err := newHighAvailability(enabled, priority)
+	if err != nil {
+		return nil, err
+	}
+	i.highAvailability = ha
+	return i, nil
+}
+
+func (i *instanceTypeParams) MustWithHighAvailability(enabled bool, priority int) BuildableInstanceTypeParameters {
+	builder, err := i.WithHighAvailability(enabled, priority)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (i *instanceTypeParams) WithSerialConsole(serialConsole bool) (BuildableInstanceTypeParameters, error) {
+	i.serialConsole = &serialConsole
+	return i, nil
+}
+
+func (i *instanceTypeParams) MustWithSerialConsole(serialConsole bool) BuildableInstanceTypeParameters {
+	builder, err := i.WithSerialConsole(serialConsole)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+type updateInstanceTypeParams struct {
+	instanceTypeParams
+
+	name *string
+}
+
+func (u *updateInstanceTypeParams) Name() *string {
+	return u.name
+}
+
+func (u *updateInstanceTypeParams) WithName(name string) (BuildableUpdateInstanceTypeParameters, error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "the instance type name must not be empty")
+	}
+	u.name = &name
+	return u, nil
+}
+
+func (u *updateInstanceTypeParams) MustWithName(name string) BuildableUpdateInstanceTypeParameters {
+	builder, err := u.WithName(name)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateInstanceTypeParams) WithDescription(description string) (BuildableUpdateInstanceTypeParameters, error) {
+	if _, err := u.instanceTypeParams.WithDescription(description); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateInstanceTypeParams) MustWithDescription(description string) BuildableUpdateInstanceTypeParameters {
+	builder, err := u.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateInstanceTypeParams) WithCPU(cpu VMCPUParams) (BuildableUpdateInstanceTypeParameters, error) {
+	if _, err := u.instanceTypeParams.WithCPU(cpu); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateInstanceTypeParams) MustWithCPU(cpu VMCPUParams) BuildableUpdateInstanceTypeParameters {
+	builder, err := u.WithCPU(cpu)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateInstanceTypeParams) WithMemory(memory int64) (BuildableUpdateInstanceTypeParameters, error) {
+	if _, err := u.instanceTypeParams.WithMemory(memory); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateInstanceTypeParams) MustWithMemory(memory int64) BuildableUpdateInstanceTypeParameters {
+	builder, err := u.WithMemory(memory)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateInstanceTypeParams) WithMemoryPolicy(
+	memoryPolicy MemoryPolicyParameters,
+) (BuildableUpdateInstanceTypeParameters, error) {
+	if _, err := u.instanceTypeParams.WithMemoryPolicy(memoryPolicy); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateInstanceTypeParams) MustWithMemoryPolicy(
+	memoryPolicy MemoryPolicyParameters,
+) BuildableUpdateInstanceTypeParameters {
+	builder, err := u.WithMemoryPolicy(memoryPolicy)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateInstanceTypeParams) WithHugePages(hugePages VMHugePages) (BuildableUpdateInstanceTypeParameters, error) {
+	if _, err := u.instanceTypeParams.WithHugePages(hugePages); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateInstanceTypeParams) MustWithHugePages(hugePages VMHugePages) BuildableUpdateInstanceTypeParameters {
+	builder, err := u.WithHugePages(hugePages)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateInstanceTypeParams) WithHighAvailability(
+	enabled bool,
+	priority int,
+) (BuildableUpdateInstanceTypeParameters, error) {
+	if _, err := u.instanceTypeParams.WithHighAvailability(enabled, priority); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateInstanceTypeParams) MustWithHighAvailability(
+	enabled bool,
+	priority int,
+) BuildableUpdateInstanceTypeParameters {
+	builder, err := u.WithHighAvailability(enabled, priority)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateInstanceTypeParams) WithSerialConsole(
+	serialConsole bool,
+) (BuildableUpdateInstanceTypeParameters, error) {
+	if _, err := u.instanceTypeParams.WithSerialConsole(serialConsole); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateInstanceTypeParams) MustWithSerialConsole(serialConsole bool) BuildableUpdateInstanceTypeParameters {
+	builder, err := u.WithSerialConsole(serialConsole)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func newHighAvailability(enabled bool, priority int) (*highAvailability, error) {
+	if priority < 0 {
+		return nil, newError(EBadArgument, "the high availability priority must not be negative (%d given)", priority)
+	}
+	return &highAvailability{
+		enabled:  enabled,
+		priority: priority,
+	}, nil
+}
+
+func validateInstanceTypeMemory(memory int64) error {
+	if memory <= 0 {
+		return newError(EBadArgument, "the instance type memory must be positive (%d given)", memory)
+	}
+	return nil
+}
+
+func validateInstanceTypeName(name string) error {
+	if name == "" {
+		return newError(EBadArgument, "the instance type name must not be empty")
+	}
+	return nil
+}
+
+// buildSDKInstanceType creates the SDK instance type from the parameters. It is shared between creation and update.
+func buildSDKInstanceType(
+	builder *ovirtsdk.InstanceTypeBuilder,
+	params OptionalInstanceTypeParameters,
+) (*ovirtsdk.InstanceType, error) {
+	if description := params.Description(); description != nil {
+		builder.Description(*description)
+	}
+	if cpu := params.CPU(); cpu != nil {
+		builder.CpuBuilder(sdkCPUBuilder(cpu))
+	}
+	if memory := params.Memory(); memory != nil {
+		builder.Memory(*memory)
+	}
+	if memoryPolicy := params.MemoryPolicy(); memoryPolicy != nil {
+		builder.MemoryPolicyBuilder(sdkMemoryPolicyBuilder(*memoryPolicy))
+	}
+	if hugePages := params.HugePages(); hugePages != nil {
+		builder.CustomPropertiesOfAny(sdkHugePagesProperty(*hugePages))
+	}
+	if ha := params.HighAvailability(); ha != nil {
+		builder.HighAvailabilityBuilder(
+			ovirtsdk.NewHighAvailabilityBuilder().Enabled(ha.Enabled()).Priority(int64(ha.Priority())),
+		)
+	}
+	if serialConsole := params.SerialConsole(); serialConsole != nil {
+		builder.ConsoleBuilder(ovirtsdk.NewConsoleBuilder().Enabled(*serialConsole))
+	}
+	instanceType, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build instance type")
+	}
+	return instanceType, nil
 }
 
 func convertSDKInstanceType(object *ovirtsdk.InstanceType, o *oVirtClient) (InstanceType, error) {
@@ -28,19 +558,112 @@ func convertSDKInstanceType(object *ovirtsdk.InstanceType, o *oVirtClient) (Inst
 		return nil, newFieldNotFound("instance type", "name")
 	}
 
-	return &instanceType{
-		o,
+	result := &instanceType{
+		client: o,
 
-		InstanceTypeID(object.MustId()),
-		name,
-	}, nil
+		id:               InstanceTypeID(object.MustId()),
+		name:             name,
+		memoryPolicy:     &memoryPolicy{},
+		highAvailability: &highAvailability{},
+	}
+	result.description, _ = object.Description()
+	result.memory, _ = object.Memory()
+	if err := convertSDKInstanceTypeCPU(object, result); err != nil {
+		return nil, err
+	}
+	if memPolicy, ok := object.MemoryPolicy(); ok {
+		if guaranteed, ok := memPolicy.Guaranteed(); ok {
+			result.memoryPolicy.guaranteed = &guaranteed
+		}
+		if max, ok := memPolicy.Max(); ok {
+			result.memoryPolicy.max = &max
+		}
+		result.memoryPolicy.ballooning, _ = memPolicy.Ballooning()
+	}
+	if err := convertSDKInstanceTypeHugePages(object, result); err != nil {
+		return nil, err
+	}
+	if ha, ok := object.HighAvailability(); ok {
+		result.highAvailability.enabled, _ = ha.Enabled()
+		priority, _ := ha.Priority()
+		result.highAvailability.priority = int(priority)
+	}
+	if console, ok := object.Console(); ok {
+		result.serialConsole, _ = console.Enabled()
+	}
+	return result, nil
+}
+
+func convertSDKInstanceTypeCPU(object *ovirtsdk.InstanceType, result *instanceType) error {
+	sdkCPU, ok := object.Cpu()
+	if !ok {
+		return newFieldNotFound("instance type", "CPU")
+	}
+	cpuTopo, ok := sdkCPU.Topology()
+	if !ok {
+		return newFieldNotFound("CPU in instance type", "CPU topo")
+	}
+	cores, ok := cpuTopo.Cores()
+	if !ok {
+		return newFieldNotFound("CPU topo in CPU in instance type", "cores")
+	}
+	threads, ok := cpuTopo.Threads()
+	if !ok {
+		return newFieldNotFound("CPU topo in CPU in instance type", "threads")
+	}
+	sockets, ok := cpuTopo.Sockets()
+	if !ok {
+		return newFieldNotFound("CPU topo in CPU in instance type", "sockets")
+	}
+	result.cpu = &vmCPU{
+		topo: &vmCPUTopo{
+			uint(cores),
+			uint(threads),
+			uint(sockets),
+		},
+	}
+	if mode, ok := sdkCPU.Mode(); ok {
+		cpuMode := CPUMode(mode)
+		result.cpu.mode = &cpuMode
+	}
+	return nil
+}
+
+func convertSDKInstanceTypeHugePages(object *ovirtsdk.InstanceType, result *instanceType) error {
+	customProperties, ok := object.CustomProperties()
+	if !ok {
+		return nil
+	}
+	for _, c := range customProperties.Slice() {
+		if customPropertyName, ok := c.Name(); !ok || customPropertyName != "hugepages" {
+			continue
+		}
+		hugePagesText, ok := c.Value()
+		if !ok {
+			return nil
+		}
+		hugePagesUint, err := strconv.ParseUint(hugePagesText, 10, 64)
+		if err != nil {
+			return wrap(err, EBug, "Failed to parse 'hugepages' custom property into a number: %s", hugePagesText)
+		}
+		hugePages := VMHugePages(hugePagesUint)
+		result.hugePages = &hugePages
+	}
+	return nil
 }
 
 type instanceType struct {
 	client Client
 
-	id   InstanceTypeID
-	name string
+	id               InstanceTypeID
+	name             string
+	description      string
+	cpu              *vmCPU
+	memory           int64
+	memoryPolicy     *memoryPolicy
+	hugePages        *VMHugePages
+	highAvailability *highAvailability
+	serialConsole    bool
 }
 
 func (i instanceType) Name() string {
@@ -50,3 +673,69 @@ func (i instanceType) Name() string {
 func (i instanceType) ID() InstanceTypeID {
 	return i.id
 }
+
+func (i instanceType) Description() string {
+	return i.description
+}
+
+func (i instanceType) CPU() VMCPU {
+	return i.cpu
+}
+
+func (i instanceType) Memory() int64 {
+	return i.memory
+}
+
+func (i instanceType) MemoryPolicy() MemoryPolicy {
+	return i.memoryPolicy
+}
+
+func (i instanceType) HugePages() *VMHugePages {
+	return i.hugePages
+}
+
+func (i instanceType) HighAvailability() HighAvailability {
+	return i.highAvailability
+}
+
+func (i instanceType) SerialConsole() bool {
+	return i.serialConsole
+}
+
+func (i instanceType) Update(params UpdateInstanceTypeParameters, retries ...RetryStrategy) (InstanceType, error) {
+	return i.client.UpdateInstanceType(i.id, params, retries...)
+}
+
+func (i instanceType) Remove(retries ...RetryStrategy) error {
+	return i.client.RemoveInstanceType(i.id, retries...)
+}
+
+// withParams returns a copy of the instance type with the values set in the parameters applied.
+func (i instanceType) withParams(params OptionalInstanceTypeParameters) *instanceType {
+	result := i
+	if description := params.Description(); description != nil {
+		result.description = *description
+	}
+	if cpu := params.CPU(); cpu != nil {
+		result.cpu = newVMCPUFromParams(cpu)
+	}
+	if memory := params.Memory(); memory != nil {
+		result.memory = *memory
+	}
+	if memPolicy := params.MemoryPolicy(); memPolicy != nil {
+		result.memoryPolicy = i.memoryPolicy.withParams(*memPolicy)
+	}
+	if hugePages := params.HugePages(); hugePages != nil {
+		result.hugePages = hugePages
+	}
+	if ha := params.HighAvailability(); ha != nil {
+		result.highAvailability = &highAvailability{
+			enabled:  ha.Enabled(),
+			priority: ha.Priority(),
+		}
+	}
+	if serialConsole := params.SerialConsole(); serialConsole != nil {
+		result.serialConsole = *serialConsole
+	}
+	return &result
+}
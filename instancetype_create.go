@@ -0,0 +1,109 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) CreateInstanceType(
+	name string,
+	params OptionalInstanceTypeParameters,
+	retries ...RetryStrategy,
+) (result InstanceType, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if err := validateInstanceTypeName(name); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = &instanceTypeParams{}
+	}
+	sdkInstanceType, err := buildSDKInstanceType(ovirtsdk.NewInstanceTypeBuilder().Name(name), params)
+	if err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("creating instance type %s", name),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().InstanceTypesService().Add().InstanceType(sdkInstanceType).Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.InstanceType()
+			if !ok {
+				return newFieldNotFound("instance type create response", "instance type")
+			}
+			result, err = convertSDKInstanceType(sdkObject, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert instance type %s", name)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) CreateInstanceType(
+	name string,
+	params OptionalInstanceTypeParameters,
+	retries ...RetryStrategy,
+) (result InstanceType, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if err := validateInstanceTypeName(name); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = &instanceTypeParams{}
+	}
+	err = retry(
+		fmt.Sprintf("creating instance type %s", name),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			for _, it := range m.instanceTypes {
+				if it.name == name {
+					return newError(EConflict, "An instance type with the name \"%s\" already exists.", name)
+				}
+			}
+
+			created := newMockInstanceType(m, InstanceTypeID(m.GenerateUUID()), name, 1024, 1).withParams(params)
+			m.instanceTypes[created.id] = created
+			result = created
+			return nil
+		})
+	return
+}
+
+// newMockInstanceType creates an instance type with the defaults the engine uses: the guaranteed memory is the full
+// memory size, the maximum memory is four times the memory size, and high availability is disabled.
+func newMockInstanceType(client Client, id InstanceTypeID, name string, memoryMB int64, sockets uint) *instanceType {
+	memory := memoryMB * 1024 * 1024
+	maxMemory := 4 * memory
+	return &instanceType{
+		client: client,
+		id:     id,
+		name:   name,
+		cpu: &vmCPU{
+			topo: &vmCPUTopo{
+				cores:   1,
+				threads: 1,
+				sockets: sockets,
+			},
+		},
+		memory: memory,
+		memoryPolicy: &memoryPolicy{
+			guaranteed: &memory,
+			max:        &maxMemory,
+			ballooning: true,
+		},
+		highAvailability: &highAvailability{
+			enabled:  false,
+			priority: 1,
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) RemoveInstanceType(id InstanceTypeID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	return retry(
+		fmt.Sprintf("removing instance type %s", id),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().InstanceTypesService().InstanceTypeService(string(id)).Remove().Send()
+			return err
+		})
+}
+
+func (m *mockClient) RemoveInstanceType(id InstanceTypeID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("removing instance type %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, ok := m.instanceTypes[id]; !ok {
+				return newError(ENotFound, "instance type with ID %s not found", id)
+			}
+			for _, vm := range m.vms {
+				if vm.instanceTypeID != nil && *vm.instanceTypeID == id {
+					return newError(
+						EConflict,
+						"Instance type %s cannot be removed because it is in use by VM %s.",
+						id,
+						vm.id,
+					)
+				}
+			}
+			delete(m.instanceTypes, id)
+			return nil
+		})
+}
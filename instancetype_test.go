@@ -1,6 +1,11 @@
 package ovirtclient_test
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
 
 func TestListInstanceTypes(t *testing.T) {
 	helper := getHelper(t)
@@ -9,3 +14,115 @@ func TestListInstanceTypes(t *testing.T) {
 		t.Fatalf("failed to list instance types (%v)", err)
 	}
 }
+
+func TestInstanceTypeCRUD(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	memory := int64(2 * 1024 * 1024 * 1024)
+	name := fmt.Sprintf("test-%s", helper.GenerateRandomID(5))
+	instanceType, err := client.CreateInstanceType(
+		name,
+		ovirtclient.CreateInstanceTypeParams().
+			MustWithDescription("Test instance type").
+			MustWithCPU(ovirtclient.NewVMCPUParams().MustWithTopo(ovirtclient.MustNewVMCPUTopo(2, 1, 1))).
+			MustWithMemory(memory).
+			MustWithHighAvailability(true, 50).
+			MustWithSerialConsole(true),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create instance type (%v)", err)
+	}
+	t.Cleanup(func() {
+		if err := instanceType.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("Failed to clean up instance type %s after test (%v)", instanceType.ID(), err)
+		}
+	})
+	if instanceType.Name() != name {
+		t.Fatalf("Incorrect instance type name (%s instead of %s).", instanceType.Name(), name)
+	}
+	if instanceType.CPU().Topo().Cores() != 2 {
+		t.Fatalf("Incorrect number of cores (%d instead of 2).", instanceType.CPU().Topo().Cores())
+	}
+	if instanceType.Memory() != memory {
+		t.Fatalf("Incorrect instance type memory (%d instead of %d bytes).", instanceType.Memory(), memory)
+	}
+	if !instanceType.HighAvailability().Enabled() || instanceType.HighAvailability().Priority() != 50 {
+		t.Fatalf("Incorrect high availability settings on instance type.")
+	}
+	if !instanceType.SerialConsole() {
+		t.Fatalf("Serial console not enabled on instance type.")
+	}
+
+	updatedInstanceType, err := instanceType.Update(
+		ovirtclient.UpdateInstanceTypeParams().
+			MustWithDescription("Updated instance type").
+			MustWithSerialConsole(false),
+	)
+	if err != nil {
+		t.Fatalf("Failed to update instance type %s (%v)", instanceType.ID(), err)
+	}
+	if updatedInstanceType.Description() != "Updated instance type" {
+		t.Fatalf("Incorrect description after update (%s).", updatedInstanceType.Description())
+	}
+	if updatedInstanceType.SerialConsole() {
+		t.Fatalf("Serial console still enabled after update.")
+	}
+	if updatedInstanceType.Memory() != memory {
+		t.Fatalf("Memory changed during an update that did not set it (%d bytes).", updatedInstanceType.Memory())
+	}
+
+	if err := updatedInstanceType.Remove(); err != nil {
+		t.Fatalf("Failed to remove instance type %s (%v)", instanceType.ID(), err)
+	}
+	if _, err := client.GetInstanceType(instanceType.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Getting a removed instance type did not result in an ENotFound error (%v)", err)
+	}
+}
+
+func TestVMInheritsInstanceTypeSettings(t *testing.T) {
+	helper := getHelper(t)
+
+	memory := int64(2 * 1024 * 1024 * 1024)
+	instanceType := assertCanCreateInstanceType(
+		t,
+		helper,
+		ovirtclient.CreateInstanceTypeParams().
+			MustWithCPU(ovirtclient.NewVMCPUParams().MustWithTopo(ovirtclient.MustNewVMCPUTopo(1, 1, 2))).
+			MustWithMemory(memory),
+	)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithInstanceTypeID(instanceType.ID()),
+	)
+	if vm.Memory() != memory {
+		t.Fatalf("VM did not inherit the memory of the instance type (%d instead of %d).", vm.Memory(), memory)
+	}
+	if sockets := vm.CPU().Topo().Sockets(); sockets != 2 {
+		t.Fatalf("VM did not inherit the CPU sockets of the instance type (%d instead of 2).", sockets)
+	}
+	if err := instanceType.Remove(ovirtclient.MaxTries(1)); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Removing an instance type in use did not result in an EConflict error (%v)", err)
+	}
+}
+
+func assertCanCreateInstanceType(
+	t *testing.T,
+	helper ovirtclient.TestHelper,
+	params ovirtclient.OptionalInstanceTypeParameters,
+) ovirtclient.InstanceType {
+	client := helper.GetClient()
+	instanceType, err := client.CreateInstanceType(fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), params)
+	if err != nil {
+		t.Fatalf("Failed to create instance type (%v)", err)
+	}
+	t.Cleanup(func() {
+		if err := instanceType.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("Failed to clean up instance type %s after test (%v)", instanceType.ID(), err)
+		}
+	})
+	return instanceType
+}
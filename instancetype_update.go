@@ -0,0 +1,83 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) UpdateInstanceType(
+	id InstanceTypeID,
+	params UpdateInstanceTypeParameters,
+	retries ...RetryStrategy,
+) (result InstanceType, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	builder := ovirtsdk.NewInstanceTypeBuilder().Id(string(id))
+	if name := params.Name(); name != nil {
+		builder.Name(*name)
+	}
+	sdkInstanceType, err := buildSDKInstanceType(builder, params)
+	if err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("updating instance type %s", id),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				InstanceTypesService().
+				InstanceTypeService(string(id)).
+				Update().
+				InstanceType(sdkInstanceType).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.InstanceType()
+			if !ok {
+				return newFieldNotFound("instance type update response", "instance type")
+			}
+			result, err = convertSDKInstanceType(sdkObject, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert instance type %s", id)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) UpdateInstanceType(
+	id InstanceTypeID,
+	params UpdateInstanceTypeParameters,
+	retries ...RetryStrategy,
+) (result InstanceType, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	err = retry(
+		fmt.Sprintf("updating instance type %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			it, ok := m.instanceTypes[id]
+			if !ok {
+				return newError(ENotFound, "instance type with ID %s not found", id)
+			}
+			updated := it.withParams(params)
+			if name := params.Name(); name != nil {
+				for _, otherIT := range m.instanceTypes {
+					if otherIT.name == *name && otherIT.id != id {
+						return newError(EConflict, "An instance type with the name \"%s\" already exists.", *name)
+					}
+				}
+				updated.name = *name
+			}
+			m.instanceTypes[id] = updated
+			result = updated
+			return nil
+		})
+	return
+}
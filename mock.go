@@ -35,14 +35,28 @@ type mockClient struct {
 	networks                          map[NetworkID]*network
 	dataCenters                       map[DatacenterID]*datacenterWithClusters
 	vmDiskAttachmentsByVM             map[VMID]map[DiskAttachmentID]*diskAttachment
-	vmDiskAttachmentsByDisk           map[DiskID]*diskAttachment
+	vmDiskAttachmentsByDisk           map[DiskID]map[DiskAttachmentID]*diskAttachment
 	templateDiskAttachmentsByTemplate map[TemplateID][]*templateDiskAttachment
 	templateDiskAttachmentsByDisk     map[DiskID]*templateDiskAttachment
 	tags                              map[TagID]*tag
+	tagIDsByHost                      map[HostID][]TagID
+	tagIDsByTemplate                  map[TemplateID][]TagID
 	affinityGroups                    map[ClusterID]map[AffinityGroupID]*affinityGroup
 	vmIPs                             map[VMID]map[string][]net.IP
 	instanceTypes                     map[InstanceTypeID]*instanceType
-	graphicsConsolesByVM              map[VMID][]*vmGraphicsConsole
+	graphicsConsolesByVM              map[VMID][]*mockGraphicsConsole
+	snapshotsByVM                     map[VMID][]*snapshotWithData
+	vmNextRunParams                   map[VMID][]UpdateVMParameters
+	imageTransfers                    map[ImageTransferID]*mockImageTransfer
+	backupsByVM                       map[VMID][]*backupWithData
+	checkpointsByVM                   map[VMID][]*checkpointWithData
+	events                            *mockEventLog
+	clusterNetworks                   map[ClusterID]map[NetworkID]bool
+	hostNICs                          map[HostID][]*hostNIC
+	networkQoS                        map[NetworkQoSID]*networkQoS
+	networkFilters                    map[NetworkFilterID]*networkFilter
+	diskProfiles                      map[DiskProfileID]*diskProfile
+	luns                              []*lun
 }
 
 func (m *mockClient) WithContext(ctx context.Context) Client {
@@ -67,10 +81,24 @@ func (m *mockClient) WithContext(ctx context.Context) Client {
 		m.templateDiskAttachmentsByTemplate,
 		m.templateDiskAttachmentsByDisk,
 		m.tags,
+		m.tagIDsByHost,
+		m.tagIDsByTemplate,
 		m.affinityGroups,
 		m.vmIPs,
 		m.instanceTypes,
 		m.graphicsConsolesByVM,
+		m.snapshotsByVM,
+		m.vmNextRunParams,
+		m.imageTransfers,
+		m.backupsByVM,
+		m.checkpointsByVM,
+		m.events,
+		m.clusterNetworks,
+		m.hostNICs,
+		m.networkQoS,
+		m.networkFilters,
+		m.diskProfiles,
+		m.luns,
 	}
 }
 
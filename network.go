@@ -17,6 +17,25 @@ type NetworkClient interface {
 	GetNetwork(id NetworkID, retries ...RetryStrategy) (Network, error)
 	// ListNetworks returns all networks on the oVirt engine.
 	ListNetworks(retries ...RetryStrategy) ([]Network, error)
+	// CreateNetwork creates a new logical network in the specified datacenter. The params parameter may be nil, in
+	// which case an untagged VM network with the default MTU is created.
+	CreateNetwork(
+		datacenterID DatacenterID,
+		name string,
+		params CreateNetworkParameters,
+		retries ...RetryStrategy,
+	) (Network, error)
+	// UpdateNetwork updates the fields of a network set in params.
+	UpdateNetwork(id NetworkID, params UpdateNetworkParameters, retries ...RetryStrategy) (Network, error)
+	// RemoveNetwork removes a network and its VNIC profiles. The network must not be used by any VM.
+	RemoveNetwork(id NetworkID, retries ...RetryStrategy) error
+	// ListClusterNetworks lists the networks assigned to a cluster.
+	ListClusterNetworks(clusterID ClusterID, retries ...RetryStrategy) ([]ClusterNetwork, error)
+	// AssignNetworkToCluster assigns a network to a cluster in the same datacenter. If required is true, hosts in
+	// the cluster that lose connectivity to the network are set to non-operational.
+	AssignNetworkToCluster(clusterID ClusterID, networkID NetworkID, required bool, retries ...RetryStrategy) error
+	// UnassignNetworkFromCluster removes the network from the cluster.
+	UnassignNetworkFromCluster(clusterID ClusterID, networkID NetworkID, retries ...RetryStrategy) error
 }
 
 // NetworkData is the core of Network, providing only the data access functions, but not the client
@@ -28,6 +47,15 @@ type NetworkData interface {
 	Name() string
 	// DatacenterID is the identifier of the datacenter object.
 	DatacenterID() DatacenterID
+	// Description returns the description of the network.
+	Description() string
+	// VLANID returns the VLAN tag of the network, or nil if the network is untagged.
+	VLANID() *uint
+	// MTU returns the MTU of the network in bytes, or 0 if the network uses the default MTU of the engine.
+	MTU() uint
+	// VMNetwork returns true if VMs can be connected to the network. Networks that are not VM networks can only
+	// be used for host traffic, such as storage or migration.
+	VMNetwork() bool
 }
 
 // Network is the interface defining the fields for networks.
@@ -36,6 +64,318 @@ type Network interface {
 
 	// Datacenter fetches the datacenter associated with this network. This is a network call and may be slow.
 	Datacenter(retries ...RetryStrategy) (Datacenter, error)
+	// Update updates the network. See UpdateNetwork for details.
+	Update(params UpdateNetworkParameters, retries ...RetryStrategy) (Network, error)
+	// Remove removes the network. See RemoveNetwork for details.
+	Remove(retries ...RetryStrategy) error
+	// AssignToCluster assigns the network to a cluster. See AssignNetworkToCluster for details.
+	AssignToCluster(clusterID ClusterID, required bool, retries ...RetryStrategy) error
+	// UnassignFromCluster removes the network from a cluster. See UnassignNetworkFromCluster for details.
+	UnassignFromCluster(clusterID ClusterID, retries ...RetryStrategy) error
+}
+
+// ClusterNetwork is a network as assigned to a cluster.
+type ClusterNetwork interface {
+	Network
+
+	// ClusterID returns the cluster the network is assigned to.
+	ClusterID() ClusterID
+	// Required returns true if the hosts of the cluster must be connected to the network to be operational.
+	Required() bool
+}
+
+// CreateNetworkParameters contains the optional parameters for creating a network.
+type CreateNetworkParameters interface {
+	// Description returns the description of the new network.
+	Description() *string
+	// VLANID returns the VLAN tag of the new network. If nil, the network is untagged.
+	VLANID() *uint
+	// MTU returns the MTU of the new network. If nil, the default MTU is used.
+	MTU() *uint
+	// VMNetwork returns if VMs can be connected to the new network. If nil, the network is a VM network.
+	VMNetwork() *bool
+}
+
+// BuildableCreateNetworkParameters is a buildable version of CreateNetworkParameters.
+type BuildableCreateNetworkParameters interface {
+	CreateNetworkParameters
+
+	// WithDescription sets the description of the new network.
+	WithDescription(description string) (BuildableCreateNetworkParameters, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableCreateNetworkParameters
+
+	// WithVLANID sets the VLAN tag of the new network. Valid VLAN tags are 0 to 4094.
+	WithVLANID(vlanID uint) (BuildableCreateNetworkParameters, error)
+	// MustWithVLANID is identical to WithVLANID, but panics instead of returning an error.
+	MustWithVLANID(vlanID uint) BuildableCreateNetworkParameters
+
+	// WithMTU sets the MTU of the new network in bytes. The MTU must be at least 68 bytes.
+	WithMTU(mtu uint) (BuildableCreateNetworkParameters, error)
+	// MustWithMTU is identical to WithMTU, but panics instead of returning an error.
+	MustWithMTU(mtu uint) BuildableCreateNetworkParameters
+
+	// WithVMNetwork sets if VMs can be connected to the new network.
+	WithVMNetwork(vmNetwork bool) (BuildableCreateNetworkParameters, error)
+	// MustWithVMNetwork is identical to WithVMNetwork, but panics instead of returning an error.
+	MustWithVMNetwork(vmNetwork bool) BuildableCreateNetworkParameters
+}
+
+// CreateNetworkParams creates a buildable set of parameters for CreateNetwork.
+func CreateNetworkParams() BuildableCreateNetworkParameters {
+	return &networkParams{}
+}
+
+// UpdateNetworkParameters contains the fields of a network to update. Each nil value leaves the field unchanged.
+type UpdateNetworkParameters interface {
+	// Name returns the new name of the network.
+	Name() *string
+	// Description returns the new description of the network.
+	Description() *string
+	// VLANID returns the new VLAN tag of the network.
+	VLANID() *uint
+	// MTU returns the new MTU of the network.
+	MTU() *uint
+	// VMNetwork returns if VMs can be connected to the network.
+	VMNetwork() *bool
+}
+
+// BuildableUpdateNetworkParameters is a buildable version of UpdateNetworkParameters.
+type BuildableUpdateNetworkParameters interface {
+	UpdateNetworkParameters
+
+	// WithName sets the new name of the network.
+	WithName(name string) (BuildableUpdateNetworkParameters, error)
+	// MustWithName is identical to WithName, but panics instead of returning an error.
+	MustWithName(name string) BuildableUpdateNetworkParameters
+
+	// WithDescription sets the new description of the network.
+	WithDescription(description string) (BuildableUpdateNetworkParameters, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableUpdateNetworkParameters
+
+	// WithVLANID sets the new VLAN tag of the network. Valid VLAN tags are 0 to 4094.
+	WithVLANID(vlanID uint) (BuildableUpdateNetworkParameters, error)
+	// MustWithVLANID is identical to WithVLANID, but panics instead of returning an error.
+	MustWithVLANID(vlanID uint) BuildableUpdateNetworkParameters
+
+	// WithMTU sets the new MTU of the network in bytes. The MTU must be at least 68 bytes.
+	WithMTU(mtu uint) (BuildableUpdateNetworkParameters, error)
+	// MustWithMTU is identical to WithMTU, but panics instead of returning an error.
+	MustWithMTU(mtu uint) BuildableUpdateNetworkParameters
+
+	// WithVMNetwork sets if VMs can be connected to the network.
+	WithVMNetwork(vmNetwork bool) (BuildableUpdateNetworkParameters, error)
+	// MustWithVMNetwork is identical to WithVMNetwork, but panics instead of returning an error.
+	MustWithVMNetwork(vmNetwork bool) BuildableUpdateNetworkParameters
+}
+
+// UpdateNetworkParams creates a buildable set of parameters for UpdateNetwork.
+func UpdateNetworkParams() BuildableUpdateNetworkParameters {
+	return &updateNetworkParams{}
+}
+
+const (
+	networkMaxVLANID = 4094
+	networkMinMTU    = 68
+)
+
+// networkParams holds the fields shared by the create and update parameters.
+type networkParams struct {
+	description *string
+	vlanID      *uint
+	mtu         *uint
+	vmNetwork   *bool
+}
+
+func (n *networkParams) Description() *string {
+	return n.description
+}
+
+func (n *networkParams) VLANID() *uint {
+	return n.vlanID
+}
+
+func (n *networkParams) MTU() *uint {
+	return n.mtu
+}
+
+func (n *networkParams) VMNetwork() *bool {
+	return n.vmNetwork
+}
+
+func (n *networkParams) WithDescription(description string) (BuildableCreateNetworkParameters, error) {
+	n.description = &description
+	return n, nil
+}
+
+func (n *networkParams) MustWithDescription(description string) BuildableCreateNetworkParameters {
+	builder, err := n.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (n *networkParams) WithVLANID(vlanID uint) (BuildableCreateNetworkParameters, error) {
+	if vlanID > networkMaxVLANID {
+		return nil, newError(EBadArgument, "invalid VLAN ID %d, must be between 0 and %d", vlanID, networkMaxVLANID)
+	}
+	n.vlanID = &vlanID
+	return n, nil
+}
+
+func (n *networkParams) MustWithVLANID(vlanID uint) BuildableCreateNetworkParameters {
+	builder, err := n.WithVLANID(vlanID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (n *networkParams) WithMTU(mtu uint) (BuildableCreateNetworkParameters, error) {
+	if mtu < networkMinMTU {
+		return nil, newError(EBadArgument, "invalid MTU %d, must be at least %d", mtu, networkMinMTU)
+	}
+	n.mtu = &mtu
+	return n, nil
+}
+
+func (n *networkParams) MustWithMTU(mtu uint) BuildableCreateNetworkParameters {
+	builder, err := n.WithMTU(mtu)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (n *networkParams) WithVMNetwork(vmNetwork bool) (BuildableCreateNetworkParameters, error) {
+	n.vmNetwork = &vmNetwork
+	return n, nil
+}
+
+func (n *networkParams) MustWithVMNetwork(vmNetwork bool) BuildableCreateNetworkParameters {
+	builder, err := n.WithVMNetwork(vmNetwork)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+type updateNetworkParams struct {
+	networkParams
+
+	name *string
+}
+
+func (u *updateNetworkParams) Name() *string {
+	return u.name
+}
+
+func (u *updateNetworkParams) WithName(name string) (BuildableUpdateNetworkParameters, error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "the network name must not be empty")
+	}
+	u.name = &name
+	return u, nil
+}
+
+func (u *updateNetworkParams) MustWithName(name string) BuildableUpdateNetworkParameters {
+	builder, err := u.WithName(name)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateNetworkParams) WithDescription(description string) (BuildableUpdateNetworkParameters, error) {
+	if _, err := u.networkParams.WithDescription(description); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateNetworkParams) MustWithDescription(description string) BuildableUpdateNetworkParameters {
+	builder, err := u.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateNetworkParams) WithVLANID(vlanID uint) (BuildableUpdateNetworkParameters, error) {
+	if _, err := u.networkParams.WithVLANID(vlanID); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateNetworkParams) MustWithVLANID(vlanID uint) BuildableUpdateNetworkParameters {
+	builder, err := u.WithVLANID(vlanID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateNetworkParams) WithMTU(mtu uint) (BuildableUpdateNetworkParameters, error) {
+	if _, err := u.networkParams.WithMTU(mtu); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateNetworkParams) MustWithMTU(mtu uint) BuildableUpdateNetworkParameters {
+	builder, err := u.WithMTU(mtu)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateNetworkParams) WithVMNetwork(vmNetwork bool) (BuildableUpdateNetworkParameters, error) {
+	if _, err := u.networkParams.WithVMNetwork(vmNetwork); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateNetworkParams) MustWithVMNetwork(vmNetwork bool) BuildableUpdateNetworkParameters {
+	builder, err := u.WithVMNetwork(vmNetwork)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// buildSDKNetwork fills the network builder from the parameters shared by the create and update calls. The usages
+// are the current usages of the network, which are extended or reduced by the vm usage if VMNetwork is set.
+func buildSDKNetwork(
+	builder *ovirtsdk4.NetworkBuilder,
+	params CreateNetworkParameters,
+	usages []ovirtsdk4.NetworkUsage,
+) {
+	if description := params.Description(); description != nil {
+		builder.Description(*description)
+	}
+	if vlanID := params.VLANID(); vlanID != nil {
+		builder.Vlan(ovirtsdk4.NewVlanBuilder().Id(int64(*vlanID)).MustBuild())
+	}
+	if mtu := params.MTU(); mtu != nil {
+		builder.Mtu(int64(*mtu))
+	}
+	if vmNetwork := params.VMNetwork(); vmNetwork != nil {
+		newUsages := []ovirtsdk4.NetworkUsage{}
+		for _, usage := range usages {
+			if usage != ovirtsdk4.NETWORKUSAGE_VM {
+				newUsages = append(newUsages, usage)
+			}
+		}
+		if *vmNetwork {
+			newUsages = append(newUsages, ovirtsdk4.NETWORKUSAGE_VM)
+		}
+		builder.Usages(newUsages)
+	}
 }
 
 func convertSDKNetwork(sdkObject *ovirtsdk4.Network, client *oVirtClient) (Network, error) {
@@ -55,20 +395,58 @@ func convertSDKNetwork(sdkObject *ovirtsdk4.Network, client *oVirtClient) (Netwo
 	if !ok {
 		return nil, newFieldNotFound("datacenter on network", "ID")
 	}
-	return &network{
+	result := &network{
 		client: client,
 		id:     NetworkID(id),
 		name:   name,
 		dcID:   DatacenterID(dcID),
+	}
+	result.description, _ = sdkObject.Description()
+	if sdkVLAN, ok := sdkObject.Vlan(); ok {
+		if vlanID, ok := sdkVLAN.Id(); ok {
+			id := uint(vlanID)
+			result.vlanID = &id
+		}
+	}
+	if mtu, ok := sdkObject.Mtu(); ok {
+		result.mtu = uint(mtu)
+	}
+	if usages, ok := sdkObject.Usages(); ok {
+		for _, usage := range usages {
+			if usage == ovirtsdk4.NETWORKUSAGE_VM {
+				result.vmNetwork = true
+			}
+		}
+	}
+	return result, nil
+}
+
+func convertSDKClusterNetwork(sdkObject *ovirtsdk4.Network, clusterID ClusterID, client *oVirtClient) (
+	ClusterNetwork,
+	error,
+) {
+	result, err := convertSDKNetwork(sdkObject, client)
+	if err != nil {
+		return nil, err
+	}
+	required, _ := sdkObject.Required()
+	return &clusterNetwork{
+		network:   *(result.(*network)),
+		clusterID: clusterID,
+		required:  required,
 	}, nil
 }
 
 type network struct {
 	client Client
 
-	id   NetworkID
-	name string
-	dcID DatacenterID
+	id          NetworkID
+	name        string
+	dcID        DatacenterID
+	description string
+	vlanID      *uint
+	mtu         uint
+	vmNetwork   bool
 }
 
 func (n network) ID() NetworkID {
@@ -86,3 +464,50 @@ func (n network) DatacenterID() DatacenterID {
 func (n network) Datacenter(retries ...RetryStrategy) (Datacenter, error) {
 	return n.client.GetDatacenter(n.dcID, retries...)
 }
+
+func (n network) Description() string {
+	return n.description
+}
+
+func (n network) VLANID() *uint {
+	return n.vlanID
+}
+
+func (n network) MTU() uint {
+	return n.mtu
+}
+
+func (n network) VMNetwork() bool {
+	return n.vmNetwork
+}
+
+func (n network) Update(params UpdateNetworkParameters, retries ...RetryStrategy) (Network, error) {
+	return n.client.UpdateNetwork(n.id, params, retries...)
+}
+
+func (n network) Remove(retries ...RetryStrategy) error {
+	return n.client.RemoveNetwork(n.id, retries...)
+}
+
+func (n network) AssignToCluster(clusterID ClusterID, required bool, retries ...RetryStrategy) error {
+	return n.client.AssignNetworkToCluster(clusterID, n.id, required, retries...)
+}
+
+func (n network) UnassignFromCluster(clusterID ClusterID, retries ...RetryStrategy) error {
+	return n.client.UnassignNetworkFromCluster(clusterID, n.id, retries...)
+}
+
+type clusterNetwork struct {
+	network
+
+	clusterID ClusterID
+	required  bool
+}
+
+func (c clusterNetwork) ClusterID() ClusterID {
+	return c.clusterID
+}
+
+func (c clusterNetwork) Required() bool {
+	return c.required
+}
@@ -0,0 +1,73 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) AssignNetworkToCluster(
+	clusterID ClusterID,
+	networkID NetworkID,
+	required bool,
+	retries ...RetryStrategy,
+) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("assigning network %s to cluster %s", networkID, clusterID),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().ClustersService().ClusterService(string(clusterID)).
+				NetworksService().
+				Add().
+				Network(ovirtsdk4.NewNetworkBuilder().Id(string(networkID)).Required(required).MustBuild()).
+				Send()
+			return err
+		})
+	return
+}
+
+func (m *mockClient) AssignNetworkToCluster(
+	clusterID ClusterID,
+	networkID NetworkID,
+	required bool,
+	retries ...RetryStrategy,
+) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("assigning network %s to cluster %s", networkID, clusterID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			c, ok := m.clusters[clusterID]
+			if !ok {
+				return newError(ENotFound, "cluster with ID %s not found", clusterID)
+			}
+			n, ok := m.networks[networkID]
+			if !ok {
+				return newError(ENotFound, "network with ID %s not found", networkID)
+			}
+			if c.datacenterID != n.dcID {
+				return newError(
+					EBadArgument,
+					"network %s is in datacenter %s, but cluster %s is in datacenter %s",
+					networkID,
+					n.dcID,
+					clusterID,
+					c.datacenterID,
+				)
+			}
+			if _, ok := m.clusterNetworks[clusterID][networkID]; ok {
+				return newError(EConflict, "network %s is already assigned to cluster %s", networkID, clusterID)
+			}
+			if _, ok := m.clusterNetworks[clusterID]; !ok {
+				m.clusterNetworks[clusterID] = map[NetworkID]bool{}
+			}
+			m.clusterNetworks[clusterID][networkID] = required
+			return nil
+		})
+}
@@ -0,0 +1,68 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListClusterNetworks(clusterID ClusterID, retries ...RetryStrategy) (
+	result []ClusterNetwork,
+	err error,
+) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []ClusterNetwork{}
+	err = retry(
+		fmt.Sprintf("listing networks of cluster %s", clusterID),
+		o.logger,
+		retries,
+		func() error {
+			response, e := o.conn.SystemService().ClustersService().ClusterService(string(clusterID)).
+				NetworksService().
+				List().
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Networks()
+			if !ok {
+				return nil
+			}
+			result = make([]ClusterNetwork, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKClusterNetwork(sdkObject, clusterID, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert cluster network during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) ListClusterNetworks(
+	clusterID ClusterID,
+	retries ...RetryStrategy,
+) (result []ClusterNetwork, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	err = retry(
+		fmt.Sprintf("listing networks of cluster %s", clusterID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, ok := m.clusters[clusterID]; !ok {
+				return newError(ENotFound, "cluster with ID %s not found", clusterID)
+			}
+			result = make([]ClusterNetwork, 0, len(m.clusterNetworks[clusterID]))
+			for networkID, required := range m.clusterNetworks[clusterID] {
+				result = append(result, &clusterNetwork{
+					network:   *m.networks[networkID],
+					clusterID: clusterID,
+					required:  required,
+				})
+			}
+			return nil
+		})
+	return
+}
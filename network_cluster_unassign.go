@@ -0,0 +1,48 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) UnassignNetworkFromCluster(
+	clusterID ClusterID,
+	networkID NetworkID,
+	retries ...RetryStrategy,
+) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("removing network %s from cluster %s", networkID, clusterID),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().ClustersService().ClusterService(string(clusterID)).
+				NetworksService().
+				NetworkService(string(networkID)).
+				Remove().
+				Send()
+			return err
+		})
+	return
+}
+
+func (m *mockClient) UnassignNetworkFromCluster(
+	clusterID ClusterID,
+	networkID NetworkID,
+	retries ...RetryStrategy,
+) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("unassigning network %s from cluster %s", networkID, clusterID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, ok := m.clusterNetworks[clusterID][networkID]; !ok {
+				return newError(ENotFound, "network %s is not assigned to cluster %s", networkID, clusterID)
+			}
+			delete(m.clusterNetworks[clusterID], networkID)
+			return nil
+		})
+}
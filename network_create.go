@@ -0,0 +1,130 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) CreateNetwork(
+	datacenterID DatacenterID,
+	name string,
+	params CreateNetworkParameters,
+	retries ...RetryStrategy,
+) (result Network, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if err := validateNetworkName(name); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = CreateNetworkParams()
+	}
+	builder := ovirtsdk4.NewNetworkBuilder().
+		Name(name).
+		DataCenter(ovirtsdk4.NewDataCenterBuilder().Id(string(datacenterID)).MustBuild())
+	buildSDKNetwork(builder, params, nil)
+	sdkNetwork, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build network")
+	}
+
+	err = retry(
+		fmt.Sprintf("creating network %s in datacenter %s", name, datacenterID),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().NetworksService().Add().Network(sdkNetwork).Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Network()
+			if !ok {
+				return newFieldNotFound("network creation response", "network")
+			}
+			result, err = convertSDKNetwork(sdkObject, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert network")
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) CreateNetwork(
+	datacenterID DatacenterID,
+	name string,
+	params CreateNetworkParameters,
+	retries ...RetryStrategy,
+) (result Network, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if err := validateNetworkName(name); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = CreateNetworkParams()
+	}
+	err = retry(
+		fmt.Sprintf("creating network %s", name),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, ok := m.dataCenters[datacenterID]; !ok {
+				return newError(ENotFound, "datacenter with ID %s not found", datacenterID)
+			}
+			if err := m.checkNetworkNameAvailable(datacenterID, name, ""); err != nil {
+				return err
+			}
+
+			created := &network{
+				client:    m,
+				id:        NetworkID(m.GenerateUUID()),
+				name:      name,
+				dcID:      datacenterID,
+				vmNetwork: true,
+			}
+			applyNetworkParams(created, params)
+			m.networks[created.id] = created
+			result = created
+			return nil
+		})
+	return
+}
+
+func validateNetworkName(name string) error {
+	if name == "" {
+		return newError(EBadArgument, "the network name must not be empty")
+	}
+	return nil
+}
+
+// checkNetworkNameAvailable returns an EConflict error if a network other than the excluded one has the specified
+// name in the datacenter. The caller must hold the lock of the mock client.
+func (m *mockClient) checkNetworkNameAvailable(datacenterID DatacenterID, name string, excludeID NetworkID) error {
+	for _, n := range m.networks {
+		if n.dcID == datacenterID && n.name == name && n.id != excludeID {
+			return newError(EConflict, "a network with the name %s already exists in datacenter %s", name, datacenterID)
+		}
+	}
+	return nil
+}
+
+// applyNetworkParams applies the parameters shared by the create and update calls to a mock network.
+func applyNetworkParams(n *network, params CreateNetworkParameters) {
+	if description := params.Description(); description != nil {
+		n.description = *description
+	}
+	if vlanID := params.VLANID(); vlanID != nil {
+		id := *vlanID
+		n.vlanID = &id
+	}
+	if mtu := params.MTU(); mtu != nil {
+		n.mtu = *mtu
+	}
+	if vmNetwork := params.VMNetwork(); vmNetwork != nil {
+		n.vmNetwork = *vmNetwork
+	}
+}
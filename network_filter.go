@@ -0,0 +1,57 @@
+package ovirtclient
+
+import (
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// NetworkFilterID is the identifier of a network filter.
+type NetworkFilterID string
+
+// defaultNetworkFilterName is the network filter the engine applies to new VNIC profiles that are not in pass-through
+// mode.
+const defaultNetworkFilterName = "vdsm-no-mac-spoofing"
+
+// NetworkFilterClient describes the functions related to network filters. Network filters are predefined libvirt
+// filters, such as vdsm-no-mac-spoofing or clean-traffic, that can be applied to VNIC profiles.
+type NetworkFilterClient interface {
+	// ListNetworkFilters lists the network filters supported by the engine.
+	ListNetworkFilters(retries ...RetryStrategy) ([]NetworkFilter, error)
+	// GetNetworkFilterByName returns the network filter with the specified name, for example vdsm-no-mac-spoofing.
+	GetNetworkFilterByName(name string, retries ...RetryStrategy) (NetworkFilter, error)
+}
+
+// NetworkFilter is a predefined filter for the traffic of a virtual network interface.
+type NetworkFilter interface {
+	// ID returns the identifier of the network filter.
+	ID() NetworkFilterID
+	// Name returns the libvirt name of the network filter.
+	Name() string
+}
+
+func convertSDKNetworkFilter(sdkObject *ovirtsdk4.NetworkFilter) (NetworkFilter, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("network filter", "ID")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("network filter", "name")
+	}
+	return &networkFilter{
+		id:   NetworkFilterID(id),
+		name: name,
+	}, nil
+}
+
+type networkFilter struct {
+	id   NetworkFilterID
+	name string
+}
+
+func (n *networkFilter) ID() NetworkFilterID {
+	return n.id
+}
+
+func (n *networkFilter) Name() string {
+	return n.name
+}
@@ -0,0 +1,65 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetNetworkFilterByName(name string, retries ...RetryStrategy) (result NetworkFilter, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting network filter %s", name),
+		o.logger,
+		retries,
+		func() error {
+			// The network filters service does not support searching, so we filter client-side.
+			response, err := o.conn.SystemService().NetworkFiltersService().List().Send()
+			if err != nil {
+				return err
+			}
+			sdkObjects, ok := response.Filters()
+			if !ok {
+				return newError(ENotFound, "no network filter named %s found", name)
+			}
+			for _, sdkObject := range sdkObjects.Slice() {
+				if n, ok := sdkObject.Name(); ok && n == name {
+					result, err = convertSDKNetworkFilter(sdkObject)
+					if err != nil {
+						return wrap(err, EBug, "failed to convert network filter %s", name)
+					}
+					return nil
+				}
+			}
+			return newError(ENotFound, "no network filter named %s found", name)
+		})
+	return result, err
+}
+
+func (m *mockClient) GetNetworkFilterByName(name string, retries ...RetryStrategy) (result NetworkFilter, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	err = retry(
+		fmt.Sprintf("getting network filter %s", name),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if item := m.findNetworkFilterByName(name); item != nil {
+				result = item
+				return nil
+			}
+			return newError(ENotFound, "no network filter named %s found", name)
+		})
+	return
+}
+
+// findNetworkFilterByName returns the network filter with the specified name, or nil if there is none. The caller
+// must hold the lock of the mock client.
+func (m *mockClient) findNetworkFilterByName(name string) *networkFilter {
+	for _, item := range m.networkFilters {
+		if item.name == name {
+			return item
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package ovirtclient
+
+func (o *oVirtClient) ListNetworkFilters(retries ...RetryStrategy) (result []NetworkFilter, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []NetworkFilter{}
+	err = retry(
+		"listing network filters",
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().NetworkFiltersService().List().Send()
+			if err != nil {
+				return err
+			}
+			sdkObjects, ok := response.Filters()
+			if !ok {
+				return nil
+			}
+			result = make([]NetworkFilter, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], err = convertSDKNetworkFilter(sdkObject)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert network filter during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) ListNetworkFilters(retries ...RetryStrategy) (result []NetworkFilter, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	err = retry(
+		"listing network filters",
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			result = make([]NetworkFilter, 0, len(m.networkFilters))
+			for _, item := range m.networkFilters {
+				result = append(result, item)
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,333 @@
+package ovirtclient
+
+import (
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// NetworkQoSID is the identifier of a network QoS entry.
+type NetworkQoSID string
+
+// NetworkQoSClient describes the functions related to network QoS entries. Network QoS entries belong to a datacenter
+// and limit the traffic of the VNIC profiles referencing them.
+//
+// See https://www.ovirt.org/documentation/administration_guide/#sect-Virtual_Machine_Network_QoS for details.
+type NetworkQoSClient interface {
+	// CreateNetworkQoS creates a new network QoS entry in the specified datacenter. The params parameter may be nil,
+	// in which case the traffic is not limited.
+	CreateNetworkQoS(
+		datacenterID DatacenterID,
+		name string,
+		params CreateNetworkQoSParameters,
+		retries ...RetryStrategy,
+	) (NetworkQoS, error)
+	// GetNetworkQoS returns a single network QoS entry of a datacenter.
+	GetNetworkQoS(datacenterID DatacenterID, id NetworkQoSID, retries ...RetryStrategy) (NetworkQoS, error)
+	// ListNetworkQoS lists the network QoS entries of a datacenter. QoS entries of other types, such as storage or
+	// CPU, are not returned.
+	ListNetworkQoS(datacenterID DatacenterID, retries ...RetryStrategy) ([]NetworkQoS, error)
+	// RemoveNetworkQoS removes a network QoS entry. VNIC profiles referencing it are no longer limited.
+	RemoveNetworkQoS(datacenterID DatacenterID, id NetworkQoSID, retries ...RetryStrategy) error
+}
+
+// NetworkQoSData contains the data of a network QoS entry.
+type NetworkQoSData interface {
+	// ID returns the identifier of the QoS entry.
+	ID() NetworkQoSID
+	// Name returns the name of the QoS entry.
+	Name() string
+	// Description returns the description of the QoS entry.
+	Description() string
+	// DatacenterID returns the datacenter the QoS entry belongs to.
+	DatacenterID() DatacenterID
+	// Inbound returns the limits of the traffic going to the VM, or nil if the inbound traffic is not limited.
+	Inbound() NetworkQoSTrafficLimits
+	// Outbound returns the limits of the traffic coming from the VM, or nil if the outbound traffic is not limited.
+	Outbound() NetworkQoSTrafficLimits
+}
+
+// NetworkQoS is a network QoS entry that can be referenced by VNIC profiles.
+type NetworkQoS interface {
+	NetworkQoSData
+
+	// Remove removes the QoS entry. See RemoveNetworkQoS for details.
+	Remove(retries ...RetryStrategy) error
+}
+
+// NetworkQoSTrafficLimits describes the limits of the traffic in one direction.
+type NetworkQoSTrafficLimits interface {
+	// Average returns the average rate in Mbps.
+	Average() uint
+	// Peak returns the peak rate in Mbps.
+	Peak() uint
+	// Burst returns the size of the burst in MB.
+	Burst() uint
+}
+
+// CreateNetworkQoSParameters contains the optional parameters for creating a network QoS entry.
+type CreateNetworkQoSParameters interface {
+	// Description returns the description of the QoS entry.
+	Description() *string
+	// Inbound returns the limits of the traffic going to the VM. If nil, the inbound traffic is not limited.
+	Inbound() NetworkQoSTrafficLimits
+	// Outbound returns the limits of the traffic coming from the VM. If nil, the outbound traffic is not limited.
+	Outbound() NetworkQoSTrafficLimits
+}
+
+// BuildableCreateNetworkQoSParameters is a buildable version of CreateNetworkQoSParameters.
+type BuildableCreateNetworkQoSParameters interface {
+	CreateNetworkQoSParameters
+
+	// WithDescription sets the description of the QoS entry.
+	WithDescription(description string) (BuildableCreateNetworkQoSParameters, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableCreateNetworkQoSParameters
+
+	// WithInbound limits the traffic going to the VM. The average and peak rates are in Mbps, the burst is in MB.
+	// The peak must not be lower than the average.
+	WithInbound(average uint, peak uint, burst uint) (BuildableCreateNetworkQoSParameters, error)
+	// MustWithInbound is identical to WithInbound, but panics instead of returning an error.
+	MustWithInbound(average uint, peak uint, burst uint) BuildableCreateNetworkQoSParameters
+
+	// WithOutbound limits the traffic coming from the VM. The average and peak rates are in Mbps, the burst is in
+	// MB. The peak must not be lower than the average.
+	WithOutbound(average uint, peak uint, burst uint) (BuildableCreateNetworkQoSParameters, error)
+	// MustWithOutbound is identical to WithOutbound, but panics instead of returning an error.
+	MustWithOutbound(average uint, peak uint, burst uint) BuildableCreateNetworkQoSParameters
+}
+
+// CreateNetworkQoSParams creates a buildable set of parameters for CreateNetworkQoS.
+func CreateNetworkQoSParams() BuildableCreateNetworkQoSParameters {
+	return &networkQoSParams{}
+}
+
+type networkQoSParams struct {
+	description *string
+	inbound     *networkQoSTrafficLimits
+	outbound    *networkQoSTrafficLimits
+}
+
+func (n *networkQoSParams) Description() *string {
+	return n.description
+}
+
+func (n *networkQoSParams) Inbound() NetworkQoSTrafficLimits {
+	if n.inbound == nil {
+		return nil
+	}
+	return n.inbound
+}
+
+func (n *networkQoSParams) Outbound() NetworkQoSTrafficLimits {
+	if n.outbound == nil {
+		return nil
+	}
+	return n.outbound
+}
+
+func (n *networkQoSParams) WithDescription(description string) (BuildableCreateNetworkQoSParameters, error) {
+	n.description = &description
+	return n, nil
+}
+
+func (n *networkQoSParams) MustWithDescription(description string) BuildableCreateNetworkQoSParameters {
+	builder, err := n.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (n *networkQoSParams) WithInbound(
+	average uint,
+	peak uint,
+	burst uint,
+) (BuildableCreateNetworkQoSParameters, error) {
+	limits, err := newNetworkQoSTrafficLimits("inbound", average, peak, burst)
+	if err != nil {
+		return nil, err
+	}
+	n.inbound = limits
+	return n, nil
+}
+
+func (n *networkQoSParams) MustWithInbound(average uint, peak uint, burst uint) BuildableCreateNetworkQoSParameters {
+	builder, err := n.WithInbound(average, peak, burst)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (n *networkQoSParams) WithOutbound(
+	average uint,
+	peak uint,
+	burst uint,
+) (BuildableCreateNetworkQoSParameters, error) {
+	limits, err := newNetworkQoSTrafficLimits("outbound", average, peak, burst)
+	if err != nil {
+		return nil, err
+	}
+	n.outbound = limits
+	return n, nil
+}
+
+func (n *networkQoSParams) MustWithOutbound(average uint, peak uint, burst uint) BuildableCreateNetworkQoSParameters {
+	builder, err := n.WithOutbound(average, peak, burst)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func newNetworkQoSTrafficLimits(direction string, average uint, peak uint, burst uint) (
+	*networkQoSTrafficLimits,
+	error,
+) {
+	if average == 0 {
+		return nil, newError(EBadArgument, "the %s average rate must be at least 1 Mbps", direction)
+	}
+	if peak < average {
+		return nil, newError(
+			EBadArgument,
+			"the %s peak rate (%d Mbps) must not be lower than the average rate (%d Mbps)",
+			direction,
+			peak,
+			average,
+		)
+	}
+	if burst == 0 {
+		return nil, newError(EBadArgument, "the %s burst must be at least 1 MB", direction)
+	}
+	return &networkQoSTrafficLimits{
+		average: average,
+		peak:    peak,
+		burst:   burst,
+	}, nil
+}
+
+func validateNetworkQoSName(name string) error {
+	if name == "" {
+		return newError(EBadArgument, "the network QoS name must not be empty")
+	}
+	return nil
+}
+
+func buildSDKNetworkQoS(name string, params CreateNetworkQoSParameters) (*ovirtsdk4.Qos, error) {
+	builder := ovirtsdk4.NewQosBuilder().
+		Name(name).
+		Type(ovirtsdk4.QOSTYPE_NETWORK)
+	if description := params.Description(); description != nil {
+		builder.Description(*description)
+	}
+	if inbound := params.Inbound(); inbound != nil {
+		builder.InboundAverage(int64(inbound.Average()))
+		builder.InboundPeak(int64(inbound.Peak()))
+		builder.InboundBurst(int64(inbound.Burst()))
+	}
+	if outbound := params.Outbound(); outbound != nil {
+		builder.OutboundAverage(int64(outbound.Average()))
+		builder.OutboundPeak(int64(outbound.Peak()))
+		builder.OutboundBurst(int64(outbound.Burst()))
+	}
+	return builder.Build()
+}
+
+func convertSDKNetworkQoS(sdkObject *ovirtsdk4.Qos, datacenterID DatacenterID, client Client) (NetworkQoS, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("network QoS", "ID")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("network QoS", "name")
+	}
+	result := &networkQoS{
+		client: client,
+		id:     NetworkQoSID(id),
+		name:   name,
+		dcID:   datacenterID,
+	}
+	result.description, _ = sdkObject.Description()
+	if average, ok := sdkObject.InboundAverage(); ok {
+		peak, _ := sdkObject.InboundPeak()
+		burst, _ := sdkObject.InboundBurst()
+		result.inbound = &networkQoSTrafficLimits{
+			average: uint(average),
+			peak:    uint(peak),
+			burst:   uint(burst),
+		}
+	}
+	if average, ok := sdkObject.OutboundAverage(); ok {
+		peak, _ := sdkObject.OutboundPeak()
+		burst, _ := sdkObject.OutboundBurst()
+		result.outbound = &networkQoSTrafficLimits{
+			average: uint(average),
+			peak:    uint(peak),
+			burst:   uint(burst),
+		}
+	}
+	return result, nil
+}
+
+type networkQoS struct {
+	client Client
+
+	id          NetworkQoSID
+	name        string
+	description string
+	dcID        DatacenterID
+	inbound     *networkQoSTrafficLimits
+	outbound    *networkQoSTrafficLimits
+}
+
+func (n *networkQoS) ID() NetworkQoSID {
+	return n.id
+}
+
+func (n *networkQoS) Name() string {
+	return n.name
+}
+
+func (n *networkQoS) Description() string {
+	return n.description
+}
+
+func (n *networkQoS) DatacenterID() DatacenterID {
+	return n.dcID
+}
+
+func (n *networkQoS) Inbound() NetworkQoSTrafficLimits {
+	if n.inbound == nil {
+		return nil
+	}
+	return n.inbound
+}
+
+func (n *networkQoS) Outbound() NetworkQoSTrafficLimits {
+	if n.outbound == nil {
+		return nil
+	}
+	return n.outbound
+}
+
+func (n *networkQoS) Remove(retries ...RetryStrategy) error {
+	return n.client.RemoveNetworkQoS(n.dcID, n.id, retries...)
+}
+
+type networkQoSTrafficLimits struct {
+	average uint
+	peak    uint
+	burst   uint
+}
+
+func (n *networkQoSTrafficLimits) Average() uint {
+	return n.average
+}
+
+func (n *networkQoSTrafficLimits) Peak() uint {
+	return n.peak
+}
+
+func (n *networkQoSTrafficLimits) Burst() uint {
+	return n.burst
+}
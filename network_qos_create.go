@@ -0,0 +1,115 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) CreateNetworkQoS(
+	datacenterID DatacenterID,
+	name string,
+	params CreateNetworkQoSParameters,
+	retries ...RetryStrategy,
+) (result NetworkQoS, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if err := validateNetworkQoSName(name); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = CreateNetworkQoSParams()
+	}
+	sdkQoS, err := buildSDKNetworkQoS(name, params)
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build network QoS")
+	}
+
+	err = retry(
+		fmt.Sprintf("creating network QoS %s in datacenter %s", name, datacenterID),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().DataCentersService().DataCenterService(string(datacenterID)).
+				QossService().
+				Add().
+				Qos(sdkQoS).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Qos()
+			if !ok {
+				return newFieldNotFound("network QoS creation response", "QoS")
+			}
+			result, err = convertSDKNetworkQoS(sdkObject, datacenterID, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert network QoS")
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) CreateNetworkQoS(
+	datacenterID DatacenterID,
+	name string,
+	params CreateNetworkQoSParameters,
+	retries ...RetryStrategy,
+) (result NetworkQoS, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if err := validateNetworkQoSName(name); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = CreateNetworkQoSParams()
+	}
+	err = retry(
+		fmt.Sprintf("creating network QoS %s", name),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, ok := m.dataCenters[datacenterID]; !ok {
+				return newError(ENotFound, "datacenter with ID %s not found", datacenterID)
+			}
+			for _, qos := range m.networkQoS {
+				if qos.dcID == datacenterID && qos.name == name {
+					return newError(
+						EConflict,
+						"a network QoS with the name %s already exists in datacenter %s",
+						name,
+						datacenterID,
+					)
+				}
+			}
+
+			created := &networkQoS{
+				client: m,
+				id:     NetworkQoSID(m.GenerateUUID()),
+				name:   name,
+				dcID:   datacenterID,
+			}
+			if description := params.Description(); description != nil {
+				created.description = *description
+			}
+			if inbound := params.Inbound(); inbound != nil {
+				created.inbound = &networkQoSTrafficLimits{
+					average: inbound.Average(),
+					peak:    inbound.Peak(),
+					burst:   inbound.Burst(),
+				}
+			}
+			if outbound := params.Outbound(); outbound != nil {
+				created.outbound = &networkQoSTrafficLimits{
+					average: outbound.Average(),
+					peak:    outbound.Peak(),
+					burst:   outbound.Burst(),
+				}
+			}
+			m.networkQoS[created.id] = created
+			result = created
+			return nil
+		})
+	return
+}
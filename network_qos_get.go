@@ -0,0 +1,69 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) GetNetworkQoS(
+	datacenterID DatacenterID,
+	id NetworkQoSID,
+	retries ...RetryStrategy,
+) (result NetworkQoS, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting network QoS %s in datacenter %s", id, datacenterID),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().DataCentersService().DataCenterService(string(datacenterID)).
+				QossService().
+				QosService(string(id)).
+				Get().
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Qos()
+			if !ok {
+				return newError(ENotFound, "no QoS returned when getting network QoS ID %s", id)
+			}
+			if qosType, _ := sdkObject.Type(); qosType != ovirtsdk4.QOSTYPE_NETWORK {
+				return newError(ENotFound, "QoS %s is not a network QoS (type: %s)", id, qosType)
+			}
+			result, err = convertSDKNetworkQoS(sdkObject, datacenterID, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert network QoS %s", id)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) GetNetworkQoS(
+	datacenterID DatacenterID,
+	id NetworkQoSID,
+	retries ...RetryStrategy,
+) (result NetworkQoS, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	err = retry(
+		fmt.Sprintf("getting network QoS %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, ok := m.dataCenters[datacenterID]; !ok {
+				return newError(ENotFound, "datacenter with ID %s not found", datacenterID)
+			}
+			item, ok := m.networkQoS[id]
+			if !ok || item.dcID != datacenterID {
+				return newError(ENotFound, "network QoS with ID %s not found in datacenter %s", id, datacenterID)
+			}
+			result = item
+			return nil
+		})
+	return
+}
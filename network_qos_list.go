@@ -0,0 +1,69 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) ListNetworkQoS(datacenterID DatacenterID, retries ...RetryStrategy) (result []NetworkQoS, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []NetworkQoS{}
+	err = retry(
+		fmt.Sprintf("listing network QoS entries in datacenter %s", datacenterID),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().DataCentersService().DataCenterService(string(datacenterID)).
+				QossService().
+				List().
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObjects, ok := response.Qoss()
+			if !ok {
+				return nil
+			}
+			result = []NetworkQoS{}
+			for _, sdkObject := range sdkObjects.Slice() {
+				if qosType, _ := sdkObject.Type(); qosType != ovirtsdk4.QOSTYPE_NETWORK {
+					continue
+				}
+				qos, err := convertSDKNetworkQoS(sdkObject, datacenterID, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert network QoS during listing item #%d", len(result))
+				}
+				result = append(result, qos)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) ListNetworkQoS(
+	datacenterID DatacenterID,
+	retries ...RetryStrategy,
+) (result []NetworkQoS, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	err = retry(
+		fmt.Sprintf("listing network QoS in datacenter %s", datacenterID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, ok := m.dataCenters[datacenterID]; !ok {
+				return newError(ENotFound, "datacenter with ID %s not found", datacenterID)
+			}
+			result = []NetworkQoS{}
+			for _, item := range m.networkQoS {
+				if item.dcID == datacenterID {
+					result = append(result, item)
+				}
+			}
+			return nil
+		})
+	return
+}
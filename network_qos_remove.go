@@ -0,0 +1,55 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) RemoveNetworkQoS(datacenterID DatacenterID, id NetworkQoSID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("removing network QoS %s from datacenter %s", id, datacenterID),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().DataCentersService().DataCenterService(string(datacenterID)).
+				QossService().
+				QosService(string(id)).
+				Remove().
+				Send()
+			return err
+		})
+	return
+}
+
+func (m *mockClient) RemoveNetworkQoS(datacenterID DatacenterID, id NetworkQoSID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("removing network QoS %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			item, ok := m.networkQoS[id]
+			if !ok || item.dcID != datacenterID {
+				return newError(ENotFound, "network QoS with ID %s not found in datacenter %s", id, datacenterID)
+			}
+			m.removeMockNetworkQoS(id)
+			return nil
+		})
+}
+
+// removeMockNetworkQoS removes the QoS entry and unlinks it from the VNIC profiles referencing it. The caller must
+// hold the lock of the mock client.
+func (m *mockClient) removeMockNetworkQoS(id NetworkQoSID) {
+	for profileID, profile := range m.vnicProfiles {
+		if profile.networkQoSID != nil && *profile.networkQoSID == id {
+			// Profiles returned earlier must not change, so we store a copy.
+			updated := profile.clone()
+			updated.networkQoSID = nil
+			m.vnicProfiles[profileID] = updated
+		}
+	}
+	delete(m.networkQoS, id)
+}
@@ -0,0 +1,76 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestNetworkQoSCreateListRemove(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	datacenterID := assertCanGetTestDatacenterID(t, helper)
+
+	qos := assertCanCreateNetworkQoS(
+		t,
+		helper,
+		datacenterID,
+		ovirtclient.CreateNetworkQoSParams().
+			MustWithInbound(100, 200, 10).
+			MustWithOutbound(50, 50, 5),
+	)
+	if qos.Inbound() == nil || qos.Inbound().Average() != 100 || qos.Inbound().Peak() != 200 ||
+		qos.Inbound().Burst() != 10 {
+		t.Fatalf("Incorrect inbound limits on the created network QoS.")
+	}
+	if qos.Outbound() == nil || qos.Outbound().Average() != 50 {
+		t.Fatalf("Incorrect outbound limits on the created network QoS.")
+	}
+
+	qosList, err := client.ListNetworkQoS(datacenterID)
+	if err != nil {
+		t.Fatalf("Failed to list network QoS entries (%v)", err)
+	}
+	found := false
+	for _, item := range qosList {
+		if item.ID() == qos.ID() {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("The created network QoS %s was not listed.", qos.ID())
+	}
+
+	if err := qos.Remove(); err != nil {
+		t.Fatalf("Failed to remove network QoS %s (%v)", qos.ID(), err)
+	}
+	if _, err := client.GetNetworkQoS(datacenterID, qos.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Network QoS %s still exists after removal.", qos.ID())
+	}
+}
+
+func TestNetworkQoSParamsRejectPeakBelowAverage(t *testing.T) {
+	t.Parallel()
+	if _, err := ovirtclient.CreateNetworkQoSParams().WithInbound(100, 50, 10); err == nil {
+		t.Fatalf("Setting a peak rate lower than the average rate did not result in an error.")
+	}
+}
+
+func assertCanCreateNetworkQoS(
+	t *testing.T,
+	helper ovirtclient.TestHelper,
+	datacenterID ovirtclient.DatacenterID,
+	params ovirtclient.CreateNetworkQoSParameters,
+) ovirtclient.NetworkQoS {
+	qos, err := helper.GetClient().CreateNetworkQoS(datacenterID, helper.GenerateTestResourceName(t), params)
+	if err != nil {
+		t.Fatalf("Failed to create network QoS (%v)", err)
+	}
+	t.Cleanup(func() {
+		if err := qos.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("Failed to remove network QoS %s (%v)", qos.ID(), err)
+		}
+	})
+	return qos
+}
@@ -0,0 +1,64 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) RemoveNetwork(id NetworkID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("removing network %s", id),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().NetworksService().NetworkService(string(id)).Remove().Send()
+			return err
+		})
+	return
+}
+
+func (m *mockClient) RemoveNetwork(id NetworkID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("removing network %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, ok := m.networks[id]; !ok {
+				return newError(ENotFound, "network with ID %s not found", id)
+			}
+			if profileID, ok := m.networkInUse(id); ok {
+				return newError(EConflict, "cannot remove network %s, VNIC profile %s is in use by a VM", id, profileID)
+			}
+			m.removeMockNetwork(id)
+			return nil
+		})
+}
+
+// networkInUse returns the first VNIC profile of the network used by a NIC. The caller must hold the lock of the
+// mock client.
+func (m *mockClient) networkInUse(id NetworkID) (VNICProfileID, bool) {
+	for _, n := range m.nics {
+		if profile, ok := m.vnicProfiles[n.vnicProfileID]; ok && profile.networkID == id {
+			return profile.id, true
+		}
+	}
+	return "", false
+}
+
+// removeMockNetwork removes the network along with its VNIC profiles and cluster assignments. The caller must hold
+// the lock of the mock client.
+func (m *mockClient) removeMockNetwork(id NetworkID) {
+	for profileID, profile := range m.vnicProfiles {
+		if profile.networkID == id {
+			delete(m.vnicProfiles, profileID)
+		}
+	}
+	for _, networks := range m.clusterNetworks {
+		delete(networks, id)
+	}
+	delete(m.networks, id)
+}
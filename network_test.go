@@ -0,0 +1,147 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestNetworkCreateUpdateRemove(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	datacenterID := assertCanGetTestDatacenterID(t, helper)
+
+	network, err := client.CreateNetwork(
+		datacenterID,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.CreateNetworkParams().
+			MustWithVLANID(42).
+			MustWithMTU(9000).
+			MustWithVMNetwork(false),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create network (%v)", err)
+	}
+	t.Cleanup(func() {
+		if err := network.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("Failed to remove network %s (%v)", network.ID(), err)
+		}
+	})
+	if network.VLANID() == nil || *network.VLANID() != 42 {
+		t.Fatalf("Incorrect VLAN ID on the created network.")
+	}
+	if network.MTU() != 9000 {
+		t.Fatalf("Incorrect MTU (expected: 9000, got: %d)", network.MTU())
+	}
+	if network.VMNetwork() {
+		t.Fatalf("The network was created as a VM network.")
+	}
+
+	network, err = network.Update(ovirtclient.UpdateNetworkParams().MustWithMTU(1500).MustWithVMNetwork(true))
+	if err != nil {
+		t.Fatalf("Failed to update network %s (%v)", network.ID(), err)
+	}
+	if network.MTU() != 1500 || !network.VMNetwork() {
+		t.Fatalf("The network was not updated correctly.")
+	}
+
+	if err := network.Remove(); err != nil {
+		t.Fatalf("Failed to remove network %s (%v)", network.ID(), err)
+	}
+	if _, err := client.GetNetwork(network.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Network %s still exists after removal.", network.ID())
+	}
+}
+
+func TestNetworkClusterAssignment(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	datacenterID := assertCanGetTestDatacenterID(t, helper)
+
+	network, err := client.CreateNetwork(datacenterID, helper.GenerateTestResourceName(t), nil)
+	if err != nil {
+		t.Fatalf("Failed to create network (%v)", err)
+	}
+	t.Cleanup(func() {
+		if err := network.Remove(); err != nil {
+			t.Fatalf("Failed to remove network %s (%v)", network.ID(), err)
+		}
+	})
+
+	if err := network.AssignToCluster(helper.GetClusterID(), false); err != nil {
+		t.Fatalf("Failed to assign network %s to cluster %s (%v)", network.ID(), helper.GetClusterID(), err)
+	}
+	clusterNetwork := assertClusterHasNetwork(t, client, helper.GetClusterID(), network.ID())
+	if clusterNetwork == nil {
+		t.Fatalf("Network %s is not listed on cluster %s.", network.ID(), helper.GetClusterID())
+	}
+	if clusterNetwork.Required() {
+		t.Fatalf("Network %s was assigned as required.", network.ID())
+	}
+
+	if err := network.UnassignFromCluster(helper.GetClusterID()); err != nil {
+		t.Fatalf("Failed to remove network %s from cluster %s (%v)", network.ID(), helper.GetClusterID(), err)
+	}
+	if assertClusterHasNetwork(t, client, helper.GetClusterID(), network.ID()) != nil {
+		t.Fatalf("Network %s is still listed on cluster %s.", network.ID(), helper.GetClusterID())
+	}
+}
+
+func TestDatacenterCreateRemove(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	datacenter, err := client.CreateDatacenter(
+		helper.GenerateTestResourceName(t),
+		ovirtclient.CreateDatacenterParams().MustWithLocal(true),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create datacenter (%v)", err)
+	}
+	if !datacenter.Local() {
+		t.Fatalf("The datacenter was not created with local storage.")
+	}
+	network, err := client.CreateNetwork(datacenter.ID(), helper.GenerateTestResourceName(t), nil)
+	if err != nil {
+		t.Fatalf("Failed to create network in datacenter %s (%v)", datacenter.ID(), err)
+	}
+	if err := network.AssignToCluster(helper.GetClusterID(), false); err == nil {
+		t.Fatalf("Assigning a network to a cluster in a different datacenter did not result in an error.")
+	}
+
+	if err := datacenter.Remove(); err != nil {
+		t.Fatalf("Failed to remove datacenter %s (%v)", datacenter.ID(), err)
+	}
+	if _, err := client.GetNetwork(network.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Network %s still exists after removing its datacenter.", network.ID())
+	}
+}
+
+func assertCanGetTestDatacenterID(t *testing.T, helper ovirtclient.TestHelper) ovirtclient.DatacenterID {
+	cluster, err := helper.GetClient().GetCluster(helper.GetClusterID())
+	if err != nil {
+		t.Fatalf("Failed to fetch cluster %s (%v)", helper.GetClusterID(), err)
+	}
+	return cluster.DatacenterID()
+}
+
+func assertClusterHasNetwork(
+	t *testing.T,
+	client ovirtclient.Client,
+	clusterID ovirtclient.ClusterID,
+	networkID ovirtclient.NetworkID,
+) ovirtclient.ClusterNetwork {
+	networks, err := client.ListClusterNetworks(clusterID)
+	if err != nil {
+		t.Fatalf("Failed to list networks of cluster %s (%v)", clusterID, err)
+	}
+	for _, network := range networks {
+		if network.ID() == networkID {
+			return network
+		}
+	}
+	return nil
+}
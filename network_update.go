@@ -0,0 +1,111 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) UpdateNetwork(
+	id NetworkID,
+	params UpdateNetworkParameters,
+	retries ...RetryStrategy,
+) (result Network, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if params == nil {
+		return nil, newError(EBadArgument, "the update parameters must not be nil")
+	}
+
+	err = retry(
+		fmt.Sprintf("updating network %s", id),
+		o.logger,
+		retries,
+		func() error {
+			var usages []ovirtsdk4.NetworkUsage
+			if params.VMNetwork() != nil {
+				// The usages are replaced on update, so we need the current ones to keep the non-VM usages.
+				response, err := o.conn.SystemService().NetworksService().NetworkService(string(id)).Get().Send()
+				if err != nil {
+					return err
+				}
+				sdkObject, ok := response.Network()
+				if !ok {
+					return newError(ENotFound, "no network returned when getting network ID %s", id)
+				}
+				usages, _ = sdkObject.Usages()
+			}
+			builder := ovirtsdk4.NewNetworkBuilder().Id(string(id))
+			if name := params.Name(); name != nil {
+				builder.Name(*name)
+			}
+			buildSDKNetwork(builder, params, usages)
+			sdkNetwork, err := builder.Build()
+			if err != nil {
+				return wrap(err, EBug, "failed to build network")
+			}
+			response, err := o.conn.SystemService().NetworksService().NetworkService(string(id)).Update().
+				Network(sdkNetwork).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Network()
+			if !ok {
+				return newFieldNotFound("network update response", "network")
+			}
+			result, err = convertSDKNetwork(sdkObject, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert network %s", id)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) UpdateNetwork(
+	id NetworkID,
+	params UpdateNetworkParameters,
+	retries ...RetryStrategy,
+) (result Network, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if params == nil {
+		return nil, newError(EBadArgument, "the update parameters must not be nil")
+	}
+	err = retry(
+		fmt.Sprintf("updating network %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			item, ok := m.networks[id]
+			if !ok {
+				return newError(ENotFound, "network with ID %s not found", id)
+			}
+			// Networks returned earlier must not change, so we update a copy.
+			updated := *item
+			if name := params.Name(); name != nil {
+				if err := m.checkNetworkNameAvailable(item.dcID, *name, id); err != nil {
+					return err
+				}
+				updated.name = *name
+			}
+			if vmNetwork := params.VMNetwork(); vmNetwork != nil && !*vmNetwork {
+				if profileID, ok := m.networkInUse(id); ok {
+					return newError(
+						EConflict,
+						"cannot turn network %s into a non-VM network, VNIC profile %s is in use by a VM",
+						id,
+						profileID,
+					)
+				}
+			}
+			applyNetworkParams(&updated, params)
+			m.networks[id] = &updated
+			result = &updated
+			return nil
+		})
+	return
+}
@@ -25,9 +25,20 @@ type ExtraSettings interface {
 	Proxy() *string
 }
 
+// ExtraSettingsV2 extends ExtraSettings with limits on the requests sent to the oVirt Engine. The limits apply to all
+// requests of a client, including those of sub-clients created with WithContext and image transfers.
+type ExtraSettingsV2 interface {
+	ExtraSettings
+
+	// RateLimit returns the maximum number of requests per second sent to the engine. 0 means no limit.
+	RateLimit() uint
+	// MaxConcurrentRequests returns the maximum number of requests in flight at the same time. 0 means no limit.
+	MaxConcurrentRequests() uint
+}
+
 // ExtraSettingsBuilder is a buildable version of ExtraSettings.
 type ExtraSettingsBuilder interface {
-	ExtraSettings
+	ExtraSettingsV2
 
 	// WithExtraHeaders adds extra headers to send along with each request.
 	WithExtraHeaders(map[string]string) ExtraSettingsBuilder
@@ -35,6 +46,10 @@ type ExtraSettingsBuilder interface {
 	WithCompression() ExtraSettingsBuilder
 	// WithProxy explicitly sets a proxy server to use for requests.
 	WithProxy(string) ExtraSettingsBuilder
+	// WithRateLimit limits the number of requests sent to the engine per second.
+	WithRateLimit(requestsPerSecond uint) ExtraSettingsBuilder
+	// WithMaxConcurrentRequests limits the number of requests in flight at the same time.
+	WithMaxConcurrentRequests(maxConcurrentRequests uint) ExtraSettingsBuilder
 }
 
 // NewExtraSettings creates a builder for ExtraSettings.
@@ -46,6 +61,9 @@ type extraSettings struct {
 	headers     map[string]string
 	compression bool
 	proxy       *string
+
+	rateLimit             uint
+	maxConcurrentRequests uint
 }
 
 func (e *extraSettings) ExtraHeaders() map[string]string {
@@ -60,6 +78,14 @@ func (e *extraSettings) Proxy() *string {
 	return e.proxy
 }
 
+func (e *extraSettings) RateLimit() uint {
+	return e.rateLimit
+}
+
+func (e *extraSettings) MaxConcurrentRequests() uint {
+	return e.maxConcurrentRequests
+}
+
 func (e *extraSettings) WithExtraHeaders(m map[string]string) ExtraSettingsBuilder {
 	e.headers = m
 	return e
@@ -75,6 +101,16 @@ func (e *extraSettings) WithProxy(addr string) ExtraSettingsBuilder {
 	return e
 }
 
+func (e *extraSettings) WithRateLimit(requestsPerSecond uint) ExtraSettingsBuilder {
+	e.rateLimit = requestsPerSecond
+	return e
+}
+
+func (e *extraSettings) WithMaxConcurrentRequests(maxConcurrentRequests uint) ExtraSettingsBuilder {
+	e.maxConcurrentRequests = maxConcurrentRequests
+	return e
+}
+
 // New creates a new copy of the enhanced oVirt client. It accepts the following options:
 //
 //	url
@@ -88,7 +124,8 @@ func (e *extraSettings) WithProxy(addr string) ExtraSettingsBuilder {
 //
 //	password
 //
-// This is the password for the oVirt engine. Other authentication mechanisms are not supported.
+// This is the password for the oVirt engine. To use other authentication mechanisms, such as a pre-issued access
+// token or Kerberos SSO, call NewWithAuthentication instead.
 //
 //	tls
 //
@@ -101,7 +138,8 @@ func (e *extraSettings) WithProxy(addr string) ExtraSettingsBuilder {
 //	extraSettings
 //
 // This is an implementation of the ExtraSettings interface, allowing for customization of headers and turning on
-// compression.
+// compression. Implementations of ExtraSettingsV2 can also limit the request rate and the number of concurrent
+// requests. Use NewExtraSettings to create one.
 //
 // # TLS
 //
@@ -159,12 +197,32 @@ func NewWithVerify(
 	logger Logger,
 	extraSettings ExtraSettings,
 	verify func(connection Client) error,
+) (ClientWithLegacySupport, error) {
+	return NewWithAuthentication(u, PasswordAuth(username, password), tls, logger, extraSettings, verify)
+}
+
+// NewWithAuthentication is equivalent to NewWithVerify, but takes an AuthenticationProvider instead of a username and
+// password. This allows for connecting with a pre-issued access token (AccessTokenAuth), a token provider callback
+// (TokenProviderAuth) or Kerberos/Negotiate SSO (NegotiateAuth). The provider is asked for a new access token on every
+// reconnect, including the automatic reconnects when the engine reports an expired token.
+func NewWithAuthentication(
+	u string,
+	auth AuthenticationProvider,
+	tls TLSProvider,
+	logger Logger,
+	extraSettings ExtraSettings,
+	verify func(connection Client) error,
 ) (ClientWithLegacySupport, error) {
 	if err := validateURL(u); err != nil {
 		return nil, wrap(err, EBadArgument, "invalid URL: %s", u)
 	}
-	if err := validateUsername(username); err != nil {
-		return nil, wrap(err, EBadArgument, "invalid username: %s", username)
+	if auth == nil {
+		return nil, newError(EBadArgument, "no authentication provider passed")
+	}
+	if passwordAuth, ok := auth.(*passwordAuthenticationProvider); ok {
+		if err := validateUsername(passwordAuth.username); err != nil {
+			return nil, wrap(err, EBadArgument, "invalid username: %s", passwordAuth.username)
+		}
 	}
 	tlsConfig, err := tls.CreateTLSConfig()
 	if err != nil {
@@ -175,10 +233,14 @@ func NewWithVerify(
 	if err != nil {
 		return nil, err
 	}
+	limiter := newRequestLimiter(extraSettings)
 	httpClient := http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: tlsConfig,
-			Proxy:           proxyFunc,
+		Transport: &limitingTransport{
+			next: &http.Transport{
+				TLSClientConfig: tlsConfig,
+				Proxy:           proxyFunc,
+			},
+			limiter: limiter,
 		},
 	}
 
@@ -189,12 +251,14 @@ func NewWithVerify(
 		httpClient,
 		logger,
 		u,
-		username,
-		password,
+		auth,
 		tlsConfig,
 		extraSettings,
 		rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
 		verify,
+		limiter,
+		new(uint64),
+		false,
 	}
 
 	if err := client.Reconnect(); err != nil {
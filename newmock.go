@@ -1,6 +1,7 @@
 package ovirtclient
 
 import (
+	"fmt"
 	"math/rand"
 	"net"
 	"sync"
@@ -19,13 +20,15 @@ func NewMock() MockClient {
 
 // NewMockWithLogger is identical to NewMock, but accepts a logger.
 func NewMockWithLogger(logger Logger) MockClient {
-	testCluster := generateTestCluster()
+	testDatacenter := generateTestDatacenter()
+	testCluster := generateTestCluster(testDatacenter)
 	testHost := generateTestHost(testCluster)
+	secondaryHost := generateTestHost(testCluster)
 	testStorageDomain := generateTestStorageDomain()
 	secondaryStorageDomain := generateTestStorageDomain()
-	testDatacenter := generateTestDatacenter(testCluster)
 	testNetwork := generateTestNetwork(testDatacenter)
-	testVNICProfile := generateTestVNICProfile(testNetwork)
+	testNetworkFilters := generateTestNetworkFilters()
+	testVNICProfile := generateTestVNICProfile(testNetwork, testNetworkFilters)
 	blankTemplate := &template{
 		nil,
 		DefaultBlankTemplateID,
@@ -40,6 +43,10 @@ func NewMockWithLogger(logger Logger) MockClient {
 			},
 			nil,
 		},
+		1073741824,
+		1,
+		"",
+		DefaultBlankTemplateID,
 	}
 
 	client := getClient(
@@ -48,17 +55,22 @@ func NewMockWithLogger(logger Logger) MockClient {
 		secondaryStorageDomain,
 		testCluster,
 		testHost,
+		secondaryHost,
 		blankTemplate,
 		testVNICProfile,
 		testNetwork,
 		testDatacenter,
+		testNetworkFilters,
 	)
 
 	testCluster.client = client
 	testHost.client = client
+	secondaryHost.client = client
 	blankTemplate.client = client
 	testStorageDomain.client = client
+	testStorageDomain.datacenterIDs = []DatacenterID{testDatacenter.ID()}
 	secondaryStorageDomain.client = client
+	secondaryStorageDomain.datacenterIDs = []DatacenterID{testDatacenter.ID()}
 	testDatacenter.client = client
 	testNetwork.client = client
 	testVNICProfile.client = client
@@ -72,19 +84,23 @@ func getClient(
 	secondaryStorageDomain *storageDomain,
 	testCluster *cluster,
 	testHost *host,
+	secondaryHost *host,
 	blankTemplate *template,
 	testVNICProfile *vnicProfile,
 	testNetwork *network,
 	testDatacenter *datacenterWithClusters,
+	testNetworkFilters map[NetworkFilterID]*networkFilter,
 ) *mockClient {
 	client := &mockClient{
-		ctx:             nil,
-		logger:          logger,
-		url:             "https://localhost/ovirt-engine/api",
-		lock:            &sync.Mutex{},
-		vms:             map[VMID]*vm{},
-		tags:            map[TagID]*tag{},
-		nonSecureRandom: rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
+		ctx:              nil,
+		logger:           logger,
+		url:              "https://localhost/ovirt-engine/api",
+		lock:             &sync.Mutex{},
+		vms:              map[VMID]*vm{},
+		tags:             map[TagID]*tag{},
+		tagIDsByHost:     map[HostID][]TagID{},
+		tagIDsByTemplate: map[TemplateID][]TagID{},
+		nonSecureRandom:  rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
 		storageDomains: map[StorageDomainID]*storageDomain{
 			testStorageDomain.ID():      testStorageDomain,
 			secondaryStorageDomain.ID(): secondaryStorageDomain,
@@ -94,7 +110,8 @@ func getClient(
 			testCluster.ID(): testCluster,
 		},
 		hosts: map[HostID]*host{
-			testHost.ID(): testHost,
+			testHost.ID():      testHost,
+			secondaryHost.ID(): secondaryHost,
 		},
 		templates: map[TemplateID]*template{
 			blankTemplate.ID(): blankTemplate,
@@ -110,7 +127,7 @@ func getClient(
 			testDatacenter.ID(): testDatacenter,
 		},
 		vmDiskAttachmentsByVM:   map[VMID]map[DiskAttachmentID]*diskAttachment{},
-		vmDiskAttachmentsByDisk: map[DiskID]*diskAttachment{},
+		vmDiskAttachmentsByDisk: map[DiskID]map[DiskAttachmentID]*diskAttachment{},
 		templateDiskAttachmentsByTemplate: map[TemplateID][]*templateDiskAttachment{
 			blankTemplate.ID(): {},
 		},
@@ -120,68 +137,137 @@ func getClient(
 		},
 		vmIPs:                map[VMID]map[string][]net.IP{},
 		instanceTypes:        nil,
-		graphicsConsolesByVM: map[VMID][]*vmGraphicsConsole{},
+		graphicsConsolesByVM: map[VMID][]*mockGraphicsConsole{},
+		snapshotsByVM:        map[VMID][]*snapshotWithData{},
+		vmNextRunParams:      map[VMID][]UpdateVMParameters{},
+		imageTransfers:       map[ImageTransferID]*mockImageTransfer{},
+		backupsByVM:          map[VMID][]*backupWithData{},
+		checkpointsByVM:      map[VMID][]*checkpointWithData{},
+		events:               newMockEventLog(),
+		hostNICs: map[HostID][]*hostNIC{
+			testHost.ID():      generateTestHostNICs(testHost, testNetwork),
+			secondaryHost.ID(): generateTestHostNICs(secondaryHost, testNetwork),
+		},
+		clusterNetworks: map[ClusterID]map[NetworkID]bool{
+			testCluster.ID(): {
+				testNetwork.ID(): true,
+			},
+		},
+		networkQoS:     map[NetworkQoSID]*networkQoS{},
+		networkFilters: testNetworkFilters,
+		diskProfiles:   map[DiskProfileID]*diskProfile{},
+		luns:           generateTestLUNs(),
 	}
 	client.instanceTypes = getInstanceTypes(client)
+	client.addDefaultDiskProfile(testStorageDomain)
+	client.addDefaultDiskProfile(secondaryStorageDomain)
 	return client
 }
 
+// getInstanceTypes returns the instance types the engine creates on installation.
 func getInstanceTypes(client *mockClient) map[InstanceTypeID]*instanceType {
-	instanceTypes := map[InstanceTypeID]*instanceType{
-		"00000009-0009-0009-0009-0000000000f1": {
-			client,
-			"00000009-0009-0009-0009-0000000000f1",
-			"Large",
-		},
-		"00000007-0007-0007-0007-00000000010a": {
-			client,
-			"00000007-0007-0007-0007-00000000010a",
-			"Medium",
-		},
-		"00000005-0005-0005-0005-0000000002e6": {
-			client,
-			"00000005-0005-0005-0005-0000000002e6",
-			"Small",
-		},
-		"00000003-0003-0003-0003-0000000000be": {
-			client,
-			"00000003-0003-0003-0003-0000000000be",
-			"Tiny",
-		},
-		"0000000b-000b-000b-000b-00000000021f": {
-			client,
-			"0000000b-000b-000b-000b-00000000021f",
-			"XLarge",
-		},
+	instanceTypes := map[InstanceTypeID]*instanceType{}
+	for _, it := range []*instanceType{
+		newMockInstanceType(client, "00000003-0003-0003-0003-0000000000be", "Tiny", 512, 1),
+		newMockInstanceType(client, "00000005-0005-0005-0005-0000000002e6", "Small", 2048, 1),
+		newMockInstanceType(client, "00000007-0007-0007-0007-00000000010a", "Medium", 4096, 2),
+		newMockInstanceType(client, "00000009-0009-0009-0009-0000000000f1", "Large", 8192, 2),
+		newMockInstanceType(client, "0000000b-000b-000b-000b-00000000021f", "XLarge", 16384, 4),
+	} {
+		instanceTypes[it.id] = it
 	}
 	return instanceTypes
 }
 
-func generateTestVNICProfile(testNetwork *network) *vnicProfile {
-	return &vnicProfile{
-		id:        VNICProfileID(uuid.NewString()),
-		name:      "test",
-		networkID: testNetwork.ID(),
+func generateTestVNICProfile(testNetwork *network, networkFilters map[NetworkFilterID]*networkFilter) *vnicProfile {
+	result := &vnicProfile{
+		id:              VNICProfileID(uuid.NewString()),
+		name:            "test",
+		networkID:       testNetwork.ID(),
+		passThroughMode: VNICProfilePassThroughModeDisabled,
+	}
+	for _, filter := range networkFilters {
+		if filter.name == defaultNetworkFilterName {
+			filterID := filter.id
+			result.networkFilterID = &filterID
+		}
+	}
+	return result
+}
+
+func generateTestNetworkFilters() map[NetworkFilterID]*networkFilter {
+	result := map[NetworkFilterID]*networkFilter{}
+	for _, name := range []string{
+		defaultNetworkFilterName,
+		"clean-traffic",
+		"clean-traffic-gateway",
+		"no-arp-spoofing",
+		"no-mac-spoofing",
+		"allow-dhcp",
+	} {
+		filter := &networkFilter{
+			id:   NetworkFilterID(uuid.NewString()),
+			name: name,
+		}
+		result[filter.id] = filter
+	}
+	return result
+}
+
+// generateTestLUNs creates the LUN inventory visible to all hosts in the mock: two LUNs on an iSCSI target and one
+// Fibre Channel LUN.
+func generateTestLUNs() []*lun {
+	return []*lun{
+		{
+			id:          "36001405b4d0c2a1f6e34e0f9d7a1c001",
+			storageType: StorageDomainTypeISCSI,
+			address:     "192.0.2.10",
+			port:        3260,
+			target:      "iqn.2022-04.org.ovirt.mock:storage",
+			size:        10 * 1024 * 1024 * 1024,
+			vendorID:    "LIO-ORG",
+			productID:   "mock-iscsi",
+			serial:      "SLIO-ORG_mock-iscsi_b4d0c2a1",
+		},
+		{
+			id:          "36001405b4d0c2a1f6e34e0f9d7a1c002",
+			storageType: StorageDomainTypeISCSI,
+			address:     "192.0.2.10",
+			port:        3260,
+			target:      "iqn.2022-04.org.ovirt.mock:storage",
+			size:        20 * 1024 * 1024 * 1024,
+			vendorID:    "LIO-ORG",
+			productID:   "mock-iscsi",
+			serial:      "SLIO-ORG_mock-iscsi_b4d0c2a2",
+		},
+		{
+			id:          "3600a098038304437415d4b6a59682f44",
+			storageType: StorageDomainTypeFCP,
+			size:        50 * 1024 * 1024 * 1024,
+			vendorID:    "NETAPP",
+			productID:   "LUN C-Mode",
+			serial:      "SNETAPP_LUN_C-Mode_80D7AdZh",
+		},
 	}
 }
 
 func generateTestNetwork(testDatacenter *datacenterWithClusters) *network {
 	return &network{
-		id:   NetworkID(uuid.NewString()),
-		name: "test",
-		dcID: testDatacenter.ID(),
+		id:        NetworkID(uuid.NewString()),
+		name:      "test",
+		dcID:      testDatacenter.ID(),
+		vmNetwork: true,
 	}
 }
 
-func generateTestDatacenter(testCluster *cluster) *datacenterWithClusters {
+func generateTestDatacenter() *datacenterWithClusters {
 	return &datacenterWithClusters{
 		datacenter: datacenter{
-			id:   DatacenterID(uuid.NewString()),
-			name: "test",
-		},
-		clusters: []ClusterID{
-			testCluster.ID(),
+			id:                   DatacenterID(uuid.NewString()),
+			name:                 "test",
+			compatibilityVersion: NewClusterVersion(4, 7),
 		},
+		clusters: []ClusterID{},
 	}
 }
 
@@ -196,17 +282,64 @@ func generateTestStorageDomain() *storageDomain {
 	}
 }
 
-func generateTestCluster() *cluster {
-	return &cluster{
-		id:   ClusterID(uuid.NewString()),
-		name: "Test cluster",
+// generateTestCluster creates a cluster with the defaults the engine uses for new clusters and adds it to the
+// datacenter.
+func generateTestCluster(dc *datacenterWithClusters) *cluster {
+	result := &cluster{
+		id:                      ClusterID(uuid.NewString()),
+		name:                    "Test cluster",
+		description:             "Test cluster",
+		datacenterID:            dc.ID(),
+		cpuType:                 "Intel Cascadelake Server Family",
+		compatibilityVersion:    NewClusterVersion(4, 7),
+		memoryOvercommitPercent: 100,
+		schedulingPolicyID:      mockDefaultSchedulingPolicyID,
+		virtService:             true,
+		glusterService:          false,
 	}
+	dc.clusters = append(dc.clusters, result.id)
+	return result
+}
+
+// mockDefaultSchedulingPolicyID is the ID of the built-in "none" scheduling policy of the engine, which is the default
+// for new clusters.
+const mockDefaultSchedulingPolicyID SchedulingPolicyID = "b4ed2332-a7ac-4d5f-9596-99a439cb2812"
+
+// generateTestHostNICs creates four 10 Gbit network interfaces for the host. The network is attached to eth0 with a
+// static IP address.
+func generateTestHostNICs(h *host, testNetwork *network) []*hostNIC {
+	result := make([]*hostNIC, 4)
+	for i := range result {
+		result[i] = &hostNIC{
+			id:           HostNICID(uuid.NewString()),
+			hostID:       h.ID(),
+			name:         fmt.Sprintf("eth%d", i),
+			mac:          fmt.Sprintf("56:6f:%s:%s:%s:%02x", h.id[0:2], h.id[2:4], h.id[4:6], i),
+			speed:        10 * 1000 * 1000 * 1000,
+			mtu:          1500,
+			bootProtocol: HostNICBootProtocolNone,
+		}
+	}
+	networkID := testNetwork.ID()
+	result[0].networkID = &networkID
+	result[0].bootProtocol = HostNICBootProtocolStatic
+	result[0].ipv4Address = net.ParseIP("192.168.0.10")
+	return result
 }
 
 func generateTestHost(c *cluster) *host {
+	id := uuid.NewString()
 	return &host{
-		id:        HostID(uuid.NewString()),
+		id:        HostID(id),
+		name:      fmt.Sprintf("host-%s", id[:8]),
+		address:   fmt.Sprintf("host-%s.example.com", id[:8]),
 		clusterID: c.ID(),
 		status:    HostStatusUp,
+		cpuTopo: &hostCPUTopo{
+			cores:   4,
+			threads: 2,
+			sockets: 1,
+		},
+		memory: 16 * 1024 * 1024 * 1024,
 	}
 }
@@ -1,6 +1,9 @@
 package ovirtclient
 
 import (
+	"net"
+	"strings"
+
 	ovirtsdk "github.com/ovirt/go-ovirt"
 )
 
@@ -30,12 +33,92 @@ type NICClient interface {
 	ListNICs(vmid VMID, retries ...RetryStrategy) ([]NIC, error)
 	// RemoveNIC removes the network interface specified.
 	RemoveNIC(vmid VMID, id NICID, retries ...RetryStrategy) error
+	// HotplugNIC plugs the NIC into the VM. If the VM is running, the NIC is hot plugged and an error with the
+	// EHotPlugFailed code is returned if the guest could not attach the device.
+	HotplugNIC(vmid VMID, id NICID, retries ...RetryStrategy) error
+	// HotunplugNIC unplugs the NIC from the VM without removing it. If the VM is running, the NIC is hot unplugged
+	// and an error with the EHotPlugFailed code is returned if the guest could not detach the device.
+	HotunplugNIC(vmid VMID, id NICID, retries ...RetryStrategy) error
+}
+
+// NICInterface is the device model of the network interface presented to the guest.
+type NICInterface string
+
+const (
+	// NICInterfaceVirtIO is the paravirtualized VirtIO network device. This is the default.
+	NICInterfaceVirtIO NICInterface = "virtio"
+	// NICInterfaceE1000 emulates an Intel E1000 network card.
+	NICInterfaceE1000 NICInterface = "e1000"
+	// NICInterfaceRTL8139 emulates a Realtek RTL8139 network card.
+	NICInterfaceRTL8139 NICInterface = "rtl8139"
+	// NICInterfaceRTL8139VirtIO is a dual mode Realtek RTL8139 and VirtIO network card.
+	NICInterfaceRTL8139VirtIO NICInterface = "rtl8139_virtio"
+	// NICInterfaceSPAPRVLAN is the virtual network device of IBM POWER guests.
+	NICInterfaceSPAPRVLAN NICInterface = "spapr_vlan"
+	// NICInterfacePCIPassthrough passes an SR-IOV virtual function to the guest. The NIC must use a VNIC profile in
+	// pass-through mode.
+	NICInterfacePCIPassthrough NICInterface = "pci_passthrough"
+)
+
+// NICInterfaceList is a list of NICInterface.
+type NICInterfaceList []NICInterface
+
+// NICInterfaceValues returns all possible NICInterface values.
+func NICInterfaceValues() NICInterfaceList {
+	return []NICInterface{
+		NICInterfaceVirtIO,
+		NICInterfaceE1000,
+		NICInterfaceRTL8139,
+		NICInterfaceRTL8139VirtIO,
+		NICInterfaceSPAPRVLAN,
+		NICInterfacePCIPassthrough,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l NICInterfaceList) Strings() []string {
+	result := make([]string, len(l))
+	for i, iface := range l {
+		result[i] = string(iface)
+	}
+	return result
+}
+
+// Validate returns an error if the NIC interface doesn't have a valid value.
+func (i NICInterface) Validate() error {
+	for _, iface := range NICInterfaceValues() {
+		if iface == i {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid NIC interface: %s must be one of: %s",
+		i,
+		strings.Join(NICInterfaceValues().Strings(), ", "),
+	)
+}
+
+// NICReportedDevice is a network device as reported by the guest agent running in the VM.
+type NICReportedDevice interface {
+	// Name returns the name of the device in the guest operating system, for example eth0.
+	Name() string
+	// Mac returns the MAC address of the device as seen by the guest.
+	Mac() string
+	// IPs returns the IP addresses the guest has configured on the device.
+	IPs() []net.IP
 }
 
 // OptionalNICParameters is an interface that declares the source of optional parameters for NIC creation.
 type OptionalNICParameters interface {
 	// represent mac_address for NIC
 	Mac() string
+	// Interface returns the device model of the NIC. If nil, VirtIO is used.
+	Interface() *NICInterface
+	// Plugged returns if the NIC is plugged into the VM. If nil, the NIC is plugged.
+	Plugged() *bool
+	// Linked returns if the link of the NIC is up. If nil, the link is up.
+	Linked() *bool
 }
 
 // BuildableNICParameters is a modifiable version of OptionalNICParameters. You can use CreateNICParams() to create a
@@ -48,6 +131,21 @@ type BuildableNICParameters interface {
 
 	// MustWithMac is the same as WithMac, but panics instead of returning an error.
 	MustWithMac(mac string) BuildableNICParameters
+
+	// WithInterface sets the device model of the NIC.
+	WithInterface(iface NICInterface) (BuildableNICParameters, error)
+	// MustWithInterface is the same as WithInterface, but panics instead of returning an error.
+	MustWithInterface(iface NICInterface) BuildableNICParameters
+
+	// WithPlugged sets if the NIC is plugged into the VM.
+	WithPlugged(plugged bool) (BuildableNICParameters, error)
+	// MustWithPlugged is the same as WithPlugged, but panics instead of returning an error.
+	MustWithPlugged(plugged bool) BuildableNICParameters
+
+	// WithLinked sets if the link of the NIC is up.
+	WithLinked(linked bool) (BuildableNICParameters, error)
+	// MustWithLinked is the same as WithLinked, but panics instead of returning an error.
+	MustWithLinked(linked bool) BuildableNICParameters
 }
 
 // CreateNICParams returns a buildable structure of OptionalNICParameters.
@@ -56,13 +154,28 @@ func CreateNICParams() BuildableNICParameters {
 }
 
 type nicParams struct {
-	mac string
+	mac     string
+	iface   *NICInterface
+	plugged *bool
+	linked  *bool
 }
 
 func (c *nicParams) Mac() string {
 	return c.mac
 }
 
+func (c *nicParams) Interface() *NICInterface {
+	return c.iface
+}
+
+func (c *nicParams) Plugged() *bool {
+	return c.plugged
+}
+
+func (c *nicParams) Linked() *bool {
+	return c.linked
+}
+
 func (c *nicParams) WithMac(mac string) (BuildableNICParameters, error) {
 	c.mac = mac
 	return c, nil
@@ -76,6 +189,48 @@ func (c *nicParams) MustWithMac(mac string) BuildableNICParameters {
 	return builder
 }
 
+func (c *nicParams) WithInterface(iface NICInterface) (BuildableNICParameters, error) {
+	if err := iface.Validate(); err != nil {
+		return nil, err
+	}
+	c.iface = &iface
+	return c, nil
+}
+
+func (c *nicParams) MustWithInterface(iface NICInterface) BuildableNICParameters {
+	builder, err := c.WithInterface(iface)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *nicParams) WithPlugged(plugged bool) (BuildableNICParameters, error) {
+	c.plugged = &plugged
+	return c, nil
+}
+
+func (c *nicParams) MustWithPlugged(plugged bool) BuildableNICParameters {
+	builder, err := c.WithPlugged(plugged)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *nicParams) WithLinked(linked bool) (BuildableNICParameters, error) {
+	c.linked = &linked
+	return c, nil
+}
+
+func (c *nicParams) MustWithLinked(linked bool) BuildableNICParameters {
+	builder, err := c.WithLinked(linked)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
 // UpdateNICParameters is an interface that declares methods of changeable parameters for NIC's. Each
 // method can return nil to leave an attribute unchanged, or a new value for the attribute.
 type UpdateNICParameters interface {
@@ -87,6 +242,16 @@ type UpdateNICParameters interface {
 
 	// Mac potentially returns a change MacAddress for a nic
 	Mac() *string
+
+	// Interface potentially returns a changed device model for a NIC.
+	Interface() *NICInterface
+
+	// Plugged potentially returns a changed plugged state for a NIC. Use HotplugNIC and HotunplugNIC to change the
+	// plugged state of a NIC on a running VM.
+	Plugged() *bool
+
+	// Linked potentially returns a changed link state for a NIC.
+	Linked() *bool
 }
 
 // BuildableUpdateNICParameters is a buildable version of UpdateNICParameters.
@@ -107,6 +272,21 @@ type BuildableUpdateNICParameters interface {
 	WithMac(mac string) (BuildableUpdateNICParameters, error)
 	// MustWithMac is identical to WithMac, but panics instead of returning an error.
 	MustWithMac(mac string) BuildableUpdateNICParameters
+
+	// WithInterface sets the device model of a NIC for the UpdateNIC method.
+	WithInterface(iface NICInterface) (BuildableUpdateNICParameters, error)
+	// MustWithInterface is identical to WithInterface, but panics instead of returning an error.
+	MustWithInterface(iface NICInterface) BuildableUpdateNICParameters
+
+	// WithPlugged sets the plugged state of a NIC for the UpdateNIC method.
+	WithPlugged(plugged bool) (BuildableUpdateNICParameters, error)
+	// MustWithPlugged is identical to WithPlugged, but panics instead of returning an error.
+	MustWithPlugged(plugged bool) BuildableUpdateNICParameters
+
+	// WithLinked sets the link state of a NIC for the UpdateNIC method.
+	WithLinked(linked bool) (BuildableUpdateNICParameters, error)
+	// MustWithLinked is identical to WithLinked, but panics instead of returning an error.
+	MustWithLinked(linked bool) BuildableUpdateNICParameters
 }
 
 // UpdateNICParams creates a buildable UpdateNICParameters.
@@ -118,6 +298,9 @@ type updateNICParams struct {
 	name          *string
 	vnicProfileID *VNICProfileID
 	mac           *string
+	iface         *NICInterface
+	plugged       *bool
+	linked        *bool
 }
 
 func (u *updateNICParams) Name() *string {
@@ -132,6 +315,18 @@ func (u *updateNICParams) Mac() *string {
 	return u.mac
 }
 
+func (u *updateNICParams) Interface() *NICInterface {
+	return u.iface
+}
+
+func (u *updateNICParams) Plugged() *bool {
+	return u.plugged
+}
+
+func (u *updateNICParams) Linked() *bool {
+	return u.linked
+}
+
 func (u *updateNICParams) WithName(name string) (BuildableUpdateNICParameters, error) {
 	u.name = &name
 	return u, nil
@@ -171,6 +366,48 @@ func (u *updateNICParams) MustWithMac(mac string) BuildableUpdateNICParameters {
 	return b
 }
 
+func (u *updateNICParams) WithInterface(iface NICInterface) (BuildableUpdateNICParameters, error) {
+	if err := iface.Validate(); err != nil {
+		return nil, err
+	}
+	u.iface = &iface
+	return u, nil
+}
+
+func (u *updateNICParams) MustWithInterface(iface NICInterface) BuildableUpdateNICParameters {
+	b, err := u.WithInterface(iface)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func (u *updateNICParams) WithPlugged(plugged bool) (BuildableUpdateNICParameters, error) {
+	u.plugged = &plugged
+	return u, nil
+}
+
+func (u *updateNICParams) MustWithPlugged(plugged bool) BuildableUpdateNICParameters {
+	b, err := u.WithPlugged(plugged)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func (u *updateNICParams) WithLinked(linked bool) (BuildableUpdateNICParameters, error) {
+	u.linked = &linked
+	return u, nil
+}
+
+func (u *updateNICParams) MustWithLinked(linked bool) BuildableUpdateNICParameters {
+	b, err := u.WithLinked(linked)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
 // NICData is the core of NIC which only provides data-access functions.
 type NICData interface {
 	// ID is the identifier for this network interface.
@@ -183,6 +420,16 @@ type NICData interface {
 	VNICProfileID() VNICProfileID
 	// Mac returns a MacAddress for a nic
 	Mac() string
+	// Interface returns the device model of the NIC.
+	Interface() NICInterface
+	// Plugged returns true if the NIC is plugged into the VM.
+	Plugged() bool
+	// Linked returns true if the link of the NIC is up.
+	Linked() bool
+	// ReportedDevices returns the network devices the guest agent reports for this NIC. The list is empty if the VM
+	// is not running or has no guest agent. The IPs of the devices can be used to correlate the addresses returned
+	// by GetVMIPAddresses with the NIC.
+	ReportedDevices() []NICReportedDevice
 }
 
 // NIC represents a network interface.
@@ -199,6 +446,10 @@ type NIC interface {
 	Update(params UpdateNICParameters, retries ...RetryStrategy) (NIC, error)
 	// Remove removes the current network interface. This involves an API call and may be slow.
 	Remove(retries ...RetryStrategy) error
+	// Hotplug plugs the NIC into the VM. See HotplugNIC for details.
+	Hotplug(retries ...RetryStrategy) error
+	// Hotunplug unplugs the NIC from the VM. See HotunplugNIC for details.
+	Hotunplug(retries ...RetryStrategy) error
 }
 
 func convertSDKNIC(sdkObject *ovirtsdk.Nic, cli Client) (NIC, error) {
@@ -234,24 +485,76 @@ func convertSDKNIC(sdkObject *ovirtsdk.Nic, cli Client) (NIC, error) {
 	if !ok {
 		return nil, newFieldNotFound("address", "mac")
 	}
-	return &nic{
-		cli,
-		NICID(id),
-		name,
-		VMID(vmid),
-		VNICProfileID(vnicProfileID),
-		macAddr,
-	}, nil
+	result := &nic{
+		client:        cli,
+		id:            NICID(id),
+		name:          name,
+		vmid:          VMID(vmid),
+		vnicProfileID: VNICProfileID(vnicProfileID),
+		mac:           macAddr,
+		iface:         NICInterfaceVirtIO,
+	}
+	if iface, ok := sdkObject.Interface(); ok {
+		result.iface = NICInterface(iface)
+	}
+	result.plugged, _ = sdkObject.Plugged()
+	result.linked, _ = sdkObject.Linked()
+	if sdkDevices, ok := sdkObject.ReportedDevices(); ok {
+		for _, sdkDevice := range sdkDevices.Slice() {
+			result.reportedDevices = append(result.reportedDevices, convertSDKNICReportedDevice(sdkDevice))
+		}
+	}
+	return result, nil
+}
+
+func convertSDKNICReportedDevice(sdkObject *ovirtsdk.ReportedDevice) *nicReportedDevice {
+	result := &nicReportedDevice{}
+	result.name, _ = sdkObject.Name()
+	if sdkMAC, ok := sdkObject.Mac(); ok {
+		result.mac, _ = sdkMAC.Address()
+	}
+	if sdkIPs, ok := sdkObject.Ips(); ok {
+		for _, sdkIP := range sdkIPs.Slice() {
+			if address, ok := sdkIP.Address(); ok {
+				if ip := net.ParseIP(address); ip != nil {
+					result.ips = append(result.ips, ip)
+				}
+			}
+		}
+	}
+	return result
+}
+
+type nicReportedDevice struct {
+	name string
+	mac  string
+	ips  []net.IP
+}
+
+func (n *nicReportedDevice) Name() string {
+	return n.name
+}
+
+func (n *nicReportedDevice) Mac() string {
+	return n.mac
+}
+
+func (n *nicReportedDevice) IPs() []net.IP {
+	return n.ips
 }
 
 type nic struct {
 	client Client
 
-	id            NICID
-	name          string
-	vmid          VMID
-	vnicProfileID VNICProfileID
-	mac           string
+	id              NICID
+	name            string
+	vmid            VMID
+	vnicProfileID   VNICProfileID
+	mac             string
+	iface           NICInterface
+	plugged         bool
+	linked          bool
+	reportedDevices []*nicReportedDevice
 }
 
 func (n nic) Update(params UpdateNICParameters, retries ...RetryStrategy) (NIC, error) {
@@ -286,39 +589,72 @@ func (n nic) Mac() string {
 	return n.mac
 }
 
+func (n nic) Interface() NICInterface {
+	return n.iface
+}
+
+func (n nic) Plugged() bool {
+	return n.plugged
+}
+
+func (n nic) Linked() bool {
+	return n.linked
+}
+
+func (n nic) ReportedDevices() []NICReportedDevice {
+	result := make([]NICReportedDevice, len(n.reportedDevices))
+	for i, device := range n.reportedDevices {
+		result[i] = device
+	}
+	return result
+}
+
 func (n nic) Remove(retries ...RetryStrategy) error {
 	return n.client.RemoveNIC(n.vmid, n.id, retries...)
 }
 
+func (n nic) Hotplug(retries ...RetryStrategy) error {
+	return n.client.HotplugNIC(n.vmid, n.id, retries...)
+}
+
+func (n nic) Hotunplug(retries ...RetryStrategy) error {
+	return n.client.HotunplugNIC(n.vmid, n.id, retries...)
+}
+
 func (n nic) withName(name string) *nic {
-	return &nic{
-		client:        n.client,
-		id:            n.id,
-		name:          name,
-		vmid:          n.vmid,
-		vnicProfileID: n.vnicProfileID,
-		mac:           n.mac,
-	}
+	n.name = name
+	return &n
 }
 
 func (n nic) withVNICProfileID(vnicProfileID VNICProfileID) *nic {
-	return &nic{
-		client:        n.client,
-		id:            n.id,
-		name:          n.name,
-		vmid:          n.vmid,
-		vnicProfileID: vnicProfileID,
-		mac:           n.mac,
-	}
+	n.vnicProfileID = vnicProfileID
+	return &n
 }
 
 func (n nic) withMac(mac string) *nic {
-	return &nic{
-		client:        n.client,
-		id:            n.id,
-		name:          n.name,
-		vmid:          n.vmid,
-		vnicProfileID: n.vnicProfileID,
-		mac:           mac,
+	n.mac = mac
+	return &n
+}
+
+func (n nic) withInterface(iface NICInterface) *nic {
+	n.iface = iface
+	return &n
+}
+
+func (n nic) withPlugged(plugged bool) *nic {
+	n.plugged = plugged
+	if !plugged {
+		n.reportedDevices = nil
 	}
+	return &n
+}
+
+func (n nic) withLinked(linked bool) *nic {
+	n.linked = linked
+	return &n
+}
+
+func (n nic) withReportedDevices(reportedDevices []*nicReportedDevice) *nic {
+	n.reportedDevices = reportedDevices
+	return &n
 }
@@ -19,13 +19,13 @@ func (o *oVirtClient) CreateNIC(
 		return nil, err
 	}
 
-	var mac string
-	if params != nil {
-		if err := validateNICCreationOptionalParameters(params); err != nil {
-			return nil, err
-		}
-		mac = params.Mac()
+	if params == nil {
+		params = CreateNICParams()
+	}
+	if err := validateNICCreationOptionalParameters(params); err != nil {
+		return nil, err
 	}
+	mac := params.Mac()
 
 	retries = defaultRetries(retries, defaultReadTimeouts(o))
 	err = retry(
@@ -40,6 +40,15 @@ func (o *oVirtClient) CreateNIC(
 			if mac != "" {
 				nicBuilder.Mac(ovirtsdk.NewMacBuilder().Address(mac).MustBuild())
 			}
+			if iface := params.Interface(); iface != nil {
+				nicBuilder.Interface(ovirtsdk.NicInterface(*iface))
+			}
+			if plugged := params.Plugged(); plugged != nil {
+				nicBuilder.Plugged(*plugged)
+			}
+			if linked := params.Linked(); linked != nil {
+				nicBuilder.Linked(*linked)
+			}
 
 			nic := nicBuilder.MustBuild()
 
@@ -100,6 +109,9 @@ func (m *mockClient) CreateNIC(
 		name:          name,
 		vmid:          vmid,
 		vnicProfileID: vnicProfileID,
+		iface:         NICInterfaceVirtIO,
+		plugged:       true,
+		linked:        true,
 	}
 
 	if params != nil {
@@ -107,6 +119,18 @@ func (m *mockClient) CreateNIC(
 			return nil, err
 		}
 		nic.mac = params.Mac()
+		if iface := params.Interface(); iface != nil {
+			nic.iface = *iface
+		}
+		if plugged := params.Plugged(); plugged != nil {
+			nic.plugged = *plugged
+		}
+		if linked := params.Linked(); linked != nil {
+			nic.linked = *linked
+		}
+	}
+	if err := m.checkNICInterfaceMatchesProfile(nic.iface, nic.vnicProfileID); err != nil {
+		return nil, err
 	}
 
 	m.nics[id] = nic
@@ -130,5 +154,36 @@ func validateNICCreationOptionalParameters(params OptionalNICParameters) error {
 			return newError(EUnidentified, "Failed to parse MacAddress: %s", mac)
 		}
 	}
+	if iface := params.Interface(); iface != nil {
+		if err := iface.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkNICInterfaceMatchesProfile checks that only pci_passthrough NICs use VNIC profiles in pass-through mode. The
+// caller must hold the lock of the mock client.
+func (m *mockClient) checkNICInterfaceMatchesProfile(iface NICInterface, vnicProfileID VNICProfileID) error {
+	profile, ok := m.vnicProfiles[vnicProfileID]
+	if !ok {
+		return nil
+	}
+	passThrough := profile.passThroughMode == VNICProfilePassThroughModeEnabled
+	if passThrough && iface != NICInterfacePCIPassthrough {
+		return newError(
+			EBadArgument,
+			"VNIC profile %s is in pass-through mode and can only be used with the %s interface",
+			vnicProfileID,
+			NICInterfacePCIPassthrough,
+		)
+	}
+	if !passThrough && iface == NICInterfacePCIPassthrough {
+		return newError(
+			EBadArgument,
+			"the %s interface requires a VNIC profile in pass-through mode",
+			NICInterfacePCIPassthrough,
+		)
+	}
 	return nil
 }
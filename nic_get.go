@@ -11,7 +11,11 @@ func (o *oVirtClient) GetNIC(vmid VMID, id NICID, retries ...RetryStrategy) (res
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().VmsService().VmService(string(vmid)).NicsService().NicService(string(id)).Get().Send()
+			response, err := o.conn.SystemService().VmsService().VmService(string(vmid)).NicsService().
+				NicService(string(id)).
+				Get().
+				Follow("reported_devices").
+				Send()
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,66 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) HotplugNIC(vmid VMID, id NICID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("hot plugging NIC %s on VM %s", id, vmid),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().VmsService().VmService(string(vmid)).NicsService().
+				NicService(string(id)).
+				Activate().
+				Send()
+			return err
+		})
+	return
+}
+
+func (m *mockClient) HotplugNIC(vmid VMID, id NICID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("hot plugging NIC %s on VM %s", id, vmid),
+		m.logger,
+		retries,
+		func() error {
+			return m.setNICPlugged(vmid, id, true)
+		})
+}
+
+// setNICPlugged changes the plugged state of a mock NIC the way hot plugging and unplugging would.
+func (m *mockClient) setNICPlugged(vmid VMID, id NICID, plugged bool) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	item, ok := m.vms[vmid]
+	if !ok {
+		return newError(ENotFound, "VM with ID %s not found", vmid)
+	}
+	n, ok := m.nics[id]
+	if !ok || n.vmid != vmid {
+		return newError(ENotFound, "NIC with ID %s not found on VM with ID %s", id, vmid)
+	}
+	if n.plugged == plugged {
+		if plugged {
+			return newError(EConflict, "NIC %s is already plugged into VM %s", id, vmid)
+		}
+		return newError(EConflict, "NIC %s is already unplugged from VM %s", id, vmid)
+	}
+	switch item.status {
+	case VMStatusUp, VMStatusDown, VMStatusPaused:
+	default:
+		return newError(
+			EHotPlugFailed,
+			"cannot change the plugged state of NIC %s while VM %s is in status %s",
+			id,
+			vmid,
+			item.status,
+		)
+	}
+	m.nics[id] = n.withPlugged(plugged)
+	return nil
+}
@@ -0,0 +1,64 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMNICHotplug(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("nic_test_%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams(),
+	)
+	nic := assertCanCreateNIC(
+		t,
+		helper,
+		vm,
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateNICParams().
+			MustWithInterface(ovirtclient.NICInterfaceE1000).
+			MustWithPlugged(false).
+			MustWithLinked(false),
+	)
+	if nic.Interface() != ovirtclient.NICInterfaceE1000 {
+		t.Fatalf("Incorrect NIC interface (expected: %s, got: %s)", ovirtclient.NICInterfaceE1000, nic.Interface())
+	}
+	if nic.Plugged() || nic.Linked() {
+		t.Fatalf("The NIC was created plugged or linked.")
+	}
+
+	if err := nic.Hotplug(); err != nil {
+		t.Fatalf("Failed to hot plug NIC %s (%v)", nic.ID(), err)
+	}
+	nic = assertNICPlugged(t, vm, nic.ID(), true)
+	if err := nic.Hotunplug(); err != nil {
+		t.Fatalf("Failed to hot unplug NIC %s (%v)", nic.ID(), err)
+	}
+	nic = assertNICPlugged(t, vm, nic.ID(), false)
+
+	nic, err := nic.Update(ovirtclient.UpdateNICParams().MustWithLinked(true))
+	if err != nil {
+		t.Fatalf("Failed to update NIC %s (%v)", nic.ID(), err)
+	}
+	if !nic.Linked() {
+		t.Fatalf("The link of NIC %s is not up after the update.", nic.ID())
+	}
+}
+
+func assertNICPlugged(t *testing.T, vm ovirtclient.VM, id ovirtclient.NICID, plugged bool) ovirtclient.NIC {
+	nic, err := vm.GetNIC(id)
+	if err != nil {
+		t.Fatalf("Failed to fetch NIC %s (%v)", id, err)
+	}
+	if nic.Plugged() != plugged {
+		t.Fatalf("Incorrect plugged state of NIC %s (expected: %t, got: %t)", id, plugged, nic.Plugged())
+	}
+	return nic
+}
@@ -0,0 +1,32 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) HotunplugNIC(vmid VMID, id NICID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("hot unplugging NIC %s from VM %s", id, vmid),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().VmsService().VmService(string(vmid)).NicsService().
+				NicService(string(id)).
+				Deactivate().
+				Send()
+			return err
+		})
+	return
+}
+
+func (m *mockClient) HotunplugNIC(vmid VMID, id NICID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("hot unplugging NIC %s from VM %s", id, vmid),
+		m.logger,
+		retries,
+		func() error {
+			return m.setNICPlugged(vmid, id, false)
+		})
+}
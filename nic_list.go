@@ -11,7 +11,10 @@ func (o *oVirtClient) ListNICs(vmid VMID, retries ...RetryStrategy) (result []NI
 		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().VmsService().VmService(string(vmid)).NicsService().List().Send()
+			response, e := o.conn.SystemService().VmsService().VmService(string(vmid)).NicsService().
+				List().
+				Follow("reported_devices").
+				Send()
 			if e != nil {
 				return e
 			}
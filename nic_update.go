@@ -28,6 +28,18 @@ func (o *oVirtClient) UpdateNIC(
 		}
 		nicBuilder.Mac(ovirtsdk.NewMacBuilder().Address(*mac).MustBuild())
 	}
+	if iface := params.Interface(); iface != nil {
+		if err := iface.Validate(); err != nil {
+			return nil, err
+		}
+		nicBuilder.Interface(ovirtsdk.NicInterface(*iface))
+	}
+	if plugged := params.Plugged(); plugged != nil {
+		nicBuilder.Plugged(*plugged)
+	}
+	if linked := params.Linked(); linked != nil {
+		nicBuilder.Linked(*linked)
+	}
 
 	req.Nic(nicBuilder.MustBuild())
 
@@ -83,6 +95,24 @@ func (m *mockClient) UpdateNIC(vmid VMID, nicID NICID, params UpdateNICParameter
 		}
 		nic = nic.withMac(*mac)
 	}
+	if iface := params.Interface(); iface != nil {
+		if err := iface.Validate(); err != nil {
+			return nil, err
+		}
+		if vm, ok := m.vms[vmid]; ok && nic.plugged && *iface != nic.iface && vm.status != VMStatusDown {
+			return nil, newError(EConflict, "cannot change the interface of a plugged NIC on a running VM")
+		}
+		nic = nic.withInterface(*iface)
+	}
+	if err := m.checkNICInterfaceMatchesProfile(nic.iface, nic.vnicProfileID); err != nil {
+		return nil, err
+	}
+	if plugged := params.Plugged(); plugged != nil {
+		nic = nic.withPlugged(*plugged)
+	}
+	if linked := params.Linked(); linked != nil {
+		nic = nic.withLinked(*linked)
+	}
 	m.nics[nicID] = nic
 
 	return nic, nil
@@ -0,0 +1,184 @@
+package ovirtclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// requestLimiter limits the rate and the number of concurrent requests sent to the engine. It is shared by all
+// sub-clients created via WithContext, as well as between the SDK connection and the raw HTTP client.
+type requestLimiter struct {
+	lock     *sync.Mutex
+	interval time.Duration
+	next     time.Time
+	slots    chan struct{}
+}
+
+// newRequestLimiter creates a request limiter from the extra settings. The limits are only applied if the extra
+// settings implement ExtraSettingsV2.
+func newRequestLimiter(extraSettings ExtraSettings) *requestLimiter {
+	limiter := &requestLimiter{
+		lock: &sync.Mutex{},
+	}
+	settings, ok := extraSettings.(ExtraSettingsV2)
+	if !ok {
+		return limiter
+	}
+	if rateLimit := settings.RateLimit(); rateLimit > 0 {
+		limiter.interval = time.Second / time.Duration(rateLimit)
+	}
+	if maxConcurrentRequests := settings.MaxConcurrentRequests(); maxConcurrentRequests > 0 {
+		limiter.slots = make(chan struct{}, maxConcurrentRequests)
+	}
+	return limiter
+}
+
+// acquire waits until a request may be sent. If it returns without an error, release must be called once the
+// request is finished.
+func (r *requestLimiter) acquire(ctx context.Context) error {
+	if r.interval > 0 {
+		r.lock.Lock()
+		now := time.Now()
+		if r.next.Before(now) {
+			r.next = now
+		}
+		wait := r.next.Sub(now)
+		r.next = r.next.Add(r.interval)
+		r.lock.Unlock()
+		if wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			}
+		}
+	}
+	if r.slots != nil {
+		select {
+		case r.slots <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (r *requestLimiter) release() {
+	if r.slots != nil {
+		<-r.slots
+	}
+}
+
+// setSDKTransportLimiter applies the request limiter to the HTTP client the SDK connection has created for itself.
+func setSDKTransportLimiter(conn *ovirtsdk4.Connection, limiter *requestLimiter) error {
+	field, err := sdkConnectionField(conn, "client", reflect.TypeOf(&http.Client{}))
+	if err != nil {
+		return err
+	}
+	httpClient, ok := field.Interface().(*http.Client)
+	if !ok || httpClient == nil {
+		return newError(EBug, "the oVirt SDK connection has no HTTP client")
+	}
+	httpClient.Transport = &limitingTransport{
+		next:    httpClient.Transport,
+		limiter: limiter,
+	}
+	return nil
+}
+
+// limitingTransport applies the request limiter to all requests and turns 429 and 503 responses carrying a
+// Retry-After header into a retryAfterError, so the retry function can wait as long as the engine asked for.
+type limitingTransport struct {
+	next    http.RoundTripper
+	limiter *requestLimiter
+}
+
+func (l *limitingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := l.limiter.acquire(req.Context()); err != nil {
+		return nil, err
+	}
+	resp, err := l.next.RoundTrip(req)
+	if err != nil {
+		l.limiter.release()
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+			l.limiter.release()
+			return nil, &retryAfterError{
+				statusCode: resp.StatusCode,
+				wait:       wait,
+			}
+		}
+	}
+	// The request counts as in flight until the response body is closed, which matters for image transfers.
+	resp.Body = &limitedResponseBody{
+		ReadCloser: resp.Body,
+		release:    l.limiter.release,
+		once:       &sync.Once{},
+	}
+	return resp, nil
+}
+
+type limitedResponseBody struct {
+	io.ReadCloser
+
+	release func()
+	once    *sync.Once
+}
+
+func (l *limitedResponseBody) Close() error {
+	err := l.ReadCloser.Close()
+	l.once.Do(l.release)
+	return err
+}
+
+// retryAfterError indicates that the engine responded with HTTP 429 or 503 and asked to retry after a certain time.
+type retryAfterError struct {
+	statusCode int
+	wait       time.Duration
+}
+
+func (r *retryAfterError) Error() string {
+	return fmt.Sprintf("the engine responded with HTTP %d and asked to retry after %s", r.statusCode, r.wait)
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseUint(value, 10, 32); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if wait := date.Sub(now); wait > 0 {
+		return wait, true
+	}
+	return 0, true
+}
+
+// retryAfterDuration returns the wait time the engine requested, if the error was caused by a Retry-After response.
+func retryAfterDuration(err error) (time.Duration, bool) {
+	var retryAfterErr *retryAfterError
+	if errors.As(err, &retryAfterErr) {
+		return retryAfterErr.wait, true
+	}
+	return 0, false
+}
@@ -0,0 +1,164 @@
+package ovirtclient_test
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestMaxConcurrentRequestsIsSharedBetweenSubClients(t *testing.T) {
+	t.Parallel()
+	engine := newFakeThrottledEngine()
+	client := connectToFakeThrottledEngine(t, engine, ovirtclient.NewExtraSettings().WithMaxConcurrentRequests(2))
+
+	wg := &sync.WaitGroup{}
+	errs := make(chan error, 20)
+	for i := 0; i < 4; i++ {
+		subClient := client.WithContext(context.Background())
+		for j := 0; j < 5; j++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs <- subClient.Test()
+			}()
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("Failed to test the connection (%v)", err)
+		}
+	}
+	if maxInFlight := engine.maxInFlightRequests(); maxInFlight > 2 {
+		t.Fatalf("The engine received %d concurrent requests despite a limit of 2.", maxInFlight)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+	engine := newFakeThrottledEngine()
+	client := connectToFakeThrottledEngine(t, engine, ovirtclient.NewExtraSettings().WithRateLimit(10))
+
+	startTime := time.Now()
+	for i := 0; i < 10; i++ {
+		if err := client.Test(); err != nil {
+			t.Fatalf("Failed to test the connection (%v)", err)
+		}
+	}
+	// 10 requests at 10 requests per second must take at least 900 ms, we allow for a little leeway due to the timing.
+	if elapsed := time.Since(startTime); elapsed < 800*time.Millisecond {
+		t.Fatalf("10 requests with a rate limit of 10 per second completed in %s.", elapsed)
+	}
+}
+
+func TestRetryAfterIsHonoured(t *testing.T) {
+	t.Parallel()
+	engine := newFakeThrottledEngine()
+	client := connectToFakeThrottledEngine(t, engine, ovirtclient.NewExtraSettings())
+
+	engine.throttle(1)
+	startTime := time.Now()
+	if err := client.Test(); err != nil {
+		t.Fatalf("The client did not retry after being throttled (%v)", err)
+	}
+	// The default backoff would retry after 1 second, the engine asked for 2.
+	if elapsed := time.Since(startTime); elapsed < fakeRetryAfterSeconds*time.Second {
+		t.Fatalf("The client retried after %s instead of waiting %d seconds.", elapsed, fakeRetryAfterSeconds)
+	}
+	if remaining := engine.remainingThrottledResponses(); remaining != 0 {
+		t.Fatalf("The client did not send the request again after being throttled.")
+	}
+}
+
+const fakeRetryAfterSeconds = 2
+
+// fakeThrottledEngine wraps the fake SSO engine and records the number of concurrent API requests. It can also be
+// instructed to respond with HTTP 503 and a Retry-After header.
+type fakeThrottledEngine struct {
+	*fakeSSOEngine
+
+	statsLock          *sync.Mutex
+	inFlight           int
+	maxInFlight        int
+	throttledResponses int
+}
+
+func newFakeThrottledEngine() *fakeThrottledEngine {
+	return &fakeThrottledEngine{
+		fakeSSOEngine: &fakeSSOEngine{validTokens: map[string]bool{}},
+		statsLock:     &sync.Mutex{},
+	}
+}
+
+func (f *fakeThrottledEngine) throttle(responses int) {
+	f.statsLock.Lock()
+	defer f.statsLock.Unlock()
+	f.throttledResponses = responses
+}
+
+func (f *fakeThrottledEngine) remainingThrottledResponses() int {
+	f.statsLock.Lock()
+	defer f.statsLock.Unlock()
+	return f.throttledResponses
+}
+
+func (f *fakeThrottledEngine) maxInFlightRequests() int {
+	f.statsLock.Lock()
+	defer f.statsLock.Unlock()
+	return f.maxInFlight
+}
+
+func (f *fakeThrottledEngine) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.URL.Path != "/ovirt-engine/api" {
+		f.fakeSSOEngine.ServeHTTP(writer, request)
+		return
+	}
+	f.statsLock.Lock()
+	if f.throttledResponses > 0 {
+		f.throttledResponses--
+		f.statsLock.Unlock()
+		writer.Header().Set("Retry-After", strconv.Itoa(fakeRetryAfterSeconds))
+		writer.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	f.inFlight++
+	if f.inFlight > f.maxInFlight {
+		f.maxInFlight = f.inFlight
+	}
+	f.statsLock.Unlock()
+
+	time.Sleep(50 * time.Millisecond)
+	f.fakeSSOEngine.ServeHTTP(writer, request)
+
+	f.statsLock.Lock()
+	f.inFlight--
+	f.statsLock.Unlock()
+}
+
+func connectToFakeThrottledEngine(
+	t *testing.T,
+	engine *fakeThrottledEngine,
+	extraSettings ovirtclient.ExtraSettings,
+) ovirtclient.Client {
+	url, tlsProvider, clientTLSConfig := startFakeEngine(t, engine)
+	engine.clientTLSConfig = clientTLSConfig
+	client, err := ovirtclient.New(
+		url,
+		fakeSSOUsername,
+		fakeSSOPassword,
+		tlsProvider,
+		ovirtclientlog.NewTestLogger(t),
+		extraSettings,
+	)
+	if err != nil {
+		t.Fatalf("Failed to connect to the fake engine (%v)", err)
+	}
+	return client
+}
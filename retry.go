@@ -50,42 +50,80 @@ func retry(
 		if !recoverFailure(action, retries, err, logger) {
 			logRetry(action, logger, err)
 		}
-		// Here we create a select statement with a dynamic number of cases. We use this because a) select{} only
-		// supports fixed cases and b) the channel types are different. Context returns a <-chan struct{}, while
-		// time.After() returns <-chan time.Time. Go doesn't support type assertions, so we have to result to
-		// the reflection library to do this.
-		var chans []reflect.SelectCase
-		for _, r := range retries {
-			c := r.Wait(err)
-			if c != nil {
-				chans = append(
-					chans, reflect.SelectCase{
-						Dir:  reflect.SelectRecv,
-						Chan: reflect.ValueOf(c),
-						Send: reflect.Value{},
-					},
-				)
-			}
+		if err := waitForRetry(action, retries, err, logger); err != nil {
+			return err
 		}
-		if len(chans) == 0 {
-			logger.Errorf(
-				"No retry strategies with waiting function specified for %s.",
-				action,
+	}
+}
+
+// waitForRetry waits until the retry strategies allow for the next attempt. If the engine asked the client to retry
+// after a certain time with a Retry-After header, it waits at least as long as requested, even if a retry strategy
+// would allow for an earlier retry.
+func waitForRetry(action string, retries []RetryInstance, err error, logger ovirtclientlog.Logger) error {
+	// Here we create a select statement with a dynamic number of cases. We use this because a) select{} only
+	// supports fixed cases and b) the channel types are different. Context returns a <-chan struct{}, while
+	// time.After() returns <-chan time.Time. Go doesn't support type assertions, so we have to result to
+	// the reflection library to do this.
+	var chans []reflect.SelectCase
+	var chanRetries []RetryInstance
+	for _, r := range retries {
+		c := r.Wait(err)
+		if c != nil {
+			chans = append(
+				chans, reflect.SelectCase{
+					Dir:  reflect.SelectRecv,
+					Chan: reflect.ValueOf(c),
+					Send: reflect.Value{},
+				},
 			)
-			return newError(EBug, "no retry strategies with waiting function specified for %s", action)
+			chanRetries = append(chanRetries, r)
 		}
+	}
+	if len(chans) == 0 {
+		logger.Errorf(
+			"No retry strategies with waiting function specified for %s.",
+			action,
+		)
+		return newError(EBug, "no retry strategies with waiting function specified for %s", action)
+	}
+	retryAfter, hasRetryAfter := retryAfterDuration(err)
+	if hasRetryAfter {
+		logger.Debugf("The engine asked to retry %s after %s, waiting...", action, retryAfter)
+		chans = append(
+			chans, reflect.SelectCase{
+				Dir:  reflect.SelectRecv,
+				Chan: reflect.ValueOf(time.After(retryAfter)),
+				Send: reflect.Value{},
+			},
+		)
+	}
+	strategyExpired := false
+	for !strategyExpired || hasRetryAfter {
 		chosen, _, _ := reflect.Select(chans)
-		if err := retries[chosen].OnWaitExpired(err, action); err != nil {
+		if chosen == len(chanRetries) {
+			hasRetryAfter = false
+			chans = chans[:chosen]
+			continue
+		}
+		if err := chanRetries[chosen].OnWaitExpired(err, action); err != nil {
 			logger.Infof("Giving up %s (%v)", action, err)
 			return err
 		}
+		strategyExpired = true
+		// The expired channel must not be selected again while we are waiting for the Retry-After time.
+		chans[chosen].Chan = reflect.Value{}
 	}
+	return nil
 }
 
 func recoverFailure(action string, retries []RetryInstance, err error, logger ovirtclientlog.Logger) bool {
 	var e EngineError
 	if !errors.As(err, &e) {
-		return false
+		// Errors coming straight from the SDK, such as an expired token, have not been identified yet.
+		if e = realIdentify(err); e == nil {
+			return false
+		}
+		err = e
 	}
 	if !e.CanRecover() {
 		return false
@@ -93,3 +93,24 @@ func TestContextStrategy(t *testing.T) {
 		t.Fatalf("retry didn't run for enough time")
 	}
 }
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2022, 5, 1, 12, 0, 0, 0, time.UTC)
+	for value, expected := range map[string]time.Duration{
+		"5":                             5 * time.Second,
+		"Sun, 01 May 2022 12:00:30 GMT": 30 * time.Second,
+		"Sun, 01 May 2022 11:00:00 GMT": 0,
+	} {
+		wait, ok := parseRetryAfter(value, now)
+		if !ok {
+			t.Fatalf("failed to parse Retry-After value %s", value)
+		}
+		if wait != expected {
+			t.Fatalf("incorrect wait time for Retry-After value %s (expected: %s, got: %s)", value, expected, wait)
+		}
+	}
+	if _, ok := parseRetryAfter("invalid", now); ok {
+		t.Fatalf("an invalid Retry-After value was parsed")
+	}
+}
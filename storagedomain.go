@@ -21,6 +21,34 @@ type StorageDomainClient interface {
 	// RemoveDiskFromStorageDomain removes a disk from a specific storage domain, but leaves the disk on other storage
 	// domains if any. If the disk is not present on any more storage domains, the entire disk will be removed.
 	RemoveDiskFromStorageDomain(id StorageDomainID, diskID DiskID, retries ...RetryStrategy) error
+	// CreateStorageDomain creates a new data storage domain using the specified host to initialize the storage. Use
+	// NewNFSStorageParams, NewLocalFSStorageParams, NewISCSIStorageParams, or NewFCPStorageParams to describe the
+	// storage. The new storage domain is unattached and needs to be attached to a datacenter before use.
+	CreateStorageDomain(
+		name string,
+		hostID HostID,
+		storage StorageDomainStorageParameters,
+		params CreateStorageDomainOptionalParams,
+		retries ...RetryStrategy,
+	) (StorageDomain, error)
+	// AttachStorageDomainToDatacenter attaches an unattached storage domain to a datacenter. The engine activates the
+	// storage domain after attaching it, which should be waited for via the WaitForStorageDomainStatus call.
+	AttachStorageDomainToDatacenter(id StorageDomainID, datacenterID DatacenterID, retries ...RetryStrategy) error
+	// ActivateStorageDomain activates a storage domain in maintenance in the specified datacenter.
+	ActivateStorageDomain(id StorageDomainID, datacenterID DatacenterID, retries ...RetryStrategy) error
+	// DeactivateStorageDomain puts an active storage domain in the specified datacenter into maintenance.
+	DeactivateStorageDomain(id StorageDomainID, datacenterID DatacenterID, retries ...RetryStrategy) error
+	// DetachStorageDomain detaches a storage domain in maintenance from the specified datacenter.
+	DetachStorageDomain(id StorageDomainID, datacenterID DatacenterID, retries ...RetryStrategy) error
+	// RemoveStorageDomain removes an unattached storage domain. Use RemoveStorageDomainParams to obtain a builder for
+	// the optional parameters.
+	RemoveStorageDomain(id StorageDomainID, params RemoveStorageDomainParameters, retries ...RetryStrategy) error
+	// WaitForStorageDomainStatus waits for the storage domain to reach the desired status.
+	WaitForStorageDomainStatus(
+		id StorageDomainID,
+		status StorageDomainStatus,
+		retries ...RetryStrategy,
+	) (StorageDomain, error)
 }
 
 // StorageDomainData is the core of StorageDomain, providing only data access functions.
@@ -38,11 +66,18 @@ type StorageDomainData interface {
 	Status() StorageDomainStatus
 	// ExternalStatus returns the external status of a storage domain.
 	ExternalStatus() StorageDomainExternalStatus
+	// DatacenterIDs returns the IDs of the datacenters the storage domain is attached to.
+	DatacenterIDs() []DatacenterID
 }
 
 // StorageDomain represents a storage domain returned from the oVirt Engine API.
 type StorageDomain interface {
 	StorageDomainData
+
+	// Remove removes the current storage domain. See RemoveStorageDomain for details.
+	Remove(params RemoveStorageDomainParameters, retries ...RetryStrategy) error
+	// WaitForStatus waits for the current storage domain to reach the desired status.
+	WaitForStatus(status StorageDomainStatus, retries ...RetryStrategy) (StorageDomain, error)
 }
 
 // StorageDomainList represents a list of storage domains.
@@ -133,7 +168,7 @@ func (s StorageDomainStatus) Validate() error {
 		EBadArgument,
 		"invalid storage domain status: %s must be one of: %s",
 		s,
-		strings.Join(ImageFormatValues().Strings(), ", "),
+		strings.Join(StorageDomainStatusValues().Strings(), ", "),
 	)
 }
 
@@ -242,6 +277,436 @@ func (l StorageDomainExternalStatusList) Strings() []string {
 	return result
 }
 
+// NFSVersion is the version of the NFS protocol used to mount an NFS storage domain.
+type NFSVersion string
+
+const (
+	// NFSVersionAuto lets the host negotiate the NFS version.
+	NFSVersionAuto NFSVersion = "auto"
+	// NFSVersionV3 uses NFS version 3.
+	NFSVersionV3 NFSVersion = "v3"
+	// NFSVersionV4 uses NFS version 4.
+	NFSVersionV4 NFSVersion = "v4"
+	// NFSVersionV40 uses NFS version 4.0.
+	NFSVersionV40 NFSVersion = "v4_0"
+	// NFSVersionV41 uses NFS version 4.1.
+	NFSVersionV41 NFSVersion = "v4_1"
+	// NFSVersionV42 uses NFS version 4.2.
+	NFSVersionV42 NFSVersion = "v4_2"
+)
+
+// NFSVersionList is a list of NFSVersion values.
+type NFSVersionList []NFSVersion
+
+// NFSVersionValues returns all possible NFSVersion values.
+func NFSVersionValues() NFSVersionList {
+	return []NFSVersion{
+		NFSVersionAuto,
+		NFSVersionV3,
+		NFSVersionV4,
+		NFSVersionV40,
+		NFSVersionV41,
+		NFSVersionV42,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l NFSVersionList) Strings() []string {
+	result := make([]string, len(l))
+	for i, version := range l {
+		result[i] = string(version)
+	}
+	return result
+}
+
+// Validate returns an error if the NFS version doesn't have a valid value.
+func (v NFSVersion) Validate() error {
+	for _, version := range NFSVersionValues() {
+		if version == v {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid NFS version: %s must be one of: %s",
+		v,
+		strings.Join(NFSVersionValues().Strings(), ", "),
+	)
+}
+
+// StorageDomainStorageParameters describes the storage backing a new storage domain. Use NewNFSStorageParams,
+// NewLocalFSStorageParams, NewISCSIStorageParams, or NewFCPStorageParams to create an instance.
+type StorageDomainStorageParameters interface {
+	// StorageType returns the type of the storage.
+	StorageType() StorageDomainType
+	// Address returns the address of the NFS server or the iSCSI portal. Empty for other storage types.
+	Address() string
+	// Path returns the exported path for NFS or the local directory for localfs. Empty for other storage types.
+	Path() string
+	// NFSVersion returns the NFS version to use. Only set for NFS storage.
+	NFSVersion() *NFSVersion
+	// Port returns the port of the iSCSI portal. Zero for other storage types.
+	Port() uint
+	// Target returns the iSCSI target name. Empty for other storage types.
+	Target() string
+	// LUNIDs returns the logical units that make up the volume group for block storage.
+	LUNIDs() []string
+}
+
+// BuildableNFSStorageParameters is a buildable version of StorageDomainStorageParameters for NFS storage.
+type BuildableNFSStorageParameters interface {
+	StorageDomainStorageParameters
+
+	// WithNFSVersion sets the NFS version used to mount the export.
+	WithNFSVersion(version NFSVersion) (BuildableNFSStorageParameters, error)
+	// MustWithNFSVersion is identical to WithNFSVersion, but panics instead of returning an error.
+	MustWithNFSVersion(version NFSVersion) BuildableNFSStorageParameters
+}
+
+// NewNFSStorageParams creates the storage parameters for an NFS storage domain exported from the specified server
+// and path.
+func NewNFSStorageParams(address string, path string) (BuildableNFSStorageParameters, error) {
+	if address == "" {
+		return nil, newError(EBadArgument, "NFS server address cannot be empty")
+	}
+	if !strings.HasPrefix(path, "/") {
+		return nil, newError(EBadArgument, "NFS export path must be absolute (%s)", path)
+	}
+	return &storageDomainStorageParams{
+		storageType: StorageDomainTypeNFS,
+		address:     address,
+		path:        path,
+	}, nil
+}
+
+// MustNewNFSStorageParams is identical to NewNFSStorageParams, but panics instead of returning an error.
+func MustNewNFSStorageParams(address string, path string) BuildableNFSStorageParameters {
+	params, err := NewNFSStorageParams(address, path)
+	if err != nil {
+		panic(err)
+	}
+	return params
+}
+
+// NewLocalFSStorageParams creates the storage parameters for a storage domain located in a local directory of the
+// host.
+func NewLocalFSStorageParams(path string) (StorageDomainStorageParameters, error) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, newError(EBadArgument, "local storage path must be absolute (%s)", path)
+	}
+	return &storageDomainStorageParams{
+		storageType: StorageDomainTypeLocalFS,
+		path:        path,
+	}, nil
+}
+
+// MustNewLocalFSStorageParams is identical to NewLocalFSStorageParams, but panics instead of returning an error.
+func MustNewLocalFSStorageParams(path string) StorageDomainStorageParameters {
+	params, err := NewLocalFSStorageParams(path)
+	if err != nil {
+		panic(err)
+	}
+	return params
+}
+
+// NewISCSIStorageParams creates the storage parameters for a storage domain on iSCSI LUNs. The host must already be
+// logged in to the target.
+func NewISCSIStorageParams(
+	address string,
+	port uint,
+	target string,
+	lunIDs []string,
+) (StorageDomainStorageParameters, error) {
+	if address == "" {
+		return nil, newError(EBadArgument, "iSCSI portal address cannot be empty")
+	}
+	if port == 0 || port > 65535 {
+		return nil, newError(EBadArgument, "invalid iSCSI portal port: %d", port)
+	}
+	if target == "" {
+		return nil, newError(EBadArgument, "iSCSI target cannot be empty")
+	}
+	if err := validateStorageDomainLUNIDs(lunIDs); err != nil {
+		return nil, err
+	}
+	return &storageDomainStorageParams{
+		storageType: StorageDomainTypeISCSI,
+		address:     address,
+		port:        port,
+		target:      target,
+		lunIDs:      lunIDs,
+	}, nil
+}
+
+// MustNewISCSIStorageParams is identical to NewISCSIStorageParams, but panics instead of returning an error.
+func MustNewISCSIStorageParams(
+	address string,
+	port uint,
+	target string,
+	lunIDs []string,
+) StorageDomainStorageParameters {
+	params, err := NewISCSIStorageParams(address, port, target, lunIDs)
+	if err != nil {
+		panic(err)
+	}
+	return params
+}
+
+// NewFCPStorageParams creates the storage parameters for a storage domain on Fibre Channel LUNs.
+func NewFCPStorageParams(lunIDs []string) (StorageDomainStorageParameters, error) {
+	if err := validateStorageDomainLUNIDs(lunIDs); err != nil {
+		return nil, err
+	}
+	return &storageDomainStorageParams{
+		storageType: StorageDomainTypeFCP,
+		lunIDs:      lunIDs,
+	}, nil
+}
+
+// MustNewFCPStorageParams is identical to NewFCPStorageParams, but panics instead of returning an error.
+func MustNewFCPStorageParams(lunIDs []string) StorageDomainStorageParameters {
+	params, err := NewFCPStorageParams(lunIDs)
+	if err != nil {
+		panic(err)
+	}
+	return params
+}
+
+func validateStorageDomainLUNIDs(lunIDs []string) error {
+	if len(lunIDs) == 0 {
+		return newError(EBadArgument, "at least one LUN ID must be specified for block storage")
+	}
+	for _, lunID := range lunIDs {
+		if lunID == "" {
+			return newError(EBadArgument, "LUN IDs cannot be empty")
+		}
+	}
+	return nil
+}
+
+type storageDomainStorageParams struct {
+	storageType StorageDomainType
+	address     string
+	path        string
+	nfsVersion  *NFSVersion
+	port        uint
+	target      string
+	lunIDs      []string
+}
+
+func (s *storageDomainStorageParams) StorageType() StorageDomainType {
+	return s.storageType
+}
+
+func (s *storageDomainStorageParams) Address() string {
+	return s.address
+}
+
+func (s *storageDomainStorageParams) Path() string {
+	return s.path
+}
+
+func (s *storageDomainStorageParams) NFSVersion() *NFSVersion {
+	return s.nfsVersion
+}
+
+func (s *storageDomainStorageParams) Port() uint {
+	return s.port
+}
+
+func (s *storageDomainStorageParams) Target() string {
+	return s.target
+}
+
+func (s *storageDomainStorageParams) LUNIDs() []string {
+	return s.lunIDs
+}
+
+func (s *storageDomainStorageParams) WithNFSVersion(version NFSVersion) (BuildableNFSStorageParameters, error) {
+	if err := version.Validate(); err != nil {
+		return nil, err
+	}
+	s.nfsVersion = &version
+	return s, nil
+}
+
+func (s *storageDomainStorageParams) MustWithNFSVersion(version NFSVersion) BuildableNFSStorageParameters {
+	builder, err := s.WithNFSVersion(version)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// CreateStorageDomainOptionalParams contains the optional parameters for creating a storage domain.
+type CreateStorageDomainOptionalParams interface {
+	// Description returns the description of the new storage domain.
+	Description() *string
+	// Comment returns the comment of the new storage domain.
+	Comment() *string
+}
+
+// BuildableCreateStorageDomainParams is a buildable version of CreateStorageDomainOptionalParams.
+type BuildableCreateStorageDomainParams interface {
+	CreateStorageDomainOptionalParams
+
+	// WithDescription sets the description of the new storage domain.
+	WithDescription(description string) (BuildableCreateStorageDomainParams, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableCreateStorageDomainParams
+
+	// WithComment sets the comment of the new storage domain.
+	WithComment(comment string) (BuildableCreateStorageDomainParams, error)
+	// MustWithComment is identical to WithComment, but panics instead of returning an error.
+	MustWithComment(comment string) BuildableCreateStorageDomainParams
+}
+
+// CreateStorageDomainParams creates a buildable set of optional parameters for storage domain creation.
+func CreateStorageDomainParams() BuildableCreateStorageDomainParams {
+	return &createStorageDomainParams{}
+}
+
+type createStorageDomainParams struct {
+	description *string
+	comment     *string
+}
+
+func (c *createStorageDomainParams) Description() *string {
+	return c.description
+}
+
+func (c *createStorageDomainParams) Comment() *string {
+	return c.comment
+}
+
+func (c *createStorageDomainParams) WithDescription(description string) (BuildableCreateStorageDomainParams, error) {
+	c.description = &description
+	return c, nil
+}
+
+func (c *createStorageDomainParams) MustWithDescription(description string) BuildableCreateStorageDomainParams {
+	builder, err := c.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *createStorageDomainParams) WithComment(comment string) (BuildableCreateStorageDomainParams, error) {
+	c.comment = &comment
+	return c, nil
+}
+
+func (c *createStorageDomainParams) MustWithComment(comment string) BuildableCreateStorageDomainParams {
+	builder, err := c.WithComment(comment)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// RemoveStorageDomainParameters contains the optional parameters for removing a storage domain.
+type RemoveStorageDomainParameters interface {
+	// HostID returns the host used to remove the storage domain. If nil, the engine picks a host.
+	HostID() *HostID
+	// Format indicates that the storage should be formatted after removal so it can be reused.
+	Format() bool
+	// Destroy indicates that the storage domain should be removed from the engine database only, without touching
+	// the storage. This is used for storage domains that are no longer reachable.
+	Destroy() bool
+}
+
+// BuildableRemoveStorageDomainParameters is a buildable version of RemoveStorageDomainParameters.
+type BuildableRemoveStorageDomainParameters interface {
+	RemoveStorageDomainParameters
+
+	// WithHostID sets the host used to remove the storage domain.
+	WithHostID(hostID HostID) (BuildableRemoveStorageDomainParameters, error)
+	// MustWithHostID is identical to WithHostID, but panics instead of returning an error.
+	MustWithHostID(hostID HostID) BuildableRemoveStorageDomainParameters
+
+	// WithFormat sets the flag to format the storage after removal.
+	WithFormat(format bool) (BuildableRemoveStorageDomainParameters, error)
+	// MustWithFormat is identical to WithFormat, but panics instead of returning an error.
+	MustWithFormat(format bool) BuildableRemoveStorageDomainParameters
+
+	// WithDestroy sets the flag to remove the storage domain from the database only.
+	WithDestroy(destroy bool) (BuildableRemoveStorageDomainParameters, error)
+	// MustWithDestroy is identical to WithDestroy, but panics instead of returning an error.
+	MustWithDestroy(destroy bool) BuildableRemoveStorageDomainParameters
+}
+
+// RemoveStorageDomainParams creates a buildable set of parameters for storage domain removal.
+func RemoveStorageDomainParams() BuildableRemoveStorageDomainParameters {
+	return &removeStorageDomainParams{}
+}
+
+type removeStorageDomainParams struct {
+	hostID  *HostID
+	format  bool
+	destroy bool
+}
+
+func (r *removeStorageDomainParams) HostID() *HostID {
+	return r.hostID
+}
+
+func (r *removeStorageDomainParams) Format() bool {
+	return r.format
+}
+
+func (r *removeStorageDomainParams) Destroy() bool {
+	return r.destroy
+}
+
+func (r *removeStorageDomainParams) WithHostID(hostID HostID) (BuildableRemoveStorageDomainParameters, error) {
+	if hostID == "" {
+		return nil, newError(EBadArgument, "host ID cannot be empty")
+	}
+	r.hostID = &hostID
+	return r, nil
+}
+
+func (r *removeStorageDomainParams) MustWithHostID(hostID HostID) BuildableRemoveStorageDomainParameters {
+	builder, err := r.WithHostID(hostID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (r *removeStorageDomainParams) WithFormat(format bool) (BuildableRemoveStorageDomainParameters, error) {
+	if format && r.destroy {
+		return nil, newError(EBadArgument, "format and destroy cannot be used together")
+	}
+	r.format = format
+	return r, nil
+}
+
+func (r *removeStorageDomainParams) MustWithFormat(format bool) BuildableRemoveStorageDomainParameters {
+	builder, err := r.WithFormat(format)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (r *removeStorageDomainParams) WithDestroy(destroy bool) (BuildableRemoveStorageDomainParameters, error) {
+	if destroy && r.format {
+		return nil, newError(EBadArgument, "format and destroy cannot be used together")
+	}
+	r.destroy = destroy
+	return r, nil
+}
+
+func (r *removeStorageDomainParams) MustWithDestroy(destroy bool) BuildableRemoveStorageDomainParameters {
+	builder, err := r.WithDestroy(destroy)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
 func convertSDKStorageDomain(sdkStorageDomain *ovirtsdk4.StorageDomain, client Client) (StorageDomain, error) {
 	id, ok := sdkStorageDomain.Id()
 	if !ok {
@@ -274,6 +739,14 @@ func convertSDKStorageDomain(sdkStorageDomain *ovirtsdk4.StorageDomain, client C
 	if status == "" && externalStatus == "" {
 		return nil, newError(EFieldMissing, "neither the status nor the external status is set for storage domain %s", id)
 	}
+	var datacenterIDs []DatacenterID
+	if datacenters, ok := sdkStorageDomain.DataCenters(); ok {
+		for _, datacenter := range datacenters.Slice() {
+			if datacenterID, ok := datacenter.Id(); ok {
+				datacenterIDs = append(datacenterIDs, DatacenterID(datacenterID))
+			}
+		}
+	}
 
 	return &storageDomain{
 		client: client,
@@ -284,6 +757,7 @@ func convertSDKStorageDomain(sdkStorageDomain *ovirtsdk4.StorageDomain, client C
 		storageType:    StorageDomainType(storageType),
 		status:         StorageDomainStatus(status),
 		externalStatus: StorageDomainExternalStatus(externalStatus),
+		datacenterIDs:  datacenterIDs,
 	}, nil
 }
 
@@ -296,6 +770,7 @@ type storageDomain struct {
 	storageType    StorageDomainType
 	status         StorageDomainStatus
 	externalStatus StorageDomainExternalStatus
+	datacenterIDs  []DatacenterID
 }
 
 func (s storageDomain) ID() StorageDomainID {
@@ -322,6 +797,37 @@ func (s storageDomain) ExternalStatus() StorageDomainExternalStatus {
 	return s.externalStatus
 }
 
+func (s storageDomain) DatacenterIDs() []DatacenterID {
+	return s.datacenterIDs
+}
+
+func (s storageDomain) Remove(params RemoveStorageDomainParameters, retries ...RetryStrategy) error {
+	return s.client.RemoveStorageDomain(s.id, params, retries...)
+}
+
+func (s storageDomain) WaitForStatus(status StorageDomainStatus, retries ...RetryStrategy) (StorageDomain, error) {
+	return s.client.WaitForStorageDomainStatus(s.id, status, retries...)
+}
+
+// clone creates a copy of the storage domain, so the mock client can store a changed storage domain without altering
+// the ones it already handed out.
+func (s storageDomain) clone() *storageDomain {
+	result := s
+	result.datacenterIDs = make([]DatacenterID, len(s.datacenterIDs))
+	copy(result.datacenterIDs, s.datacenterIDs)
+	return &result
+}
+
+// isAttachedTo returns true if the storage domain is attached to the specified datacenter.
+func (s storageDomain) isAttachedTo(datacenterID DatacenterID) bool {
+	for _, id := range s.datacenterIDs {
+		if id == datacenterID {
+			return true
+		}
+	}
+	return false
+}
+
 type storageDomainDiskWait struct {
 	client        *oVirtClient
 	disk          Disk
@@ -0,0 +1,59 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ActivateStorageDomain(
+	id StorageDomainID,
+	datacenterID DatacenterID,
+	retries ...RetryStrategy,
+) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("activating storage domain %s in datacenter %s", id, datacenterID),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				DataCentersService().
+				DataCenterService(string(datacenterID)).
+				StorageDomainsService().
+				StorageDomainService(string(id)).
+				Activate().
+				Send()
+			return err
+		})
+	return
+}
+
+func (m *mockClient) ActivateStorageDomain(
+	id StorageDomainID,
+	datacenterID DatacenterID,
+	retries ...RetryStrategy,
+) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("activating storage domain %s in datacenter %s", id, datacenterID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			sd, err := m.getAttachedStorageDomain(id, datacenterID)
+			if err != nil {
+				return err
+			}
+			switch sd.status {
+			case StorageDomainStatusActive, StorageDomainStatusActivating:
+				return nil
+			case StorageDomainStatusMaintenance, StorageDomainStatusInactive:
+			default:
+				return newError(EConflict, "storage domain %s cannot be activated in status %s", id, sd.status)
+			}
+			m.transitionStorageDomain(sd, StorageDomainStatusActivating, StorageDomainStatusActive, nil)
+			return nil
+		})
+}
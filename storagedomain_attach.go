@@ -0,0 +1,67 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) AttachStorageDomainToDatacenter(
+	id StorageDomainID,
+	datacenterID DatacenterID,
+	retries ...RetryStrategy,
+) (err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	err = retry(
+		fmt.Sprintf("attaching storage domain %s to datacenter %s", id, datacenterID),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				DataCentersService().
+				DataCenterService(string(datacenterID)).
+				StorageDomainsService().
+				Add().
+				StorageDomain(ovirtsdk.NewStorageDomainBuilder().Id(string(id)).MustBuild()).
+				Send()
+			return err
+		})
+	return
+}
+
+func (m *mockClient) AttachStorageDomainToDatacenter(
+	id StorageDomainID,
+	datacenterID DatacenterID,
+	retries ...RetryStrategy,
+) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("attaching storage domain %s to datacenter %s", id, datacenterID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, ok := m.dataCenters[datacenterID]; !ok {
+				return newError(ENotFound, "datacenter with ID %s not found", datacenterID)
+			}
+			sd, ok := m.storageDomains[id]
+			if !ok {
+				return newError(ENotFound, "storage domain with ID %s not found", id)
+			}
+			if sd.status != StorageDomainStatusUnattached {
+				return newError(
+					EConflict,
+					"storage domain %s is in status %s and cannot be attached to a datacenter",
+					id,
+					sd.status,
+				)
+			}
+			attached := sd.clone()
+			attached.datacenterIDs = []DatacenterID{datacenterID}
+			m.transitionStorageDomain(attached, StorageDomainStatusLocked, StorageDomainStatusActive, nil)
+			return nil
+		})
+}
@@ -0,0 +1,165 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) CreateStorageDomain(
+	name string,
+	hostID HostID,
+	storage StorageDomainStorageParameters,
+	params CreateStorageDomainOptionalParams,
+	retries ...RetryStrategy,
+) (result StorageDomain, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	if err := validateStorageDomainCreationParameters(name, hostID, storage); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = CreateStorageDomainParams()
+	}
+	sdkStorageDomain, err := buildSDKStorageDomain(name, hostID, storage, params)
+	if err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("creating storage domain %s", name),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().StorageDomainsService().Add().StorageDomain(sdkStorageDomain).Send()
+			if err != nil {
+				return err
+			}
+			sd, ok := response.StorageDomain()
+			if !ok {
+				return newFieldNotFound("storage domain create response", "storage domain")
+			}
+			result, err = convertSDKStorageDomain(sd, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert storage domain")
+			}
+			return nil
+		},
+	)
+	return result, err
+}
+
+func buildSDKStorageDomain(
+	name string,
+	hostID HostID,
+	storage StorageDomainStorageParameters,
+	params CreateStorageDomainOptionalParams,
+) (*ovirtsdk.StorageDomain, error) {
+	storageBuilder := ovirtsdk.NewHostStorageBuilder().Type(ovirtsdk.StorageType(storage.StorageType()))
+	switch storage.StorageType() {
+	case StorageDomainTypeNFS:
+		storageBuilder.Address(storage.Address()).Path(storage.Path())
+		if nfsVersion := storage.NFSVersion(); nfsVersion != nil {
+			storageBuilder.NfsVersion(ovirtsdk.NfsVersion(*nfsVersion))
+		}
+	case StorageDomainTypeLocalFS:
+		storageBuilder.Path(storage.Path())
+	case StorageDomainTypeISCSI, StorageDomainTypeFCP:
+		logicalUnits := make([]ovirtsdk.LogicalUnitBuilder, len(storage.LUNIDs()))
+		for i, lunID := range storage.LUNIDs() {
+			logicalUnit := ovirtsdk.NewLogicalUnitBuilder().Id(lunID)
+			if storage.StorageType() == StorageDomainTypeISCSI {
+				logicalUnit.Address(storage.Address()).Port(int64(storage.Port())).Target(storage.Target())
+			}
+			logicalUnits[i] = *logicalUnit
+		}
+		storageBuilder.LogicalUnitsBuilderOfAny(logicalUnits...)
+	}
+
+	builder := ovirtsdk.NewStorageDomainBuilder().
+		Name(name).
+		Type(ovirtsdk.STORAGEDOMAINTYPE_DATA).
+		HostBuilder(ovirtsdk.NewHostBuilder().Id(string(hostID))).
+		StorageBuilder(storageBuilder)
+	if description := params.Description(); description != nil {
+		builder.Description(*description)
+	}
+	if comment := params.Comment(); comment != nil {
+		builder.Comment(*comment)
+	}
+	sdkStorageDomain, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build storage domain")
+	}
+	return sdkStorageDomain, nil
+}
+
+func validateStorageDomainCreationParameters(
+	name string,
+	hostID HostID,
+	storage StorageDomainStorageParameters,
+) error {
+	if name == "" {
+		return newError(EBadArgument, "name cannot be empty for storage domain creation")
+	}
+	if hostID == "" {
+		return newError(EBadArgument, "host ID cannot be empty for storage domain creation")
+	}
+	if storage == nil {
+		return newError(EBadArgument, "storage parameters are required for storage domain creation")
+	}
+	switch storage.StorageType() {
+	case StorageDomainTypeNFS, StorageDomainTypeLocalFS, StorageDomainTypeISCSI, StorageDomainTypeFCP:
+		return nil
+	default:
+		return newError(EBadArgument, "unsupported storage type for storage domain creation: %s", storage.StorageType())
+	}
+}
+
+func (m *mockClient) CreateStorageDomain(
+	name string,
+	hostID HostID,
+	storage StorageDomainStorageParameters,
+	params CreateStorageDomainOptionalParams,
+	retries ...RetryStrategy,
+) (result StorageDomain, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if err := validateStorageDomainCreationParameters(name, hostID, storage); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("creating storage domain %s", name),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			host, ok := m.hosts[hostID]
+			if !ok {
+				return newError(ENotFound, "host with ID %s not found", hostID)
+			}
+			if host.status != HostStatusUp {
+				return newError(EConflict, "host %s is in status %s and cannot create storage domains", hostID, host.status)
+			}
+			for _, sd := range m.storageDomains {
+				if sd.name == name {
+					return newError(EConflict, "a storage domain with the name %s already exists", name)
+				}
+			}
+
+			sd := &storageDomain{
+				client:         m,
+				id:             StorageDomainID(m.GenerateUUID()),
+				name:           name,
+				available:      10 * 1024 * 1024 * 1024,
+				storageType:    storage.StorageType(),
+				status:         StorageDomainStatusUnattached,
+				externalStatus: StorageDomainExternalStatusNA,
+			}
+			m.storageDomains[sd.id] = sd
+			m.addDefaultDiskProfile(sd)
+			result = sd.clone()
+			return nil
+		})
+	return
+}
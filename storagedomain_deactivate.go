@@ -0,0 +1,67 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) DeactivateStorageDomain(
+	id StorageDomainID,
+	datacenterID DatacenterID,
+	retries ...RetryStrategy,
+) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("deactivating storage domain %s in datacenter %s", id, datacenterID),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				DataCentersService().
+				DataCenterService(string(datacenterID)).
+				StorageDomainsService().
+				StorageDomainService(string(id)).
+				Deactivate().
+				Send()
+			return err
+		})
+	return
+}
+
+func (m *mockClient) DeactivateStorageDomain(
+	id StorageDomainID,
+	datacenterID DatacenterID,
+	retries ...RetryStrategy,
+) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("putting storage domain %s into maintenance in datacenter %s", id, datacenterID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			sd, err := m.getAttachedStorageDomain(id, datacenterID)
+			if err != nil {
+				return err
+			}
+			switch sd.status {
+			case StorageDomainStatusMaintenance, StorageDomainStatusPreparingForMaintenance:
+				return nil
+			case StorageDomainStatusActive:
+			default:
+				return newError(EConflict, "storage domain %s cannot be put into maintenance in status %s", id, sd.status)
+			}
+			if err := m.storageDomainInUse(id); err != nil {
+				return err
+			}
+			m.transitionStorageDomain(
+				sd,
+				StorageDomainStatusPreparingForMaintenance,
+				StorageDomainStatusMaintenance,
+				nil,
+			)
+			return nil
+		})
+}
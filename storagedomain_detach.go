@@ -0,0 +1,67 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) DetachStorageDomain(
+	id StorageDomainID,
+	datacenterID DatacenterID,
+	retries ...RetryStrategy,
+) (err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	err = retry(
+		fmt.Sprintf("detaching storage domain %s from datacenter %s", id, datacenterID),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				DataCentersService().
+				DataCenterService(string(datacenterID)).
+				StorageDomainsService().
+				StorageDomainService(string(id)).
+				Remove().
+				Send()
+			return err
+		})
+	return
+}
+
+func (m *mockClient) DetachStorageDomain(
+	id StorageDomainID,
+	datacenterID DatacenterID,
+	retries ...RetryStrategy,
+) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("detaching storage domain %s from datacenter %s", id, datacenterID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			sd, err := m.getAttachedStorageDomain(id, datacenterID)
+			if err != nil {
+				return err
+			}
+			if sd.status != StorageDomainStatusMaintenance {
+				return newError(
+					EConflict,
+					"storage domain %s must be in maintenance to be detached (currently %s)",
+					id,
+					sd.status,
+				)
+			}
+			m.transitionStorageDomain(
+				sd,
+				StorageDomainStatusDetaching,
+				StorageDomainStatusUnattached,
+				func(sd *storageDomain) {
+					sd.datacenterIDs = nil
+				},
+			)
+			return nil
+		})
+}
@@ -0,0 +1,67 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListStorageDomainDiskProfiles(
+	storageDomainID StorageDomainID,
+	retries ...RetryStrategy,
+) (result []DiskProfile, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	result = []DiskProfile{}
+	err = retry(
+		fmt.Sprintf("listing disk profiles of storage domain %s", storageDomainID),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().StorageDomainsService().
+				StorageDomainService(string(storageDomainID)).
+				DiskProfilesService().
+				List().
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObjects, ok := response.Profiles()
+			if !ok {
+				return nil
+			}
+			result = make([]DiskProfile, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], err = convertSDKDiskProfile(sdkObject, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert disk profile during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) ListStorageDomainDiskProfiles(
+	storageDomainID StorageDomainID,
+	retries ...RetryStrategy,
+) (result []DiskProfile, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	err = retry(
+		fmt.Sprintf("listing disk profiles of storage domain %s", storageDomainID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, ok := m.storageDomains[storageDomainID]; !ok {
+				return newError(ENotFound, "storage domain with ID %s not found", storageDomainID)
+			}
+			result = []DiskProfile{}
+			for _, item := range m.diskProfiles {
+				if item.storageDomainID == storageDomainID {
+					result = append(result, item)
+				}
+			}
+			return nil
+		})
+	return
+}
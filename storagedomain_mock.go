@@ -0,0 +1,154 @@
+package ovirtclient
+
+import (
+	"time"
+)
+
+// getAttachedStorageDomain returns a storage domain that is attached to the specified datacenter. Must be called
+// with the lock held.
+func (m *mockClient) getAttachedStorageDomain(id StorageDomainID, datacenterID DatacenterID) (*storageDomain, error) {
+	if _, ok := m.dataCenters[datacenterID]; !ok {
+		return nil, newError(ENotFound, "datacenter with ID %s not found", datacenterID)
+	}
+	sd, ok := m.storageDomains[id]
+	if !ok {
+		return nil, newError(ENotFound, "storage domain with ID %s not found", id)
+	}
+	if !sd.isAttachedTo(datacenterID) {
+		return nil, newError(EConflict, "storage domain %s is not attached to datacenter %s", id, datacenterID)
+	}
+	return sd, nil
+}
+
+// transitionStorageDomain moves the storage domain into an intermediate status, then into the final status in the
+// background, the same way the engine performs storage domain operations. Each status change stores a new copy so
+// storage domains handed out earlier do not change. The optional finish function is called with the lock held on the
+// copy that receives the final status. Must be called with the lock held.
+func (m *mockClient) transitionStorageDomain(
+	sd *storageDomain,
+	intermediateStatus StorageDomainStatus,
+	finalStatus StorageDomainStatus,
+	finish func(sd *storageDomain),
+) {
+	transitioning := sd.clone()
+	transitioning.status = intermediateStatus
+	m.storageDomains[sd.id] = transitioning
+	go func() {
+		time.Sleep(2 * time.Second)
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		current, ok := m.storageDomains[sd.id]
+		if !ok || current.status != intermediateStatus {
+			return
+		}
+		finished := current.clone()
+		if finish != nil {
+			finish(finished)
+		}
+		finished.status = finalStatus
+		m.storageDomains[sd.id] = finished
+	}()
+}
+
+// storageDomainInUse returns an error if any VM using a disk on the storage domain is not down. Must be called with
+// the lock held.
+func (m *mockClient) storageDomainInUse(id StorageDomainID) error {
+	for _, d := range m.disks {
+		if !diskOnStorageDomain(d, id) {
+			continue
+		}
+		if vm := m.diskUsedByRunningVM(d.id); vm != nil {
+			return newError(
+				EConflict,
+				"disk %s on storage domain %s is in use by VM %s in status %s",
+				d.id,
+				id,
+				vm.id,
+				vm.status,
+			)
+		}
+	}
+	return nil
+}
+
+// storageDomainHasDisks returns true if any disk resides on the specified storage domain. Must be called with the
+// lock held.
+func (m *mockClient) storageDomainHasDisks(id StorageDomainID) bool {
+	for _, d := range m.disks {
+		if diskOnStorageDomain(d, id) {
+			return true
+		}
+	}
+	return false
+}
+
+func diskOnStorageDomain(d *diskWithData, id StorageDomainID) bool {
+	for _, storageDomainID := range d.storageDomainIDs {
+		if storageDomainID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// checkStorageDomainSpace returns an error if the storage domain does not exist or does not have the specified number
+// of bytes available. Must be called with the lock held.
+func (m *mockClient) checkStorageDomainSpace(id StorageDomainID, size uint64) error {
+	sd, ok := m.storageDomains[id]
+	if !ok {
+		return newError(ENotFound, "storage domain with ID %s not found", id)
+	}
+	if sd.available < size {
+		return newError(
+			EConflict,
+			"storage domain %s has %d bytes available, but %d bytes are required",
+			id,
+			sd.available,
+			size,
+		)
+	}
+	return nil
+}
+
+// adjustStorageDomainAvailable changes the available bytes on a storage domain by the specified delta. Must be called
+// with the lock held.
+func (m *mockClient) adjustStorageDomainAvailable(id StorageDomainID, delta int64) {
+	sd, ok := m.storageDomains[id]
+	if !ok {
+		return
+	}
+	updated := sd.clone()
+	if delta < 0 && uint64(-delta) > sd.available {
+		updated.available = 0
+	} else {
+		updated.available = uint64(int64(sd.available) + delta)
+	}
+	m.storageDomains[id] = updated
+}
+
+// getDefaultDiskProfile returns the disk profile the engine assigns to new disks on a storage domain if no profile is
+// specified. The caller must hold the lock of the mock client.
+func (m *mockClient) getDefaultDiskProfile(storageDomainID StorageDomainID) *diskProfile {
+	sd, ok := m.storageDomains[storageDomainID]
+	if !ok {
+		return nil
+	}
+	for _, item := range m.diskProfiles {
+		if item.storageDomainID == storageDomainID && item.name == sd.name {
+			return item
+		}
+	}
+	return nil
+}
+
+// addDefaultDiskProfile creates the default disk profile for a storage domain, the same way the engine does when a
+// storage domain is created. The caller must hold the lock of the mock client.
+func (m *mockClient) addDefaultDiskProfile(sd *storageDomain) {
+	profile := &diskProfile{
+		client:          m,
+		id:              DiskProfileID(m.GenerateUUID()),
+		name:            sd.name,
+		storageDomainID: sd.id,
+	}
+	m.diskProfiles[profile.id] = profile
+}
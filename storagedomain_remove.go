@@ -0,0 +1,83 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) RemoveStorageDomain(
+	id StorageDomainID,
+	params RemoveStorageDomainParameters,
+	retries ...RetryStrategy,
+) (err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	if params == nil {
+		params = RemoveStorageDomainParams()
+	}
+	err = retry(
+		fmt.Sprintf("removing storage domain %s", id),
+		o.logger,
+		retries,
+		func() error {
+			request := o.conn.
+				SystemService().
+				StorageDomainsService().
+				StorageDomainService(string(id)).
+				Remove().
+				Format(params.Format()).
+				Destroy(params.Destroy())
+			if hostID := params.HostID(); hostID != nil {
+				request.Host(string(*hostID))
+			}
+			_, err := request.Send()
+			return err
+		})
+	return
+}
+
+func (m *mockClient) RemoveStorageDomain(
+	id StorageDomainID,
+	params RemoveStorageDomainParameters,
+	retries ...RetryStrategy,
+) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if params == nil {
+		params = RemoveStorageDomainParams()
+	}
+	return retry(
+		fmt.Sprintf("removing storage domain %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			sd, ok := m.storageDomains[id]
+			if !ok {
+				return newError(ENotFound, "storage domain with ID %s not found", id)
+			}
+			if hostID := params.HostID(); hostID != nil {
+				if _, ok := m.hosts[*hostID]; !ok {
+					return newError(ENotFound, "host with ID %s not found", *hostID)
+				}
+			}
+			if !params.Destroy() && sd.status != StorageDomainStatusUnattached {
+				return newError(
+					EConflict,
+					"storage domain %s must be detached before it can be removed (currently %s)",
+					id,
+					sd.status,
+				)
+			}
+			if m.storageDomainHasDisks(id) {
+				return newError(EConflict, "storage domain %s still contains disks", id)
+			}
+			for profileID, profile := range m.diskProfiles {
+				if profile.storageDomainID == id {
+					delete(m.diskProfiles, profileID)
+				}
+			}
+			delete(m.storageDomains, id)
+			return nil
+		})
+}
@@ -0,0 +1,167 @@
+package ovirtclient_test
+
+import (
+	"os"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestStorageDomainLifecycle(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	storage := getTestNFSStorageParams(t, helper)
+	hostID := findUpHost(t, helper).ID()
+	datacenterID := getTestStorageDomainDatacenterID(t, helper)
+
+	sd := assertCanCreateStorageDomain(t, helper, hostID, storage)
+	assertStorageDomainStatus(t, client, sd.ID(), ovirtclient.StorageDomainStatusUnattached)
+
+	if err := client.AttachStorageDomainToDatacenter(sd.ID(), datacenterID); err != nil {
+		t.Fatalf("Failed to attach storage domain %s to datacenter %s (%v)", sd.ID(), datacenterID, err)
+	}
+	assertStorageDomainStatus(t, client, sd.ID(), ovirtclient.StorageDomainStatusActive)
+
+	if err := client.DetachStorageDomain(sd.ID(), datacenterID, ovirtclient.MaxTries(1)); err == nil {
+		t.Fatalf("Detaching an active storage domain did not result in an error.")
+	}
+
+	if err := client.DeactivateStorageDomain(sd.ID(), datacenterID); err != nil {
+		t.Fatalf("Failed to deactivate storage domain %s (%v)", sd.ID(), err)
+	}
+	assertStorageDomainStatus(t, client, sd.ID(), ovirtclient.StorageDomainStatusMaintenance)
+
+	if err := client.ActivateStorageDomain(sd.ID(), datacenterID); err != nil {
+		t.Fatalf("Failed to activate storage domain %s (%v)", sd.ID(), err)
+	}
+	assertStorageDomainStatus(t, client, sd.ID(), ovirtclient.StorageDomainStatusActive)
+
+	if err := client.DeactivateStorageDomain(sd.ID(), datacenterID); err != nil {
+		t.Fatalf("Failed to deactivate storage domain %s (%v)", sd.ID(), err)
+	}
+	assertStorageDomainStatus(t, client, sd.ID(), ovirtclient.StorageDomainStatusMaintenance)
+
+	if err := client.DetachStorageDomain(sd.ID(), datacenterID); err != nil {
+		t.Fatalf("Failed to detach storage domain %s (%v)", sd.ID(), err)
+	}
+	assertStorageDomainStatus(t, client, sd.ID(), ovirtclient.StorageDomainStatusUnattached)
+
+	if err := client.RemoveStorageDomain(
+		sd.ID(),
+		ovirtclient.RemoveStorageDomainParams().MustWithHostID(hostID).MustWithFormat(true),
+	); err != nil {
+		t.Fatalf("Failed to remove storage domain %s (%v)", sd.ID(), err)
+	}
+	if _, err := client.GetStorageDomain(sd.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Storage domain %s still exists after removal (%v)", sd.ID(), err)
+	}
+}
+
+func TestActiveStorageDomainCannotBeRemoved(t *testing.T) {
+	helper := getHelper(t)
+
+	err := helper.GetClient().RemoveStorageDomain(helper.GetStorageDomainID(), nil, ovirtclient.MaxTries(1))
+	if err == nil {
+		t.Fatalf("Removing an active storage domain did not result in an error.")
+	}
+}
+
+func TestStorageDomainStorageParamsValidation(t *testing.T) {
+	if _, err := ovirtclient.NewNFSStorageParams("nfs.example.com", "relative/path"); err == nil {
+		t.Fatalf("Creating NFS storage parameters with a relative path did not result in an error.")
+	}
+	_, err := ovirtclient.NewISCSIStorageParams("iscsi.example.com", 3260, "iqn.2022-01.com.example:target", nil)
+	if err == nil {
+		t.Fatalf("Creating iSCSI storage parameters without LUNs did not result in an error.")
+	}
+	if _, err := ovirtclient.MustNewNFSStorageParams("nfs.example.com", "/exports/data").
+		WithNFSVersion("v5"); err == nil {
+		t.Fatalf("Setting an invalid NFS version did not result in an error.")
+	}
+}
+
+func assertCanCreateStorageDomain(
+	t *testing.T,
+	helper ovirtclient.TestHelper,
+	hostID ovirtclient.HostID,
+	storage ovirtclient.StorageDomainStorageParameters,
+) ovirtclient.StorageDomain {
+	client := helper.GetClient()
+	sd, err := client.CreateStorageDomain(
+		helper.GenerateTestResourceName(t),
+		hostID,
+		storage,
+		ovirtclient.CreateStorageDomainParams().MustWithDescription("Test storage domain"),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create storage domain (%v)", err)
+	}
+	t.Cleanup(func() {
+		if err := client.RemoveStorageDomain(
+			sd.ID(),
+			ovirtclient.RemoveStorageDomainParams().MustWithHostID(hostID).MustWithDestroy(true),
+		); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("Failed to remove storage domain %s after test (%v)", sd.ID(), err)
+		}
+	})
+	return sd
+}
+
+// getTestNFSStorageParams returns the NFS export to create a test storage domain on. On a live engine the export
+// is read from the OVIRT_TEST_NFS_ADDRESS and OVIRT_TEST_NFS_PATH environment variables, and the test is skipped if
+// they are not set.
+func getTestNFSStorageParams(t *testing.T, helper ovirtclient.TestHelper) ovirtclient.StorageDomainStorageParameters {
+	address := "nfs.example.com"
+	path := "/exports/" + helper.GenerateRandomID(5)
+	if _, ok := helper.GetClient().(ovirtclient.MockClient); !ok {
+		address = os.Getenv("OVIRT_TEST_NFS_ADDRESS")
+		path = os.Getenv("OVIRT_TEST_NFS_PATH")
+		if address == "" || path == "" {
+			t.Skipf("OVIRT_TEST_NFS_ADDRESS and OVIRT_TEST_NFS_PATH are not set, skipping storage domain test.")
+		}
+	}
+	storage, err := ovirtclient.NewNFSStorageParams(address, path)
+	if err != nil {
+		t.Fatalf("Failed to create NFS storage parameters (%v)", err)
+	}
+	return storage
+}
+
+func getTestStorageDomainDatacenterID(t *testing.T, helper ovirtclient.TestHelper) ovirtclient.DatacenterID {
+	sd, err := helper.GetClient().GetStorageDomain(helper.GetStorageDomainID())
+	if err != nil {
+		t.Fatalf("Failed to fetch test storage domain (%v)", err)
+	}
+	datacenterIDs := sd.DatacenterIDs()
+	if len(datacenterIDs) == 0 {
+		t.Fatalf("Test storage domain %s is not attached to a datacenter.", sd.ID())
+	}
+	return datacenterIDs[0]
+}
+
+// findUpHost returns a host in the up status, or skips the test if there is none.
+func findUpHost(t *testing.T, helper ovirtclient.TestHelper) ovirtclient.Host {
+	hosts, err := helper.GetClient().ListHosts()
+	if err != nil {
+		t.Fatalf("Failed to list hosts (%v)", err)
+	}
+	for _, host := range hosts {
+		if host.Status() == ovirtclient.HostStatusUp {
+			return host
+		}
+	}
+	t.Skipf("No host in status %s found.", ovirtclient.HostStatusUp)
+	return nil
+}
+
+func assertStorageDomainStatus(
+	t *testing.T,
+	client ovirtclient.Client,
+	id ovirtclient.StorageDomainID,
+	status ovirtclient.StorageDomainStatus,
+) {
+	if _, err := client.WaitForStorageDomainStatus(id, status); err != nil {
+		t.Fatalf("Storage domain %s did not reach status %s (%v)", id, status, err)
+	}
+}
@@ -0,0 +1,57 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) WaitForStorageDomainStatus(
+	id StorageDomainID,
+	status StorageDomainStatus,
+	retries ...RetryStrategy,
+) (storageDomain StorageDomain, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("waiting for storage domain %s status %s", id, status),
+		o.logger,
+		retries,
+		func() error {
+			storageDomain, err = o.GetStorageDomain(id, retries...)
+			if err != nil {
+				return err
+			}
+			if storageDomain.Status() != status {
+				return newError(EPending, "storage domain status is %s, not %s", storageDomain.Status(), status)
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) WaitForStorageDomainStatus(
+	id StorageDomainID,
+	status StorageDomainStatus,
+	retries ...RetryStrategy,
+) (storageDomain StorageDomain, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(m))
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("waiting for storage domain %s status %s", id, status),
+		m.logger,
+		retries,
+		func() error {
+			storageDomain, err = m.GetStorageDomain(id, retries...)
+			if err != nil {
+				return err
+			}
+			if storageDomain.Status() != status {
+				return newError(EPending, "storage domain status is %s, not %s", storageDomain.Status(), status)
+			}
+			return nil
+		})
+	return
+}
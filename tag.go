@@ -9,15 +9,27 @@ import (
 // TagID is the UUID of a tag.
 type TagID string
 
-// TagClient describes the functions related to oVirt tags.
+// RootTagID is the ID of the root tag all other tags descend from. It can be passed as a parent ID to create or move
+// a tag at the top level.
+const RootTagID TagID = "00000000-0000-0000-0000-000000000000"
+
+// TagClient describes the functions related to oVirt tags. Tags form a tree: each tag may have a parent tag, and
+// searching for a tag also matches objects that have one of its descendant tags. Tag names are unique across the whole
+// tree.
 type TagClient interface {
 	// GetTag returns a single tag based on its ID.
 	GetTag(id TagID, retries ...RetryStrategy) (Tag, error)
 	// ListTags returns all tags on the oVirt engine.
 	ListTags(retries ...RetryStrategy) ([]Tag, error)
-	// CreateTag creates a new tag with a name.
+	// ListTagChildren returns the direct children of a tag. Pass RootTagID to list the top level tags.
+	ListTagChildren(id TagID, retries ...RetryStrategy) ([]Tag, error)
+	// CreateTag creates a new tag with a name. If no parent is passed in the params, the tag is created at the top
+	// level.
 	CreateTag(name string, params CreateTagParams, retries ...RetryStrategy) (result Tag, err error)
-	// RemoveTag removes the tag with the specified ID.
+	// UpdateTag renames a tag, changes its description, or moves it under a different parent. A tag cannot be
+	// moved under itself or one of its descendants.
+	UpdateTag(id TagID, params UpdateTagParameters, retries ...RetryStrategy) (Tag, error)
+	// RemoveTag removes the tag with the specified ID. The descendants of the tag are removed with it.
 	RemoveTag(tagID TagID, retries ...RetryStrategy) error
 }
 
@@ -30,17 +42,27 @@ type TagData interface {
 	Name() string
 	// Description returns the user-give description for this tag. It may be nil if no decription is set.
 	Description() *string
+	// ParentID returns the ID of the parent tag. It is nil if the tag is at the top level.
+	ParentID() *TagID
 }
 
 // Tag is the interface defining the fields for tag.
 type Tag interface {
 	TagData
 	Remove(retries ...RetryStrategy) error
+	// Update updates the current tag. See UpdateTag for details.
+	Update(params UpdateTagParameters, retries ...RetryStrategy) (Tag, error)
+	// ListChildren lists the direct children of the current tag.
+	ListChildren(retries ...RetryStrategy) ([]Tag, error)
+	// Parent fetches the parent tag. It returns nil if the tag is at the top level.
+	Parent(retries ...RetryStrategy) (Tag, error)
 }
 
 // CreateTagParams contains the optional parameters for tag creation.
 type CreateTagParams interface {
 	Description() *string
+	// ParentID returns the ID of the tag the new tag should be created under.
+	ParentID() *TagID
 }
 
 // BuildableTagParams is an buildable version of CreateTagParams.
@@ -49,6 +71,11 @@ type BuildableTagParams interface {
 
 	WithDescription(description string) (BuildableTagParams, error)
 	MustWithDescription(description string) BuildableTagParams
+
+	// WithParentID sets the ID of the tag the new tag should be created under.
+	WithParentID(parentID TagID) (BuildableTagParams, error)
+	// MustWithParentID is identical to WithParentID, but panics instead of returning an error.
+	MustWithParentID(parentID TagID) BuildableTagParams
 }
 
 // NewCreateTagParams creates a buildable set of CreateTagParams to pass to the CreateTag function.
@@ -58,6 +85,7 @@ func NewCreateTagParams() BuildableTagParams {
 
 type createTagParams struct {
 	description *string
+	parentID    *TagID
 }
 
 func (c *createTagParams) WithDescription(description string) (BuildableTagParams, error) {
@@ -73,10 +101,135 @@ func (c *createTagParams) MustWithDescription(description string) BuildableTagPa
 	return builder
 }
 
+func (c *createTagParams) WithParentID(parentID TagID) (BuildableTagParams, error) {
+	if err := validateTagParentID(parentID); err != nil {
+		return nil, err
+	}
+	c.parentID = &parentID
+	return c, nil
+}
+
+func (c *createTagParams) MustWithParentID(parentID TagID) BuildableTagParams {
+	builder, err := c.WithParentID(parentID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
 func (c *createTagParams) Description() *string {
 	return c.description
 }
 
+func (c *createTagParams) ParentID() *TagID {
+	return c.parentID
+}
+
+// UpdateTagParameters contains the fields of a tag that can be changed. Fields left nil are not changed.
+type UpdateTagParameters interface {
+	// Name returns the new name of the tag.
+	Name() *string
+	// Description returns the new description of the tag.
+	Description() *string
+	// ParentID returns the ID of the new parent tag. RootTagID moves the tag to the top level.
+	ParentID() *TagID
+}
+
+// BuildableUpdateTagParameters is a buildable version of UpdateTagParameters.
+type BuildableUpdateTagParameters interface {
+	UpdateTagParameters
+
+	// WithName sets the new name of the tag.
+	WithName(name string) (BuildableUpdateTagParameters, error)
+	// MustWithName is identical to WithName, but panics instead of returning an error.
+	MustWithName(name string) BuildableUpdateTagParameters
+
+	// WithDescription sets the new description of the tag.
+	WithDescription(description string) (BuildableUpdateTagParameters, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableUpdateTagParameters
+
+	// WithParentID moves the tag under a different parent. Pass RootTagID to move the tag to the top level.
+	WithParentID(parentID TagID) (BuildableUpdateTagParameters, error)
+	// MustWithParentID is identical to WithParentID, but panics instead of returning an error.
+	MustWithParentID(parentID TagID) BuildableUpdateTagParameters
+}
+
+// UpdateTagParams creates a buildable set of parameters for UpdateTag.
+func UpdateTagParams() BuildableUpdateTagParameters {
+	return &updateTagParams{}
+}
+
+type updateTagParams struct {
+	name        *string
+	description *string
+	parentID    *TagID
+}
+
+func (u *updateTagParams) Name() *string {
+	return u.name
+}
+
+func (u *updateTagParams) Description() *string {
+	return u.description
+}
+
+func (u *updateTagParams) ParentID() *TagID {
+	return u.parentID
+}
+
+func (u *updateTagParams) WithName(name string) (BuildableUpdateTagParameters, error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "the tag name must not be empty")
+	}
+	u.name = &name
+	return u, nil
+}
+
+func (u *updateTagParams) MustWithName(name string) BuildableUpdateTagParameters {
+	builder, err := u.WithName(name)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateTagParams) WithDescription(description string) (BuildableUpdateTagParameters, error) {
+	u.description = &description
+	return u, nil
+}
+
+func (u *updateTagParams) MustWithDescription(description string) BuildableUpdateTagParameters {
+	builder, err := u.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateTagParams) WithParentID(parentID TagID) (BuildableUpdateTagParameters, error) {
+	if err := validateTagParentID(parentID); err != nil {
+		return nil, err
+	}
+	u.parentID = &parentID
+	return u, nil
+}
+
+func (u *updateTagParams) MustWithParentID(parentID TagID) BuildableUpdateTagParameters {
+	builder, err := u.WithParentID(parentID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func validateTagParentID(parentID TagID) error {
+	if parentID == "" {
+		return newError(EBadArgument, "the parent tag ID must not be empty")
+	}
+	return nil
+}
+
 func convertSDKTag(sdkObject *ovirtsdk4.Tag, client *oVirtClient) (Tag, error) {
 	id, ok := sdkObject.Id()
 	if !ok {
@@ -91,11 +244,19 @@ func convertSDKTag(sdkObject *ovirtsdk4.Tag, client *oVirtClient) (Tag, error) {
 	if ok {
 		description = &desc
 	}
+	var parentID *TagID
+	if parent, ok := sdkObject.Parent(); ok {
+		if sdkParentID, ok := parent.Id(); ok && TagID(sdkParentID) != RootTagID {
+			p := TagID(sdkParentID)
+			parentID = &p
+		}
+	}
 	return &tag{
 		client:      client,
 		id:          TagID(id),
 		name:        name,
 		description: description,
+		parentID:    parentID,
 	}, nil
 }
 
@@ -104,6 +265,7 @@ type tag struct {
 	id          TagID
 	name        string
 	description *string
+	parentID    *TagID
 }
 
 func (n tag) ID() TagID {
@@ -118,6 +280,109 @@ func (n tag) Description() *string {
 	return n.description
 }
 
+func (n tag) ParentID() *TagID {
+	return n.parentID
+}
+
 func (n *tag) Remove(retries ...RetryStrategy) error {
 	return n.client.RemoveTag(n.id, retries...)
 }
+
+func (n *tag) Update(params UpdateTagParameters, retries ...RetryStrategy) (Tag, error) {
+	return n.client.UpdateTag(n.id, params, retries...)
+}
+
+func (n *tag) ListChildren(retries ...RetryStrategy) ([]Tag, error) {
+	return n.client.ListTagChildren(n.id, retries...)
+}
+
+func (n *tag) Parent(retries ...RetryStrategy) (Tag, error) {
+	if n.parentID == nil {
+		return nil, nil
+	}
+	return n.client.GetTag(*n.parentID, retries...)
+}
+
+// validateMockTagName returns an error if another tag than the one specified already has the name. The caller must
+// hold the lock of the mock client.
+func (m *mockClient) validateMockTagName(name string, id TagID) error {
+	if name == "" {
+		return newError(EBadArgument, "the tag name must not be empty")
+	}
+	for _, t := range m.tags {
+		if t.name == name && t.id != id {
+			return newError(EConflict, "a tag with the name \"%s\" already exists", name)
+		}
+	}
+	return nil
+}
+
+// mockTagParentID converts a parent ID passed in the parameters into the stored form, where top level tags have no
+// parent. The caller must hold the lock of the mock client.
+func (m *mockClient) mockTagParentID(parentID *TagID) (*TagID, error) {
+	if parentID == nil || *parentID == RootTagID {
+		return nil, nil
+	}
+	if _, ok := m.tags[*parentID]; !ok {
+		return nil, newError(ENotFound, "parent tag with ID %s not found", *parentID)
+	}
+	p := *parentID
+	return &p, nil
+}
+
+// mockTagWithDescendants returns the ID of the tag and the IDs of all tags below it. The caller must hold the lock of
+// the mock client.
+func (m *mockClient) mockTagWithDescendants(id TagID) map[TagID]bool {
+	result := map[TagID]bool{id: true}
+	for {
+		added := false
+		for _, t := range m.tags {
+			if t.parentID != nil && result[*t.parentID] && !result[t.id] {
+				result[t.id] = true
+				added = true
+			}
+		}
+		if !added {
+			return result
+		}
+	}
+}
+
+// hasMockTagMatching returns true if any of the tag IDs refers to a tag with the specified name or one of its
+// descendants. The caller must hold the lock of the mock client.
+func (m *mockClient) hasMockTagMatching(tagIDs []TagID, name string) bool {
+	for _, t := range m.tags {
+		if t.name != name {
+			continue
+		}
+		matching := m.mockTagWithDescendants(t.id)
+		for _, tagID := range tagIDs {
+			if matching[tagID] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// addMockTagID adds the tag ID to the list unless it is already present.
+func addMockTagID(tagIDs []TagID, tagID TagID) []TagID {
+	for _, existingTagID := range tagIDs {
+		if existingTagID == tagID {
+			return tagIDs
+		}
+	}
+	return append(tagIDs, tagID)
+}
+
+// removeMockTagID removes the tag ID from the list. It returns false if the tag ID was not in the list.
+func removeMockTagID(tagIDs []TagID, tagID TagID) ([]TagID, bool) {
+	for i, existingTagID := range tagIDs {
+		if existingTagID == tagID {
+			result := make([]TagID, 0, len(tagIDs)-1)
+			result = append(result, tagIDs[:i]...)
+			return append(result, tagIDs[i+1:]...), true
+		}
+	}
+	return tagIDs, false
+}
@@ -19,6 +19,9 @@ func (o *oVirtClient) CreateTag(name string, params CreateTagParams, retries ...
 			if description := params.Description(); description != nil {
 				tagBuilder.Description(*description)
 			}
+			if parentID := params.ParentID(); parentID != nil {
+				tagBuilder.ParentBuilder(ovirtsdk.NewTagBuilder().Id(string(*parentID)))
+			}
 			response, e := o.conn.SystemService().TagsService().Add().Tag(tagBuilder.MustBuild()).Send()
 			if e != nil {
 				return e
@@ -49,11 +52,19 @@ func (m *mockClient) CreateTag(name string, params CreateTagParams, _ ...RetrySt
 	if params == nil {
 		params = NewCreateTagParams()
 	}
+	if err := m.validateMockTagName(name, id); err != nil {
+		return nil, err
+	}
+	parentID, err := m.mockTagParentID(params.ParentID())
+	if err != nil {
+		return nil, err
+	}
 	tag := &tag{
 		client:      m,
 		id:          id,
 		name:        name,
 		description: params.Description(),
+		parentID:    parentID,
 	}
 	m.tags[id] = tag
 
@@ -0,0 +1,72 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListTagChildren(id TagID, retries ...RetryStrategy) (result []Tag, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("listing children of tag %s", id),
+		o.logger,
+		retries,
+		func() error {
+			// The tags API has no sub-collection for children, so we list all tags and filter them by parent.
+			response, err := o.conn.SystemService().TagsService().List().Send()
+			if err != nil {
+				return err
+			}
+			sdkObjects, ok := response.Tags()
+			if !ok {
+				return nil
+			}
+			result = []Tag{}
+			for _, sdkObject := range sdkObjects.Slice() {
+				t, err := convertSDKTag(sdkObject, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert tag")
+				}
+				if tagHasParent(t, id) {
+					result = append(result, t)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) ListTagChildren(id TagID, retries ...RetryStrategy) (result []Tag, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	err = retry(
+		fmt.Sprintf("listing children of tag %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, ok := m.tags[id]; !ok && id != RootTagID {
+				return newError(ENotFound, "tag with ID %s not found", id)
+			}
+			result = []Tag{}
+			for _, t := range m.tags {
+				if tagHasParent(t, id) {
+					result = append(result, t)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+// tagHasParent returns true if the tag is a direct child of the specified parent. The root tag itself is not a
+// child of any tag.
+func tagHasParent(t Tag, parentID TagID) bool {
+	if t.ID() == RootTagID {
+		return false
+	}
+	if t.ParentID() == nil {
+		return parentID == RootTagID
+	}
+	return *t.ParentID() == parentID
+}
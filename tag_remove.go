@@ -25,18 +25,23 @@ func (m *mockClient) RemoveTag(id TagID, _ ...RetryStrategy) (err error) {
 		return newError(ENotFound, "Tag with ID %s not found", id)
 	}
 
-	// remove the tag from all the VMs.
-	for _, vm := range m.vms {
-		for i, tagID := range vm.tagIDs {
-			if tagID == id {
-				// gocritic will complain on the following line due to appendAssign, but that's legit here
-				m.vms[vm.id].tagIDs = append(vm.tagIDs[:i], vm.tagIDs[i+1:]...) //nolint:gocritic
-				break
+	// The engine removes the descendants of the tag together with the tag.
+	for tagID := range m.mockTagWithDescendants(id) {
+		for vmID, vm := range m.vms {
+			if tagIDs, ok := removeMockTagID(vm.tagIDs, tagID); ok {
+				updated := vm.clone()
+				updated.tagIDs = tagIDs
+				m.vms[vmID] = updated
 			}
 		}
+		for hostID, tagIDs := range m.tagIDsByHost {
+			m.tagIDsByHost[hostID], _ = removeMockTagID(tagIDs, tagID)
+		}
+		for templateID, tagIDs := range m.tagIDsByTemplate {
+			m.tagIDsByTemplate[templateID], _ = removeMockTagID(tagIDs, tagID)
+		}
+		delete(m.tags, tagID)
 	}
 
-	delete(m.tags, id)
-
 	return nil
 }
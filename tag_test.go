@@ -152,3 +152,134 @@ func TestAddTagToVMByName(t *testing.T) {
 		t.Fatalf("Incorrect number of VMs returned (%d)", len(vms))
 	}
 }
+
+func TestTagHierarchy(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	parent := assertCanCreateTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), "")
+	child := assertCanCreateChildTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), parent.ID())
+	grandchild := assertCanCreateChildTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), child.ID())
+
+	if parent.ParentID() != nil {
+		t.Fatalf("Top level tag %s has a parent (%s).", parent.ID(), *parent.ParentID())
+	}
+	if grandchild.ParentID() == nil || *grandchild.ParentID() != child.ID() {
+		t.Fatalf("Incorrect parent of tag %s (expected: %s).", grandchild.ID(), child.ID())
+	}
+	children, err := parent.ListChildren()
+	if err != nil {
+		t.Fatalf("Failed to list children of tag %s (%v)", parent.ID(), err)
+	}
+	if len(children) != 1 || children[0].ID() != child.ID() {
+		t.Fatalf("Incorrect children of tag %s (expected only %s, got %d tags).", parent.ID(), child.ID(), len(children))
+	}
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	assertCanAddTagToVM(t, vm, grandchild)
+	vms, err := client.SearchVMs(ovirtclient.VMSearchParams().WithTag(parent.Name()))
+	if err != nil {
+		t.Fatalf("Failed to search for VM by parent tag (%v)", err)
+	}
+	if len(vms) != 1 || vms[0].ID() != vm.ID() {
+		t.Fatalf("Searching by the parent tag did not return the VM with the grandchild tag (got %d VMs).", len(vms))
+	}
+
+	if err := parent.Remove(); err != nil {
+		t.Fatalf("Failed to remove tag %s (%v)", parent.ID(), err)
+	}
+	if _, err := client.GetTag(grandchild.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Removing the parent tag did not remove the grandchild tag (%v)", err)
+	}
+}
+
+func TestTagUpdate(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	parent := assertCanCreateTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), "")
+	tag := assertCanCreateTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), "")
+	newName := fmt.Sprintf("test-%s", helper.GenerateRandomID(5))
+
+	updatedTag, err := tag.Update(
+		ovirtclient.UpdateTagParams().
+			MustWithName(newName).
+			MustWithDescription("Hello world!").
+			MustWithParentID(parent.ID()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to update tag %s (%v)", tag.ID(), err)
+	}
+	if updatedTag.Name() != newName {
+		t.Fatalf("Tag name was not updated (expected: %s, got: %s).", newName, updatedTag.Name())
+	}
+	if updatedTag.Description() == nil || *updatedTag.Description() != "Hello world!" {
+		t.Fatalf("Tag description was not updated.")
+	}
+	if updatedTag.ParentID() == nil || *updatedTag.ParentID() != parent.ID() {
+		t.Fatalf("Tag %s was not moved under tag %s.", tag.ID(), parent.ID())
+	}
+
+	updatedTag, err = updatedTag.Update(ovirtclient.UpdateTagParams().MustWithParentID(ovirtclient.RootTagID))
+	if err != nil {
+		t.Fatalf("Failed to move tag %s to the top level (%v)", tag.ID(), err)
+	}
+	if updatedTag.ParentID() != nil {
+		t.Fatalf("Tag %s was not moved to the top level.", tag.ID())
+	}
+}
+
+func TestTagCannotBeMovedUnderDescendant(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	parent := assertCanCreateTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), "")
+	child := assertCanCreateChildTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), parent.ID())
+
+	if _, err := parent.Update(ovirtclient.UpdateTagParams().MustWithParentID(child.ID())); err == nil {
+		t.Fatalf("Moving tag %s under its child %s did not result in an error.", parent.ID(), child.ID())
+	}
+	if _, err := parent.Update(ovirtclient.UpdateTagParams().MustWithParentID(parent.ID())); err == nil {
+		t.Fatalf("Moving tag %s under itself did not result in an error.", parent.ID())
+	}
+}
+
+func TestTagNamesAreUnique(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	name := fmt.Sprintf("test-%s", helper.GenerateRandomID(5))
+	tag := assertCanCreateTag(t, helper, name, "")
+	other := assertCanCreateTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), "")
+
+	if duplicate, err := client.CreateTag(name, nil); err == nil {
+		_ = duplicate.Remove()
+		t.Fatalf("Creating a second tag with the name of tag %s did not result in an error.", tag.ID())
+	}
+	if _, err := other.Update(ovirtclient.UpdateTagParams().MustWithName(name), ovirtclient.MaxTries(1)); err == nil {
+		t.Fatalf("Renaming tag %s to the name of tag %s did not result in an error.", other.ID(), tag.ID())
+	}
+}
+
+func assertCanCreateChildTag(
+	t *testing.T,
+	helper ovirtclient.TestHelper,
+	name string,
+	parentID ovirtclient.TagID,
+) ovirtclient.Tag {
+	tag, err := helper.GetClient().CreateTag(name, ovirtclient.NewCreateTagParams().MustWithParentID(parentID))
+	if err != nil {
+		t.Fatalf("Failed to create tag %s under tag %s (%v)", name, parentID, err)
+	}
+	t.Cleanup(
+		func() {
+			t.Logf("Cleaning up test tag %s...", tag.ID())
+			if err := tag.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+				t.Fatalf("Failed to remove test tag %s (%v)", tag.ID(), err)
+			}
+		},
+	)
+	return tag
+}
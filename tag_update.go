@@ -0,0 +1,110 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) UpdateTag(
+	id TagID,
+	params UpdateTagParameters,
+	retries ...RetryStrategy,
+) (result Tag, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	tagBuilder := ovirtsdk.NewTagBuilder().Id(string(id))
+	if name := params.Name(); name != nil {
+		tagBuilder.Name(*name)
+	}
+	if description := params.Description(); description != nil {
+		tagBuilder.Description(*description)
+	}
+	if parentID := params.ParentID(); parentID != nil {
+		tagBuilder.ParentBuilder(ovirtsdk.NewTagBuilder().Id(string(*parentID)))
+	}
+	sdkTag, err := tagBuilder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build tag")
+	}
+	err = retry(
+		fmt.Sprintf("updating tag %s", id),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				TagsService().
+				TagService(string(id)).
+				Update().
+				Tag(sdkTag).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Tag()
+			if !ok {
+				return newError(EFieldMissing, "missing tag in tag update response")
+			}
+			result, err = convertSDKTag(sdkObject, o)
+			if err != nil {
+				return wrap(
+					err,
+					EBug,
+					"failed to convert tag %s",
+					id,
+				)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) UpdateTag(id TagID, params UpdateTagParameters, retries ...RetryStrategy) (result Tag, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	err = retry(
+		fmt.Sprintf("updating tag %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			t, ok := m.tags[id]
+			if !ok {
+				return newError(ENotFound, "tag with ID %s not found", id)
+			}
+			if name := params.Name(); name != nil {
+				if err := m.validateMockTagName(*name, id); err != nil {
+					return err
+				}
+			}
+			parentID := t.parentID
+			if newParentID := params.ParentID(); newParentID != nil {
+				if m.mockTagWithDescendants(id)[*newParentID] {
+					return newError(
+						EBadArgument,
+						"tag %s cannot be moved under itself or one of its descendants (%s)",
+						id,
+						*newParentID,
+					)
+				}
+				var err error
+				if parentID, err = m.mockTagParentID(newParentID); err != nil {
+					return err
+				}
+			}
+
+			updated := *t
+			if name := params.Name(); name != nil {
+				updated.name = *name
+			}
+			if description := params.Description(); description != nil {
+				updated.description = description
+			}
+			updated.parentID = parentID
+			m.tags[id] = &updated
+			result = &updated
+			return nil
+		})
+	return
+}
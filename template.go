@@ -8,7 +8,8 @@ import (
 
 // TemplateClient represents the portion of the client that deals with VM templates.
 type TemplateClient interface {
-	// CreateTemplate creates a new template from an existing VM.
+	// CreateTemplate creates a new template from an existing VM. If a base template ID is passed in the parameters,
+	// the new template is created as a new version of the base template and inherits its name.
 	CreateTemplate(vmID VMID, name string, params OptionalTemplateCreateParameters, retries ...RetryStrategy) (
 		Template,
 		error,
@@ -28,6 +29,15 @@ type TemplateClient interface {
 	WaitForTemplateStatus(templateID TemplateID, status TemplateStatus, retries ...RetryStrategy) (Template, error)
 	// CopyTemplateDiskToStorageDomain copies template disk to the specified storage domain.
 	CopyTemplateDiskToStorageDomain(diskID DiskID, storageDomainID StorageDomainID, retries ...RetryStrategy) (Disk, error)
+	// UpdateTemplate updates the name, description, CPU or memory of a template. Since all versions of a template
+	// share the name of the base template, the name can only be changed on the base template.
+	UpdateTemplate(id TemplateID, params UpdateTemplateParameters, retries ...RetryStrategy) (Template, error)
+	// AddTagToTemplate adds the specified tag to a template.
+	AddTagToTemplate(id TemplateID, tagID TagID, retries ...RetryStrategy) error
+	// RemoveTagFromTemplate removes the specified tag from a template.
+	RemoveTagFromTemplate(id TemplateID, tagID TagID, retries ...RetryStrategy) error
+	// ListTemplateTags lists the tags attached to a template.
+	ListTemplateTags(id TemplateID, retries ...RetryStrategy) ([]Tag, error)
 }
 
 // TemplateID is an identifier for a template. It has a special type so the compiler
@@ -46,6 +56,16 @@ type TemplateData interface {
 	Status() TemplateStatus
 	// CPU returns the CPU configuration of the template if any.
 	CPU() VMCPU
+	// Memory returns the memory size of the template in bytes.
+	Memory() int64
+	// VersionNumber returns the version of the template within its template family. The base template has the
+	// version number 1.
+	VersionNumber() uint
+	// VersionName returns the optional name of the template version.
+	VersionName() string
+	// BaseTemplateID returns the ID of the base template of the template family. For the base template itself this
+	// is the ID of the template.
+	BaseTemplateID() TemplateID
 
 	// IsBlank returns true, if the template either has the ID of all zeroes, or if the template has no settings, disks,
 	// or other settings. This function only checks the details supported by go-ovirt-client.
@@ -62,8 +82,18 @@ type Template interface {
 	WaitForStatus(status TemplateStatus, retries ...RetryStrategy) (Template, error)
 	// ListDiskAttachments lists all disk attachments for the current template.
 	ListDiskAttachments(retries ...RetryStrategy) ([]TemplateDiskAttachment, error)
+	// BaseTemplate fetches the base template of the template family.
+	BaseTemplate(retries ...RetryStrategy) (Template, error)
+	// Update updates the template. See UpdateTemplate for details.
+	Update(params UpdateTemplateParameters, retries ...RetryStrategy) (Template, error)
 	// Remove removes the specified template.
 	Remove(retries ...RetryStrategy) error
+	// AddTag adds the specified tag to the current template.
+	AddTag(tagID TagID, retries ...RetryStrategy) error
+	// RemoveTag removes the specified tag from the current template.
+	RemoveTag(tagID TagID, retries ...RetryStrategy) error
+	// ListTags lists the tags attached to the current template.
+	ListTags(retries ...RetryStrategy) ([]Tag, error)
 }
 
 // TemplateStatus represents the status the template is in.
@@ -82,6 +112,17 @@ const (
 // OptionalTemplateCreateParameters contains the optional parameters for creating a template.
 type OptionalTemplateCreateParameters interface {
 	Description() *string
+	// BaseTemplateID returns the ID of the base template if the new template should be created as a new version
+	// of an existing template.
+	BaseTemplateID() *TemplateID
+	// VersionName returns the name of the template version.
+	VersionName() *string
+	// Seal returns true if the template should be sealed. Sealing removes machine-specific configuration, such as
+	// SSH host keys, from the template disks. This is only supported for Linux templates.
+	Seal() *bool
+	// Disks returns the VM disks that should be included in the template. If empty, all disks of the VM are
+	// included.
+	Disks() []TemplateDiskParameters
 }
 
 // BuildableTemplateCreateParameters is a buildable version of OptionalTemplateCreateParameters.
@@ -92,10 +133,130 @@ type BuildableTemplateCreateParameters interface {
 	WithDescription(description string) (BuildableTemplateCreateParameters, error)
 	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
 	MustWithDescription(description string) BuildableTemplateCreateParameters
+
+	// WithBaseTemplateID creates the template as a new version of the specified base template. The base template
+	// must not itself be a version of another template.
+	WithBaseTemplateID(baseTemplateID TemplateID) (BuildableTemplateCreateParameters, error)
+	// MustWithBaseTemplateID is identical to WithBaseTemplateID, but panics instead of returning an error.
+	MustWithBaseTemplateID(baseTemplateID TemplateID) BuildableTemplateCreateParameters
+
+	// WithVersionName sets the name of the template version.
+	WithVersionName(versionName string) (BuildableTemplateCreateParameters, error)
+	// MustWithVersionName is identical to WithVersionName, but panics instead of returning an error.
+	MustWithVersionName(versionName string) BuildableTemplateCreateParameters
+
+	// WithSeal enables or disables sealing the template. This is only supported for Linux templates.
+	WithSeal(seal bool) (BuildableTemplateCreateParameters, error)
+	// MustWithSeal is identical to WithSeal, but panics instead of returning an error.
+	MustWithSeal(seal bool) BuildableTemplateCreateParameters
+
+	// WithDisks selects the VM disks that should be included in the template, and optionally the storage domain
+	// and format each disk should be created with. Each disk may only appear once.
+	WithDisks(disks []TemplateDiskParameters) (BuildableTemplateCreateParameters, error)
+	// MustWithDisks is identical to WithDisks, but panics instead of returning an error.
+	MustWithDisks(disks []TemplateDiskParameters) BuildableTemplateCreateParameters
+}
+
+// TemplateDiskParameters describes how a disk of the VM should be included in a template.
+type TemplateDiskParameters interface {
+	// DiskID returns the ID of the VM disk to include in the template.
+	DiskID() DiskID
+	// StorageDomainID returns the storage domain the template disk should be created on. If nil, the storage
+	// domain of the VM disk is used.
+	StorageDomainID() *StorageDomainID
+	// Format returns the image format of the template disk. If nil, the format of the VM disk is used.
+	Format() *ImageFormat
+}
+
+// BuildableTemplateDiskParameters is a buildable version of TemplateDiskParameters.
+type BuildableTemplateDiskParameters interface {
+	TemplateDiskParameters
+
+	// WithStorageDomainID sets the storage domain the template disk should be created on.
+	WithStorageDomainID(storageDomainID StorageDomainID) (BuildableTemplateDiskParameters, error)
+	// MustWithStorageDomainID is identical to WithStorageDomainID, but panics instead of returning an error.
+	MustWithStorageDomainID(storageDomainID StorageDomainID) BuildableTemplateDiskParameters
+
+	// WithFormat sets the image format of the template disk.
+	WithFormat(format ImageFormat) (BuildableTemplateDiskParameters, error)
+	// MustWithFormat is identical to WithFormat, but panics instead of returning an error.
+	MustWithFormat(format ImageFormat) BuildableTemplateDiskParameters
+}
+
+// NewTemplateDiskParams creates a buildable set of parameters to include the specified VM disk in a template.
+func NewTemplateDiskParams(diskID DiskID) (BuildableTemplateDiskParameters, error) {
+	if diskID == "" {
+		return nil, newError(EBadArgument, "the disk ID must not be empty")
+	}
+	return &templateDiskParams{
+		diskID: diskID,
+	}, nil
+}
+
+// MustNewTemplateDiskParams is identical to NewTemplateDiskParams, but panics instead of returning an error.
+func MustNewTemplateDiskParams(diskID DiskID) BuildableTemplateDiskParameters {
+	builder, err := NewTemplateDiskParams(diskID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+type templateDiskParams struct {
+	diskID          DiskID
+	storageDomainID *StorageDomainID
+	format          *ImageFormat
+}
+
+func (t *templateDiskParams) DiskID() DiskID {
+	return t.diskID
+}
+
+func (t *templateDiskParams) StorageDomainID() *StorageDomainID {
+	return t.storageDomainID
+}
+
+func (t *templateDiskParams) Format() *ImageFormat {
+	return t.format
+}
+
+func (t *templateDiskParams) WithStorageDomainID(
+	storageDomainID StorageDomainID,
+) (BuildableTemplateDiskParameters, error) {
+	t.storageDomainID = &storageDomainID
+	return t, nil
+}
+
+func (t *templateDiskParams) MustWithStorageDomainID(storageDomainID StorageDomainID) BuildableTemplateDiskParameters {
+	builder, err := t.WithStorageDomainID(storageDomainID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (t *templateDiskParams) WithFormat(format ImageFormat) (BuildableTemplateDiskParameters, error) {
+	if err := format.Validate(); err != nil {
+		return nil, err
+	}
+	t.format = &format
+	return t, nil
+}
+
+func (t *templateDiskParams) MustWithFormat(format ImageFormat) BuildableTemplateDiskParameters {
+	builder, err := t.WithFormat(format)
+	if err != nil {
+		panic(err)
+	}
+	return builder
 }
 
 type templateCreateParameters struct {
-	description *string
+	description    *string
+	baseTemplateID *TemplateID
+	versionName    *string
+	seal           *bool
+	disks          []TemplateDiskParameters
 }
 
 func (t templateCreateParameters) Description() *string {
@@ -115,11 +276,227 @@ func (t templateCreateParameters) MustWithDescription(description string) Builda
 	return builder
 }
 
+func (t templateCreateParameters) BaseTemplateID() *TemplateID {
+	return t.baseTemplateID
+}
+
+func (t templateCreateParameters) WithBaseTemplateID(
+	baseTemplateID TemplateID,
+) (BuildableTemplateCreateParameters, error) {
+	if baseTemplateID == "" {
+		return nil, newError(EBadArgument, "the base template ID must not be empty")
+	}
+	t.baseTemplateID = &baseTemplateID
+	return t, nil
+}
+
+func (t templateCreateParameters) MustWithBaseTemplateID(baseTemplateID TemplateID) BuildableTemplateCreateParameters {
+	builder, err := t.WithBaseTemplateID(baseTemplateID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (t templateCreateParameters) VersionName() *string {
+	return t.versionName
+}
+
+func (t templateCreateParameters) WithVersionName(versionName string) (BuildableTemplateCreateParameters, error) {
+	t.versionName = &versionName
+	return t, nil
+}
+
+func (t templateCreateParameters) MustWithVersionName(versionName string) BuildableTemplateCreateParameters {
+	builder, err := t.WithVersionName(versionName)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (t templateCreateParameters) Seal() *bool {
+	return t.seal
+}
+
+func (t templateCreateParameters) WithSeal(seal bool) (BuildableTemplateCreateParameters, error) {
+	t.seal = &seal
+	return t, nil
+}
+
+func (t templateCreateParameters) MustWithSeal(seal bool) BuildableTemplateCreateParameters {
+	builder, err := t.WithSeal(seal)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (t templateCreateParameters) Disks() []TemplateDiskParameters {
+	return t.disks
+}
+
+func (t templateCreateParameters) WithDisks(disks []TemplateDiskParameters) (BuildableTemplateCreateParameters, error) {
+	if err := validateTemplateDisks(disks); err != nil {
+		return nil, err
+	}
+	t.disks = disks
+	return t, nil
+}
+
+func (t templateCreateParameters) MustWithDisks(disks []TemplateDiskParameters) BuildableTemplateCreateParameters {
+	builder, err := t.WithDisks(disks)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func validateTemplateDisks(disks []TemplateDiskParameters) error {
+	diskIDs := map[DiskID]int{}
+	for i, d := range disks {
+		if previousID, ok := diskIDs[d.DiskID()]; ok {
+			return newError(
+				EBadArgument,
+				"Disk %s appears twice, in position %d and %d.",
+				d.DiskID(),
+				previousID,
+				i,
+			)
+		}
+		diskIDs[d.DiskID()] = i
+	}
+	return nil
+}
+
 // TemplateCreateParams creates a builder for the parameters of the template creation.
 func TemplateCreateParams() BuildableTemplateCreateParameters {
 	return &templateCreateParameters{}
 }
 
+// UpdateTemplateParameters contains the parameters for updating a template. Fields returning nil are not changed.
+type UpdateTemplateParameters interface {
+	// Name returns the new name of the template.
+	Name() *string
+	// Description returns the new description of the template.
+	Description() *string
+	// CPU returns the new CPU configuration of the template.
+	CPU() VMCPUParams
+	// Memory returns the new memory size of the template in bytes.
+	Memory() *int64
+}
+
+// BuildableUpdateTemplateParameters is a buildable version of UpdateTemplateParameters.
+type BuildableUpdateTemplateParameters interface {
+	UpdateTemplateParameters
+
+	// WithName changes the name of the template.
+	WithName(name string) (BuildableUpdateTemplateParameters, error)
+	// MustWithName is identical to WithName, but panics instead of returning an error.
+	MustWithName(name string) BuildableUpdateTemplateParameters
+
+	// WithDescription changes the description of the template.
+	WithDescription(description string) (BuildableUpdateTemplateParameters, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableUpdateTemplateParameters
+
+	// WithCPU changes the CPU configuration of the template.
+	WithCPU(cpu VMCPUParams) (BuildableUpdateTemplateParameters, error)
+	// MustWithCPU is identical to WithCPU, but panics instead of returning an error.
+	MustWithCPU(cpu VMCPUParams) BuildableUpdateTemplateParameters
+
+	// WithMemory changes the memory size of the template in bytes.
+	WithMemory(memory int64) (BuildableUpdateTemplateParameters, error)
+	// MustWithMemory is identical to WithMemory, but panics instead of returning an error.
+	MustWithMemory(memory int64) BuildableUpdateTemplateParameters
+}
+
+// UpdateTemplateParams creates a builder for the parameters of the template update.
+func UpdateTemplateParams() BuildableUpdateTemplateParameters {
+	return &updateTemplateParams{}
+}
+
+type updateTemplateParams struct {
+	name        *string
+	description *string
+	cpu         VMCPUParams
+	memory      *int64
+}
+
+func (u *updateTemplateParams) Name() *string {
+	return u.name
+}
+
+func (u *updateTemplateParams) Description() *string {
+	return u.description
+}
+
+func (u *updateTemplateParams) CPU() VMCPUParams {
+	return u.cpu
+}
+
+func (u *updateTemplateParams) Memory() *int64 {
+	return u.memory
+}
+
+func (u *updateTemplateParams) WithName(name string) (BuildableUpdateTemplateParameters, error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "the template name must not be empty")
+	}
+	u.name = &name
+	return u, nil
+}
+
+func (u *updateTemplateParams) MustWithName(name string) BuildableUpdateTemplateParameters {
+	builder, err := u.WithName(name)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateTemplateParams) WithDescription(description string) (BuildableUpdateTemplateParameters, error) {
+	u.description = &description
+	return u, nil
+}
+
+func (u *updateTemplateParams) MustWithDescription(description string) BuildableUpdateTemplateParameters {
+	builder, err := u.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateTemplateParams) WithCPU(cpu VMCPUParams) (BuildableUpdateTemplateParameters, error) {
+	u.cpu = cpu
+	return u, nil
+}
+
+func (u *updateTemplateParams) MustWithCPU(cpu VMCPUParams) BuildableUpdateTemplateParameters {
+	builder, err := u.WithCPU(cpu)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateTemplateParams) WithMemory(memory int64) (BuildableUpdateTemplateParameters, error) {
+	if memory <= 0 {
+		return nil, newError(EBadArgument, "the template memory must be positive (%d given)", memory)
+	}
+	u.memory = &memory
+	return u, nil
+}
+
+func (u *updateTemplateParams) MustWithMemory(memory int64) BuildableUpdateTemplateParameters {
+	builder, err := u.WithMemory(memory)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
 func convertSDKTemplate(sdkTemplate *ovirtsdk.Template, client Client) (Template, error) {
 	id, ok := sdkTemplate.Id()
 	if !ok {
@@ -138,14 +515,30 @@ func convertSDKTemplate(sdkTemplate *ovirtsdk.Template, client Client) (Template
 	if err != nil {
 		return nil, err
 	}
-	return &template{
-		client:      client,
-		id:          TemplateID(id),
-		name:        name,
-		status:      TemplateStatus(status),
-		description: description,
-		cpu:         cpu,
-	}, nil
+	memory, _ := sdkTemplate.Memory()
+	result := &template{
+		client:         client,
+		id:             TemplateID(id),
+		name:           name,
+		status:         TemplateStatus(status),
+		description:    description,
+		cpu:            cpu,
+		memory:         memory,
+		versionNumber:  1,
+		baseTemplateID: TemplateID(id),
+	}
+	if version, ok := sdkTemplate.Version(); ok {
+		if versionNumber, ok := version.VersionNumber(); ok {
+			result.versionNumber = uint(versionNumber)
+		}
+		result.versionName, _ = version.VersionName()
+		if baseTemplate, ok := version.BaseTemplate(); ok {
+			if baseTemplateID, ok := baseTemplate.Id(); ok {
+				result.baseTemplateID = TemplateID(baseTemplateID)
+			}
+		}
+	}
+	return result, nil
 }
 
 func convertSDKTemplateCPU(sdkObject *ovirtsdk.Template) (*vmCPU, error) {
@@ -180,12 +573,16 @@ func convertSDKTemplateCPU(sdkObject *ovirtsdk.Template) (*vmCPU, error) {
 }
 
 type template struct {
-	client      Client
-	id          TemplateID
-	name        string
-	description string
-	status      TemplateStatus
-	cpu         *vmCPU
+	client         Client
+	id             TemplateID
+	name           string
+	description    string
+	status         TemplateStatus
+	cpu            *vmCPU
+	memory         int64
+	versionNumber  uint
+	versionName    string
+	baseTemplateID TemplateID
 }
 
 func (t template) ListDiskAttachments(retries ...RetryStrategy) ([]TemplateDiskAttachment, error) {
@@ -196,6 +593,30 @@ func (t template) CPU() VMCPU {
 	return t.cpu
 }
 
+func (t template) Memory() int64 {
+	return t.memory
+}
+
+func (t template) VersionNumber() uint {
+	return t.versionNumber
+}
+
+func (t template) VersionName() string {
+	return t.versionName
+}
+
+func (t template) BaseTemplateID() TemplateID {
+	return t.baseTemplateID
+}
+
+func (t template) BaseTemplate(retries ...RetryStrategy) (Template, error) {
+	return t.client.GetTemplate(t.baseTemplateID, retries...)
+}
+
+func (t template) Update(params UpdateTemplateParameters, retries ...RetryStrategy) (Template, error) {
+	return t.client.UpdateTemplate(t.id, params, retries...)
+}
+
 func (t template) Status() TemplateStatus {
 	return t.status
 }
@@ -204,6 +625,18 @@ func (t template) WaitForStatus(status TemplateStatus, retries ...RetryStrategy)
 	return t.client.WaitForTemplateStatus(t.id, status, retries...)
 }
 
+func (t template) AddTag(tagID TagID, retries ...RetryStrategy) error {
+	return t.client.AddTagToTemplate(t.id, tagID, retries...)
+}
+
+func (t template) RemoveTag(tagID TagID, retries ...RetryStrategy) error {
+	return t.client.RemoveTagFromTemplate(t.id, tagID, retries...)
+}
+
+func (t template) ListTags(retries ...RetryStrategy) ([]Tag, error) {
+	return t.client.ListTemplateTags(t.id, retries...)
+}
+
 func (t template) Remove(retries ...RetryStrategy) error {
 	return t.client.RemoveTemplate(t.id, retries...)
 }
@@ -2,6 +2,7 @@ package ovirtclient
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	ovirtsdk "github.com/ovirt/go-ovirt"
@@ -17,18 +18,23 @@ func (o *oVirtClient) CreateTemplate(
 	if params == nil {
 		params = &templateCreateParameters{}
 	}
+	if err := validateTemplateDisks(params.Disks()); err != nil {
+		return nil, err
+	}
+	sdkTemplate, err := buildSDKTemplate(vmID, name, params)
+	if err != nil {
+		return nil, err
+	}
 	err = retry(
 		fmt.Sprintf("creating template from VM %s", vmID),
 		o.logger,
 		retries,
 		func() error {
-			tpl := ovirtsdk.NewTemplateBuilder()
-			tpl.VmBuilder(ovirtsdk.NewVmBuilder().Id(string(vmID)))
-			tpl.Name(name)
-			if desc := params.Description(); desc != nil {
-				tpl.Description(*desc)
+			request := o.conn.SystemService().TemplatesService().Add().Template(sdkTemplate)
+			if seal := params.Seal(); seal != nil {
+				request.Seal(*seal)
 			}
-			response, err := o.conn.SystemService().TemplatesService().Add().Template(tpl.MustBuild()).Send()
+			response, err := request.Send()
 			if err != nil {
 				return err
 			}
@@ -54,6 +60,50 @@ func (o *oVirtClient) CreateTemplate(
 	return result, err
 }
 
+func buildSDKTemplate(vmID VMID, name string, params OptionalTemplateCreateParameters) (*ovirtsdk.Template, error) {
+	tpl := ovirtsdk.NewTemplateBuilder()
+	tpl.VmBuilder(ovirtsdk.NewVmBuilder().Id(string(vmID)))
+	tpl.Name(name)
+	if desc := params.Description(); desc != nil {
+		tpl.Description(*desc)
+	}
+	baseTemplateID := params.BaseTemplateID()
+	versionName := params.VersionName()
+	if baseTemplateID != nil || versionName != nil {
+		versionBuilder := ovirtsdk.NewTemplateVersionBuilder()
+		if baseTemplateID != nil {
+			versionBuilder.BaseTemplateBuilder(ovirtsdk.NewTemplateBuilder().Id(string(*baseTemplateID)))
+		}
+		if versionName != nil {
+			versionBuilder.VersionName(*versionName)
+		}
+		tpl.VersionBuilder(versionBuilder)
+	}
+	if disks := params.Disks(); len(disks) > 0 {
+		diskAttachments := make([]*ovirtsdk.DiskAttachment, len(disks))
+		for i, d := range disks {
+			diskBuilder := ovirtsdk.NewDiskBuilder().Id(string(d.DiskID()))
+			if format := d.Format(); format != nil {
+				diskBuilder.Format(ovirtsdk.DiskFormat(*format))
+			}
+			if storageDomainID := d.StorageDomainID(); storageDomainID != nil {
+				diskBuilder.StorageDomainsBuilderOfAny(*ovirtsdk.NewStorageDomainBuilder().Id(string(*storageDomainID)))
+			}
+			diskAttachment, err := ovirtsdk.NewDiskAttachmentBuilder().DiskBuilder(diskBuilder).Build()
+			if err != nil {
+				return nil, wrap(err, EBadArgument, "failed to convert disk %d", i)
+			}
+			diskAttachments[i] = diskAttachment
+		}
+		tpl.DiskAttachmentsOfAny(diskAttachments...)
+	}
+	result, err := tpl.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build template")
+	}
+	return result, nil
+}
+
 func (m *mockClient) CreateTemplate(
 	vmID VMID,
 	name string,
@@ -68,34 +118,51 @@ func (m *mockClient) CreateTemplate(
 		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
 	}
 
-	for _, tpl := range m.templates {
-		if tpl.name == name {
-			return nil, newError(EConflict, "A template with the name \"%s\" already exists.", name)
-		}
-	}
-
 	if params == nil {
 		params = &templateCreateParameters{}
 	}
+	if err := m.validateTemplateCreation(vm, params); err != nil {
+		return nil, err
+	}
+
+	id := TemplateID(m.GenerateUUID())
+	baseTemplateID := id
+	versionNumber := uint(1)
+	if baseID := params.BaseTemplateID(); baseID != nil {
+		baseTemplateID = *baseID
+		name = m.templates[baseTemplateID].name
+		versionNumber = m.getLatestTemplateVersion(baseTemplateID).versionNumber + 1
+	} else {
+		for _, tpl := range m.templates {
+			if tpl.name == name {
+				return nil, newError(EConflict, "A template with the name \"%s\" already exists.", name)
+			}
+		}
+	}
 
 	description := ""
 	if desc := params.Description(); desc != nil {
 		description = *desc
 	}
+	versionName := ""
+	if vn := params.VersionName(); vn != nil {
+		versionName = *vn
+	}
 	tpl := &template{
-		client:      m,
-		id:          TemplateID(m.GenerateUUID()),
-		name:        name,
-		description: description,
-		status:      TemplateStatusLocked,
-		cpu:         vm.cpu.clone(),
+		client:         m,
+		id:             id,
+		name:           name,
+		description:    description,
+		status:         TemplateStatusLocked,
+		cpu:            vm.cpu.clone(),
+		memory:         vm.memory,
+		versionNumber:  versionNumber,
+		versionName:    versionName,
+		baseTemplateID: baseTemplateID,
 	}
 	m.templates[tpl.ID()] = tpl
-	m.templateDiskAttachmentsByTemplate[tpl.ID()] = make(
-		[]*templateDiskAttachment,
-		len(m.vmDiskAttachmentsByVM[vmID]),
-	)
-	m.attachTemplateDisks(vmID, tpl)
+	m.templateDiskAttachmentsByTemplate[tpl.ID()] = []*templateDiskAttachment{}
+	m.attachTemplateDisks(vmID, tpl, params.Disks())
 
 	go m.handlePostTemplateCreation(tpl)
 	return tpl, nil
@@ -117,13 +184,76 @@ func (m *mockClient) handlePostTemplateCreation(tpl *template) {
 	}()
 }
 
-func (m *mockClient) attachTemplateDisks(vmID VMID, tpl *template) {
-	i := 0
+// validateTemplateCreation checks the template version, sealing and disk parameters against the VM the template is
+// created from. The caller must hold the lock of the mock client.
+func (m *mockClient) validateTemplateCreation(vm *vm, params OptionalTemplateCreateParameters) error {
+	if baseID := params.BaseTemplateID(); baseID != nil {
+		base, ok := m.templates[*baseID]
+		if !ok {
+			return newError(ENotFound, "base template with ID %s not found", *baseID)
+		}
+		if base.baseTemplateID != base.id {
+			return newError(
+				EBadArgument,
+				"template %s is a version of template %s and cannot be used as a base template",
+				base.id,
+				base.baseTemplateID,
+			)
+		}
+	}
+	if seal := params.Seal(); seal != nil && *seal && vm.os != nil && strings.HasPrefix(vm.os.t, "windows") {
+		return newError(EBadArgument, "sealing is only supported for Linux templates, VM %s runs %s", vm.id, vm.os.t)
+	}
+	if err := validateTemplateDisks(params.Disks()); err != nil {
+		return err
+	}
+	for _, d := range params.Disks() {
+		found := false
+		for _, attachment := range m.vmDiskAttachmentsByVM[vm.id] {
+			if attachment.diskID == d.DiskID() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return newError(ENotFound, "disk %s is not attached to VM %s", d.DiskID(), vm.id)
+		}
+		if storageDomainID := d.StorageDomainID(); storageDomainID != nil {
+			if _, ok := m.storageDomains[*storageDomainID]; !ok {
+				return newError(ENotFound, "storage domain with ID %s not found", *storageDomainID)
+			}
+		}
+	}
+	return nil
+}
+
+func (m *mockClient) attachTemplateDisks(vmID VMID, tpl *template, diskParams []TemplateDiskParameters) {
 	for _, attachment := range m.vmDiskAttachmentsByVM[vmID] {
 		disk := m.disks[attachment.diskID]
+		var params TemplateDiskParameters
+		for _, d := range diskParams {
+			if d.DiskID() == disk.ID() {
+				params = d
+				break
+			}
+		}
+		if len(diskParams) > 0 && params == nil {
+			continue
+		}
 		newDisk := disk.clone(nil)
 		_ = newDisk.Lock()
 		newDisk.alias = fmt.Sprintf("disk-%s", generateRandomID(5, m.nonSecureRandom))
+		if params != nil {
+			if format := params.Format(); format != nil {
+				newDisk.format = *format
+			}
+			if storageDomainID := params.StorageDomainID(); storageDomainID != nil {
+				newDisk.storageDomainIDs = []StorageDomainID{*storageDomainID}
+				if profile := m.getDefaultDiskProfile(*storageDomainID); profile != nil {
+					newDisk.diskProfileID = profile.id
+				}
+			}
+		}
 		m.disks[newDisk.ID()] = newDisk
 
 		tplAttachment := &templateDiskAttachment{
@@ -136,7 +266,18 @@ func (m *mockClient) attachTemplateDisks(vmID VMID, tpl *template) {
 			active:        attachment.active,
 		}
 		m.templateDiskAttachmentsByDisk[newDisk.id] = tplAttachment
-		m.templateDiskAttachmentsByTemplate[tpl.id][i] = tplAttachment
-		i++
+		m.templateDiskAttachmentsByTemplate[tpl.id] = append(m.templateDiskAttachmentsByTemplate[tpl.id], tplAttachment)
+	}
+}
+
+// getLatestTemplateVersion returns the template with the highest version number in the template family of the
+// specified base template. The caller must hold the lock of the mock client.
+func (m *mockClient) getLatestTemplateVersion(baseTemplateID TemplateID) *template {
+	latest := m.templates[baseTemplateID]
+	for _, tpl := range m.templates {
+		if tpl.baseTemplateID == baseTemplateID && tpl.versionNumber > latest.versionNumber {
+			latest = tpl
+		}
 	}
+	return latest
 }
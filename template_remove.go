@@ -45,11 +45,25 @@ func (m *mockClient) RemoveTemplate(id TemplateID, retries ...RetryStrategy) (er
 				}
 			}
 
+			if tpl.baseTemplateID == id {
+				for _, version := range m.templates {
+					if version.baseTemplateID == id && version.id != id {
+						return newError(
+							EConflict,
+							"Template %s cannot be removed because it has the version %s.",
+							id,
+							version.id,
+						)
+					}
+				}
+			}
+
 			if tpl.status == TemplateStatusLocked {
 				return newError(EConflict, "Template %s is in status %s.", id, tpl.status)
 			}
 
 			delete(m.templates, id)
+			delete(m.tagIDsByTemplate, id)
 			return nil
 		})
 	return err
@@ -0,0 +1,58 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListTemplateTags(id TemplateID, retries ...RetryStrategy) (result []Tag, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("listing tags for template %s", id),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().TemplatesService().TemplateService(string(id)).TagsService().List().Send()
+			if err != nil {
+				return err
+			}
+			sdkObjects, ok := response.Tags()
+			if !ok {
+				return newError(
+					ENotFound,
+					"no tags returned when getting template %s tags",
+					id,
+				)
+			}
+			result = make([]Tag, len(sdkObjects.Slice()))
+			for i, sdkTag := range sdkObjects.Slice() {
+				result[i], err = convertSDKTag(sdkTag, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert tag of template %s", id)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) ListTemplateTags(id TemplateID, retries ...RetryStrategy) (result []Tag, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	err = retry(
+		fmt.Sprintf("listing tags for template %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, ok := m.templates[id]; !ok {
+				return newError(ENotFound, "template with ID %s not found", id)
+			}
+			result = make([]Tag, len(m.tagIDsByTemplate[id]))
+			for i, tagID := range m.tagIDsByTemplate[id] {
+				result[i] = m.tags[tagID]
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,50 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) RemoveTagFromTemplate(id TemplateID, tagID TagID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("removing tag %s from template %s", tagID, id),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				TemplatesService().
+				TemplateService(string(id)).
+				TagsService().
+				TagService(string(tagID)).
+				Remove().
+				Send()
+			return err
+		})
+	return
+}
+
+func (m *mockClient) RemoveTagFromTemplate(id TemplateID, tagID TagID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("removing tag %s from template %s", tagID, id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, ok := m.templates[id]; !ok {
+				return newError(ENotFound, "template with ID %s not found", id)
+			}
+			if _, ok := m.tags[tagID]; !ok {
+				return newError(ENotFound, "tag with ID %s not found", tagID)
+			}
+			tagIDs, ok := removeMockTagID(m.tagIDsByTemplate[id], tagID)
+			if !ok {
+				return newError(ENotFound, "tag with ID %s not found on template %s", tagID, id)
+			}
+			m.tagIDsByTemplate[id] = tagIDs
+			return nil
+		})
+}
@@ -0,0 +1,44 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestTemplateTagAssignment(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("%s-%s", t.Name(), helper.GenerateRandomID(5)), nil)
+	tpl := assertCanCreateTemplate(t, helper, vm)
+	tag1 := assertCanCreateTag(t, helper, fmt.Sprintf("%s-%s", t.Name(), helper.GenerateRandomID(5)), "")
+	tag2 := assertCanCreateTag(t, helper, fmt.Sprintf("%s-%s", t.Name(), helper.GenerateRandomID(5)), "")
+
+	for _, tag := range []ovirtclient.Tag{tag1, tag2} {
+		if err := tpl.AddTag(tag.ID()); err != nil {
+			t.Fatalf("Failed to add tag %s to template %s (%v)", tag.ID(), tpl.ID(), err)
+		}
+	}
+	if err := tpl.RemoveTag(tag1.ID()); err != nil {
+		t.Fatalf("Failed to remove tag %s from template %s (%v)", tag1.ID(), tpl.ID(), err)
+	}
+
+	tplTags, err := tpl.ListTags()
+	if err != nil {
+		t.Fatalf("Failed to list tags of template %s (%v)", tpl.ID(), err)
+	}
+	if len(tplTags) != 1 || !containsTag(tplTags, tag2) {
+		t.Fatalf("Incorrect tags on template %s (expected only %s, got %d tags).", tpl.ID(), tag2.ID(), len(tplTags))
+	}
+}
+
+func containsTag(tags []ovirtclient.Tag, tag ovirtclient.Tag) bool {
+	for _, t := range tags {
+		if t.ID() == tag.ID() {
+			return true
+		}
+	}
+	return false
+}
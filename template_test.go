@@ -242,6 +242,198 @@ func assertCanGetTemplateOK(t *testing.T, helper ovirtclient.TestHelper, id ovir
 	return tpl
 }
 
+func TestTemplateVersions(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm1 := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	baseTemplate := assertCanCreateTemplate(t, helper, vm1)
+	baseTemplate = assertCanGetTemplateOK(t, helper, baseTemplate.ID())
+	if baseTemplate.VersionNumber() != 1 {
+		t.Fatalf("Incorrect version number for base template (%d instead of 1).", baseTemplate.VersionNumber())
+	}
+	if baseTemplate.BaseTemplateID() != baseTemplate.ID() {
+		t.Fatalf("The base template does not reference itself as base template.")
+	}
+
+	vm2 := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams().MustWithMemory(2*1024*1024*1024),
+	)
+	version := assertCanCreateTemplateWithParams(
+		t,
+		helper,
+		vm2,
+		ovirtclient.TemplateCreateParams().
+			MustWithBaseTemplateID(baseTemplate.ID()).
+			MustWithVersionName("second"),
+	)
+	if version.VersionNumber() != 2 {
+		t.Fatalf("Incorrect version number for template version (%d instead of 2).", version.VersionNumber())
+	}
+	if version.VersionName() != "second" {
+		t.Fatalf("Incorrect version name for template version (%s instead of second).", version.VersionName())
+	}
+	if version.BaseTemplateID() != baseTemplate.ID() {
+		t.Fatalf(
+			"Incorrect base template ID for template version (%s instead of %s).",
+			version.BaseTemplateID(),
+			baseTemplate.ID(),
+		)
+	}
+	if version.Name() != baseTemplate.Name() {
+		t.Fatalf("The template version does not share the name of the base template.")
+	}
+	version = assertCanGetTemplateOK(t, helper, version.ID())
+
+	vm3 := assertCanCreateVMFromTemplate(
+		t,
+		helper,
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		baseTemplate.ID(),
+		ovirtclient.CreateVMParams().MustWithUseLatestTemplateVersion(true),
+	)
+	if vm3.TemplateID() != version.ID() {
+		t.Fatalf(
+			"VM created with the latest template version uses template %s instead of %s.",
+			vm3.TemplateID(),
+			version.ID(),
+		)
+	}
+	if vm3.Memory() != vm2.Memory() {
+		t.Fatalf("VM created with the latest template version has incorrect memory (%d bytes).", vm3.Memory())
+	}
+}
+
+func TestTemplateVersionOfVersionIsRejected(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	baseTemplate := assertCanCreateTemplate(t, helper, vm)
+	version := assertCanCreateTemplateWithParams(
+		t,
+		helper,
+		vm,
+		ovirtclient.TemplateCreateParams().MustWithBaseTemplateID(baseTemplate.ID()),
+	)
+	assertCanGetTemplateOK(t, helper, baseTemplate.ID())
+	assertCanGetTemplateOK(t, helper, version.ID())
+	_, err := helper.GetClient().CreateTemplate(
+		vm.ID(),
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		ovirtclient.TemplateCreateParams().MustWithBaseTemplateID(version.ID()),
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Creating a version of a template version did not result in an EBadArgument error (%v)", err)
+	}
+}
+
+func TestTemplateDiskSelection(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	targetStorageDomainID := helper.GetSecondaryStorageDomainID(t)
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	disk1 := assertCanCreateDisk(t, helper)
+	disk2 := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk1)
+	assertCanAttachDisk(t, vm, disk2)
+
+	tpl := assertCanCreateTemplateWithParams(
+		t,
+		helper,
+		vm,
+		ovirtclient.TemplateCreateParams().
+			MustWithSeal(true).
+			MustWithDisks(
+				[]ovirtclient.TemplateDiskParameters{
+					ovirtclient.MustNewTemplateDiskParams(disk2.ID()).
+						MustWithStorageDomainID(targetStorageDomainID).
+						MustWithFormat(ovirtclient.ImageFormatCow),
+				},
+			),
+	)
+	tpl = assertCanGetTemplateOK(t, helper, tpl.ID())
+	attachments, err := tpl.ListDiskAttachments()
+	if err != nil {
+		t.Fatalf("Failed to list template disk attachments (%v)", err)
+	}
+	if len(attachments) != 1 {
+		t.Fatalf("Incorrect number of template disk attachments (%d instead of 1).", len(attachments))
+	}
+	tplDisk, err := attachments[0].Disk()
+	if err != nil {
+		t.Fatalf("Failed to fetch template disk (%v)", err)
+	}
+	if tplDisk.Format() != ovirtclient.ImageFormatCow {
+		t.Fatalf("Incorrect template disk format (%s instead of %s).", tplDisk.Format(), ovirtclient.ImageFormatCow)
+	}
+	if sds := tplDisk.StorageDomainIDs(); len(sds) != 1 || sds[0] != targetStorageDomainID {
+		t.Fatalf("Template disk is not on the requested storage domain %s (%v).", targetStorageDomainID, sds)
+	}
+}
+
+func TestTemplateUpdate(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	tpl := assertCanCreateTemplate(t, helper, vm)
+	tpl = assertCanGetTemplateOK(t, helper, tpl.ID())
+
+	newName := fmt.Sprintf("test-%s", helper.GenerateRandomID(5))
+	memory := int64(2 * 1024 * 1024 * 1024)
+	updatedTpl, err := tpl.Update(
+		ovirtclient.UpdateTemplateParams().
+			MustWithName(newName).
+			MustWithDescription("Updated description").
+			MustWithCPU(ovirtclient.NewVMCPUParams().MustWithTopo(ovirtclient.MustNewVMCPUTopo(2, 1, 1))).
+			MustWithMemory(memory),
+	)
+	if err != nil {
+		t.Fatalf("Failed to update template %s (%v)", tpl.ID(), err)
+	}
+	if updatedTpl.Name() != newName {
+		t.Fatalf("Incorrect template name after update (%s instead of %s).", updatedTpl.Name(), newName)
+	}
+	if updatedTpl.Description() != "Updated description" {
+		t.Fatalf("Incorrect template description after update (%s).", updatedTpl.Description())
+	}
+	if cores := updatedTpl.CPU().Topo().Cores(); cores != 2 {
+		t.Fatalf("Incorrect number of cores after update (%d instead of 2).", cores)
+	}
+	if updatedTpl.Memory() != memory {
+		t.Fatalf("Incorrect template memory after update (%d instead of %d bytes).", updatedTpl.Memory(), memory)
+	}
+}
+
+func assertCanCreateTemplateWithParams(
+	t *testing.T,
+	helper ovirtclient.TestHelper,
+	vm ovirtclient.VM,
+	params ovirtclient.OptionalTemplateCreateParameters,
+) ovirtclient.Template {
+	t.Logf("Creating test template from VM %s...", vm.Name())
+	template, err := helper.GetClient().CreateTemplate(
+		vm.ID(),
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		params,
+	)
+	if err != nil {
+		t.Fatalf("Failed to create template from VM %s (%v)", vm.ID(), err)
+	}
+	t.Cleanup(func() {
+		t.Logf("Cleaning up template %s...", template.ID())
+		if err := template.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("Failed to clean up template %s after test. (%v)", template.ID(), err)
+		}
+	})
+	return template
+}
+
 func assertCanCreateTemplate(t *testing.T, helper ovirtclient.TestHelper, vm ovirtclient.VM) ovirtclient.Template {
 	t.Logf("Creating test template from VM %s...", vm.Name())
 	template, err := helper.GetClient().CreateTemplate(
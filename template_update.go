@@ -0,0 +1,136 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) UpdateTemplate(
+	id TemplateID,
+	params UpdateTemplateParameters,
+	retries ...RetryStrategy,
+) (result Template, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	sdkTemplate, err := buildSDKTemplateForUpdate(id, params)
+	if err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("updating template %s", id),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				TemplatesService().
+				TemplateService(string(id)).
+				Update().
+				Template(sdkTemplate).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Template()
+			if !ok {
+				return newError(EFieldMissing, "missing template in template update response")
+			}
+			result, err = convertSDKTemplate(sdkObject, o)
+			if err != nil {
+				return wrap(
+					err,
+					EBug,
+					"failed to convert template %s",
+					id,
+				)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func buildSDKTemplateForUpdate(id TemplateID, params UpdateTemplateParameters) (*ovirtsdk.Template, error) {
+	builder := ovirtsdk.NewTemplateBuilder().Id(string(id))
+	if name := params.Name(); name != nil {
+		builder.Name(*name)
+	}
+	if description := params.Description(); description != nil {
+		builder.Description(*description)
+	}
+	if cpu := params.CPU(); cpu != nil {
+		builder.CpuBuilder(sdkCPUBuilder(cpu))
+	}
+	if memory := params.Memory(); memory != nil {
+		builder.Memory(*memory)
+	}
+	tpl, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build template")
+	}
+	return tpl, nil
+}
+
+func (m *mockClient) UpdateTemplate(
+	id TemplateID,
+	params UpdateTemplateParameters,
+	retries ...RetryStrategy,
+) (result Template, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	err = retry(
+		fmt.Sprintf("updating template %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			tpl, ok := m.templates[id]
+			if !ok {
+				return newError(ENotFound, "template with ID %s not found", id)
+			}
+			if tpl.status != TemplateStatusOK {
+				return newError(EConflict, "template %s is in status %s", id, tpl.status)
+			}
+			if name := params.Name(); name != nil && *name != tpl.name {
+				if err := m.renameTemplateFamily(tpl, *name); err != nil {
+					return err
+				}
+			}
+			if description := params.Description(); description != nil {
+				tpl.description = *description
+			}
+			if cpu := params.CPU(); cpu != nil {
+				tpl.cpu = newVMCPUFromParams(cpu)
+			}
+			if memory := params.Memory(); memory != nil {
+				tpl.memory = *memory
+			}
+			result = tpl
+			return nil
+		})
+	return
+}
+
+// renameTemplateFamily changes the name of a base template and all its versions. The caller must hold the lock of
+// the mock client.
+func (m *mockClient) renameTemplateFamily(tpl *template, name string) error {
+	if tpl.baseTemplateID != tpl.id {
+		return newError(
+			EBadArgument,
+			"template %s is a version of template %s, only the base template can be renamed",
+			tpl.id,
+			tpl.baseTemplateID,
+		)
+	}
+	for _, otherTpl := range m.templates {
+		if otherTpl.name == name && otherTpl.baseTemplateID != tpl.id {
+			return newError(EConflict, "A template with the name \"%s\" already exists.", name)
+		}
+	}
+	for _, version := range m.templates {
+		if version.baseTemplateID == tpl.id {
+			version.name = name
+		}
+	}
+	return nil
+}
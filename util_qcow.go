@@ -1,33 +1,31 @@
 package ovirtclient
 
 import (
-	"encoding/binary"
 	"io"
 )
 
+// extractQCOWParameters inspects the image and returns its format and virtual size. Images that cannot be uploaded to
+// oVirt, for example because they have a backing file, are rejected with an EBadArgument error.
 func extractQCOWParameters(fileSize uint64, reader io.ReadSeekCloser) (
 	ImageFormat,
 	uint64,
 	error,
 ) {
-	format := ImageFormatCow
-	qcowSize := fileSize
-	header := make([]byte, qcowHeaderSize)
-
-	_, err := io.ReadAtLeast(reader, header, qcowHeaderSize)
+	info, err := InspectImage(reader)
 	if err != nil {
-		return "", 0, wrap(err, EBadArgument, "failed to read QCOW header")
+		return "", 0, err
+	}
+	if err := info.Validate(); err != nil {
+		return "", 0, err
 	}
 
-	isQCOW := string(header[0:len(qcowMagicBytes)]) == qcowMagicBytes
-	if !isQCOW {
-		format = ImageFormatRaw
-	} else {
-		// See https://people.gnome.org/~markmc/qcow-image-format.html
-		qcowSize = binary.BigEndian.Uint64(header[qcowSizeStartByte : qcowSizeStartByte+8])
+	format := info.Format()
+	qcowSize := fileSize
+	if format == ImageFormatCow {
+		qcowSize = info.VirtualSize()
 	}
-	if qcowSize <= 0 {
+	if qcowSize == 0 {
 		return format, 0, newError(EBadArgument, "expected positive image size, got %d instead", qcowSize)
 	}
-	return format, qcowSize, err
+	return format, qcowSize, nil
 }
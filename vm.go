@@ -46,6 +46,13 @@ type VMClient interface {
 	ShutdownVM(id VMID, force bool, retries ...RetryStrategy) error
 	// WaitForVMStatus waits for the VM to reach the desired status.
 	WaitForVMStatus(id VMID, status VMStatus, retries ...RetryStrategy) (VM, error)
+	// MigrateVM moves a VM to a different host or cluster. Running VMs are live migrated, the migration should be
+	// waited for via the WaitForVMMigration call. VMs that are down are moved to the target cluster immediately.
+	// Use MigrateVMParams to obtain a builder for the params.
+	MigrateVM(id VMID, params MigrateVMParameters, retries ...RetryStrategy) error
+	// WaitForVMMigration waits for a running migration of the VM to finish. The returned VM contains the host the VM
+	// ended up on.
+	WaitForVMMigration(id VMID, retries ...RetryStrategy) (VM, error)
 	// ListVMs returns a list of all virtual machines.
 	ListVMs(retries ...RetryStrategy) ([]VM, error)
 	// SearchVMs lists all virtual machines matching a certain criteria specified in params.
@@ -238,6 +245,15 @@ type VMData interface {
 
 	// OS returns the operating system structure.
 	OS() VMOS
+
+	// NextRunConfigurationExists returns true if the VM has configuration changes that will only be applied when
+	// the VM is next started.
+	NextRunConfigurationExists() bool
+	// PendingNextRunFields returns the fields that will only be applied when the VM is next started, including the
+	// fields changed by earlier next run updates. Determining them requires fetching the next run configuration, so
+	// this is only populated on the VM returned from UpdateVM. Use NextRunConfigurationExists on VMs fetched in other
+	// ways.
+	PendingNextRunFields() []VMUpdateField
 }
 
 // VMOS is the structure describing the virtual machine operating system, if set.
@@ -659,6 +675,22 @@ type VM interface {
 
 	// ListGraphicsConsoles lists the graphics consoles on the VM.
 	ListGraphicsConsoles(retries ...RetryStrategy) ([]VMGraphicsConsole, error)
+	// AddGraphicsConsole adds a graphics console with the specified protocol to the VM.
+	AddGraphicsConsole(protocol GraphicsConsoleProtocol, retries ...RetryStrategy) (VMGraphicsConsole, error)
+
+	// CreateSnapshot creates a new snapshot of the current VM.
+	CreateSnapshot(
+		description string,
+		params CreateSnapshotOptionalParams,
+		retries ...RetryStrategy,
+	) (Snapshot, error)
+	// ListSnapshots lists all snapshots of the current VM, including the active one.
+	ListSnapshots(retries ...RetryStrategy) ([]Snapshot, error)
+
+	// Migrate moves the current VM to a different host or cluster. See MigrateVM for details.
+	Migrate(params MigrateVMParameters, retries ...RetryStrategy) error
+	// WaitForMigration waits for a running migration of the current VM to finish.
+	WaitForMigration(retries ...RetryStrategy) (VM, error)
 
 	// SerialConsole returns true if the VM has a serial console.
 	SerialConsole() bool
@@ -673,7 +705,7 @@ type VM interface {
 type VMSearchParameters interface {
 	// Name will match the name of the virtual machine exactly.
 	Name() *string
-	// Tag will match the tag of the virtual machine.
+	// Tag will match the virtual machines that have the tag with this name, or one of its descendant tags.
 	Tag() *string
 	// Statuses will return a list of acceptable statuses for this VM search.
 	Statuses() *VMStatusList
@@ -833,6 +865,10 @@ type OptionalVMParameters interface {
 
 	// SoundcardEnabled returns if a soundcard should be created or not.
 	SoundcardEnabled() *bool
+
+	// UseLatestTemplateVersion returns true if the VM should be created from the latest version of the template
+	// family the passed template belongs to, instead of the exact template version.
+	UseLatestTemplateVersion() *bool
 }
 
 // BuildableVMParameters is a variant of OptionalVMParameters that can be changed using the supplied
@@ -913,6 +949,13 @@ type BuildableVMParameters interface {
 
 	// WithSoundcardEnabled enables or disables a soundcard for the VM.
 	WithSoundcardEnabled(soundcardEnabled bool) BuildableVMParameters
+
+	// WithUseLatestTemplateVersion creates the VM from the latest version of the template family the passed
+	// template belongs to. The template ID passed to CreateVM may be any version of the template.
+	WithUseLatestTemplateVersion(useLatest bool) (BuildableVMParameters, error)
+	// MustWithUseLatestTemplateVersion is identical to WithUseLatestTemplateVersion, but panics instead of returning
+	// an error.
+	MustWithUseLatestTemplateVersion(useLatest bool) BuildableVMParameters
 }
 
 // VMCPUParams contain the CPU parameters for a VM.
@@ -1516,6 +1559,85 @@ type UpdateVMParameters interface {
 	Comment() *string
 	// Description returns the description for the VM. Return nil if the name should not be changed.
 	Description() *string
+	// CPU returns the CPU configuration for the VM. Return nil if the CPU should not be changed.
+	CPU() VMCPUParams
+	// Memory returns the memory size of the VM in bytes. Return nil if the memory should not be changed.
+	Memory() *int64
+	// MemoryPolicy returns the memory policy for the VM. Return nil if the memory policy should not be changed.
+	MemoryPolicy() *MemoryPolicyParameters
+	// HugePages returns the hugepage setting for the VM. Return nil if the hugepages should not be changed.
+	HugePages() *VMHugePages
+	// PlacementPolicy returns the placement policy for the VM. Return nil if the placement policy should not be
+	// changed.
+	PlacementPolicy() *VMPlacementPolicyParameters
+	// InstanceTypeID returns the instance type for the VM. Return nil if the instance type should not be changed.
+	InstanceTypeID() *InstanceTypeID
+	// OS returns the operating system parameters for the VM. The second return value is false if the operating
+	// system should not be changed.
+	OS() (VMOSParameters, bool)
+	// SerialConsole returns if a serial console should be enabled. Return nil if it should not be changed.
+	SerialConsole() *bool
+	// SoundcardEnabled returns if a soundcard should be enabled. Return nil if it should not be changed.
+	SoundcardEnabled() *bool
+	// Initialization returns the initialization configuration for the VM. Return nil if it should not be changed.
+	Initialization() Initialization
+	// NextRun returns true if the changes should only be applied when the VM is next started. Changes that cannot
+	// be applied to a running VM are always deferred to the next run by the engine.
+	NextRun() bool
+}
+
+// VMUpdateField identifies a VM setting that can be changed via UpdateVM.
+type VMUpdateField string
+
+const (
+	// VMUpdateFieldCPU is the CPU topology and mode of the VM.
+	VMUpdateFieldCPU VMUpdateField = "cpu"
+	// VMUpdateFieldMemory is the memory size of the VM.
+	VMUpdateFieldMemory VMUpdateField = "memory"
+	// VMUpdateFieldMemoryPolicy is the memory policy of the VM.
+	VMUpdateFieldMemoryPolicy VMUpdateField = "memory_policy"
+	// VMUpdateFieldHugePages is the hugepage setting of the VM.
+	VMUpdateFieldHugePages VMUpdateField = "hugepages"
+	// VMUpdateFieldPlacementPolicy is the placement policy of the VM.
+	VMUpdateFieldPlacementPolicy VMUpdateField = "placement_policy"
+	// VMUpdateFieldInstanceType is the instance type of the VM.
+	VMUpdateFieldInstanceType VMUpdateField = "instance_type"
+	// VMUpdateFieldOS is the operating system of the VM.
+	VMUpdateFieldOS VMUpdateField = "os"
+	// VMUpdateFieldSerialConsole is the serial console setting of the VM.
+	VMUpdateFieldSerialConsole VMUpdateField = "serial_console"
+	// VMUpdateFieldSoundcardEnabled is the soundcard setting of the VM.
+	VMUpdateFieldSoundcardEnabled VMUpdateField = "soundcard_enabled"
+	// VMUpdateFieldInitialization is the initialization configuration of the VM.
+	VMUpdateFieldInitialization VMUpdateField = "initialization"
+)
+
+// VMUpdateFieldList is a list of VMUpdateField.
+type VMUpdateFieldList []VMUpdateField
+
+// VMUpdateFieldValues returns all possible VMUpdateField values.
+func VMUpdateFieldValues() VMUpdateFieldList {
+	return []VMUpdateField{
+		VMUpdateFieldCPU,
+		VMUpdateFieldMemory,
+		VMUpdateFieldMemoryPolicy,
+		VMUpdateFieldHugePages,
+		VMUpdateFieldPlacementPolicy,
+		VMUpdateFieldInstanceType,
+		VMUpdateFieldOS,
+		VMUpdateFieldSerialConsole,
+		VMUpdateFieldSoundcardEnabled,
+		VMUpdateFieldInitialization,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l VMUpdateFieldList) Strings() []string {
+	result := make([]string, len(l))
+	for i, field := range l {
+		result[i] = string(field)
+	}
+	return result
 }
 
 // VMCPUTopo contains the CPU topology information about a VM.
@@ -1605,6 +1727,61 @@ type BuildableUpdateVMParameters interface {
 
 	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
 	MustWithDescription(comment string) BuildableUpdateVMParameters
+
+	// WithCPU changes the CPU configuration of the VM.
+	WithCPU(cpu VMCPUParams) (BuildableUpdateVMParameters, error)
+	// MustWithCPU is identical to WithCPU, but panics instead of returning an error.
+	MustWithCPU(cpu VMCPUParams) BuildableUpdateVMParameters
+
+	// WithMemory changes the memory size of the VM in bytes.
+	WithMemory(memory int64) (BuildableUpdateVMParameters, error)
+	// MustWithMemory is identical to WithMemory, but panics instead of returning an error.
+	MustWithMemory(memory int64) BuildableUpdateVMParameters
+
+	// WithMemoryPolicy changes the memory policy of the VM.
+	WithMemoryPolicy(memoryPolicy MemoryPolicyParameters) (BuildableUpdateVMParameters, error)
+	// MustWithMemoryPolicy is identical to WithMemoryPolicy, but panics instead of returning an error.
+	MustWithMemoryPolicy(memoryPolicy MemoryPolicyParameters) BuildableUpdateVMParameters
+
+	// WithHugePages changes the hugepage setting of the VM.
+	WithHugePages(hugePages VMHugePages) (BuildableUpdateVMParameters, error)
+	// MustWithHugePages is identical to WithHugePages, but panics instead of returning an error.
+	MustWithHugePages(hugePages VMHugePages) BuildableUpdateVMParameters
+
+	// WithPlacementPolicy changes the placement policy of the VM.
+	WithPlacementPolicy(placementPolicy VMPlacementPolicyParameters) (BuildableUpdateVMParameters, error)
+	// MustWithPlacementPolicy is identical to WithPlacementPolicy, but panics instead of returning an error.
+	MustWithPlacementPolicy(placementPolicy VMPlacementPolicyParameters) BuildableUpdateVMParameters
+
+	// WithInstanceTypeID changes the instance type of the VM.
+	WithInstanceTypeID(instanceTypeID InstanceTypeID) (BuildableUpdateVMParameters, error)
+	// MustWithInstanceTypeID is identical to WithInstanceTypeID, but panics instead of returning an error.
+	MustWithInstanceTypeID(instanceTypeID InstanceTypeID) BuildableUpdateVMParameters
+
+	// WithOS changes the operating system parameters of the VM.
+	WithOS(os VMOSParameters) (BuildableUpdateVMParameters, error)
+	// MustWithOS is identical to WithOS, but panics instead of returning an error.
+	MustWithOS(os VMOSParameters) BuildableUpdateVMParameters
+
+	// WithSerialConsole enables or disables the serial console of the VM.
+	WithSerialConsole(serialConsole bool) (BuildableUpdateVMParameters, error)
+	// MustWithSerialConsole is identical to WithSerialConsole, but panics instead of returning an error.
+	MustWithSerialConsole(serialConsole bool) BuildableUpdateVMParameters
+
+	// WithSoundcardEnabled enables or disables the soundcard of the VM.
+	WithSoundcardEnabled(soundcardEnabled bool) (BuildableUpdateVMParameters, error)
+	// MustWithSoundcardEnabled is identical to WithSoundcardEnabled, but panics instead of returning an error.
+	MustWithSoundcardEnabled(soundcardEnabled bool) BuildableUpdateVMParameters
+
+	// WithInitialization changes the initialization configuration of the VM.
+	WithInitialization(initialization Initialization) (BuildableUpdateVMParameters, error)
+	// MustWithInitialization is identical to WithInitialization, but panics instead of returning an error.
+	MustWithInitialization(initialization Initialization) BuildableUpdateVMParameters
+
+	// WithNextRun sets if the changes should only be applied when the VM is next started.
+	WithNextRun(nextRun bool) (BuildableUpdateVMParameters, error)
+	// MustWithNextRun is identical to WithNextRun, but panics instead of returning an error.
+	MustWithNextRun(nextRun bool) BuildableUpdateVMParameters
 }
 
 // UpdateVMParams returns a buildable set of update parameters.
@@ -1613,9 +1790,21 @@ func UpdateVMParams() BuildableUpdateVMParameters {
 }
 
 type updateVMParams struct {
-	name        *string
-	comment     *string
-	description *string
+	name             *string
+	comment          *string
+	description      *string
+	cpu              VMCPUParams
+	memory           *int64
+	memoryPolicy     *MemoryPolicyParameters
+	hugePages        *VMHugePages
+	placementPolicy  *VMPlacementPolicyParameters
+	instanceTypeID   *InstanceTypeID
+	os               VMOSParameters
+	osSet            bool
+	serialConsole    *bool
+	soundcardEnabled *bool
+	initialization   Initialization
+	nextRun          bool
 }
 
 func (u *updateVMParams) MustWithName(name string) BuildableUpdateVMParameters {
@@ -1672,6 +1861,222 @@ func (u *updateVMParams) WithDescription(description string) (BuildableUpdateVMP
 	return u, nil
 }
 
+func (u *updateVMParams) CPU() VMCPUParams {
+	return u.cpu
+}
+
+func (u *updateVMParams) Memory() *int64 {
+	return u.memory
+}
+
+func (u *updateVMParams) MemoryPolicy() *MemoryPolicyParameters {
+	return u.memoryPolicy
+}
+
+func (u *updateVMParams) HugePages() *VMHugePages {
+	return u.hugePages
+}
+
+func (u *updateVMParams) PlacementPolicy() *VMPlacementPolicyParameters {
+	return u.placementPolicy
+}
+
+func (u *updateVMParams) InstanceTypeID() *InstanceTypeID {
+	return u.instanceTypeID
+}
+
+func (u *updateVMParams) OS() (VMOSParameters, bool) {
+	return u.os, u.osSet
+}
+
+func (u *updateVMParams) SerialConsole() *bool {
+	return u.serialConsole
+}
+
+func (u *updateVMParams) SoundcardEnabled() *bool {
+	return u.soundcardEnabled
+}
+
+func (u *updateVMParams) Initialization() Initialization {
+	return u.initialization
+}
+
+func (u *updateVMParams) NextRun() bool {
+	return u.nextRun
+}
+
+func (u *updateVMParams) WithCPU(cpu VMCPUParams) (BuildableUpdateVMParameters, error) {
+	if cpu == nil {
+		return nil, newError(EBadArgument, "CPU parameters cannot be nil")
+	}
+	u.cpu = cpu
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithCPU(cpu VMCPUParams) BuildableUpdateVMParameters {
+	builder, err := u.WithCPU(cpu)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVMParams) WithMemory(memory int64) (BuildableUpdateVMParameters, error) {
+	if memory <= 0 {
+		return nil, newError(EBadArgument, "memory must be positive (%d)", memory)
+	}
+	u.memory = &memory
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithMemory(memory int64) BuildableUpdateVMParameters {
+	builder, err := u.WithMemory(memory)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVMParams) WithMemoryPolicy(memoryPolicy MemoryPolicyParameters) (BuildableUpdateVMParameters, error) {
+	if memoryPolicy == nil {
+		return nil, newError(EBadArgument, "memory policy cannot be nil")
+	}
+	u.memoryPolicy = &memoryPolicy
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithMemoryPolicy(memoryPolicy MemoryPolicyParameters) BuildableUpdateVMParameters {
+	builder, err := u.WithMemoryPolicy(memoryPolicy)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVMParams) WithHugePages(hugePages VMHugePages) (BuildableUpdateVMParameters, error) {
+	if err := hugePages.Validate(); err != nil {
+		return nil, err
+	}
+	u.hugePages = &hugePages
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithHugePages(hugePages VMHugePages) BuildableUpdateVMParameters {
+	builder, err := u.WithHugePages(hugePages)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVMParams) WithPlacementPolicy(
+	placementPolicy VMPlacementPolicyParameters,
+) (BuildableUpdateVMParameters, error) {
+	if placementPolicy == nil {
+		return nil, newError(EBadArgument, "placement policy cannot be nil")
+	}
+	u.placementPolicy = &placementPolicy
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithPlacementPolicy(
+	placementPolicy VMPlacementPolicyParameters,
+) BuildableUpdateVMParameters {
+	builder, err := u.WithPlacementPolicy(placementPolicy)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVMParams) WithInstanceTypeID(instanceTypeID InstanceTypeID) (BuildableUpdateVMParameters, error) {
+	if instanceTypeID == "" {
+		return nil, newError(EBadArgument, "instance type ID cannot be empty")
+	}
+	u.instanceTypeID = &instanceTypeID
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithInstanceTypeID(instanceTypeID InstanceTypeID) BuildableUpdateVMParameters {
+	builder, err := u.WithInstanceTypeID(instanceTypeID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVMParams) WithOS(os VMOSParameters) (BuildableUpdateVMParameters, error) {
+	if os == nil {
+		return nil, newError(EBadArgument, "OS parameters cannot be nil")
+	}
+	u.os = os
+	u.osSet = true
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithOS(os VMOSParameters) BuildableUpdateVMParameters {
+	builder, err := u.WithOS(os)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVMParams) WithSerialConsole(serialConsole bool) (BuildableUpdateVMParameters, error) {
+	u.serialConsole = &serialConsole
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithSerialConsole(serialConsole bool) BuildableUpdateVMParameters {
+	builder, err := u.WithSerialConsole(serialConsole)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVMParams) WithSoundcardEnabled(soundcardEnabled bool) (BuildableUpdateVMParameters, error) {
+	u.soundcardEnabled = &soundcardEnabled
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithSoundcardEnabled(soundcardEnabled bool) BuildableUpdateVMParameters {
+	builder, err := u.WithSoundcardEnabled(soundcardEnabled)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVMParams) WithInitialization(initialization Initialization) (BuildableUpdateVMParameters, error) {
+	if initialization == nil {
+		return nil, newError(EBadArgument, "initialization cannot be nil")
+	}
+	u.initialization = initialization
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithInitialization(initialization Initialization) BuildableUpdateVMParameters {
+	builder, err := u.WithInitialization(initialization)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVMParams) WithNextRun(nextRun bool) (BuildableUpdateVMParameters, error) {
+	u.nextRun = nextRun
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithNextRun(nextRun bool) BuildableUpdateVMParameters {
+	builder, err := u.WithNextRun(nextRun)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
 // NewCreateVMParams creates a set of BuildableVMParameters that can be used to construct the optional VM parameters.
 func NewCreateVMParams() BuildableVMParameters {
 	return &vmParams{
@@ -1714,6 +2119,8 @@ type vmParams struct {
 
 	serialConsole    *bool
 	soundcardEnabled *bool
+
+	useLatestTemplateVersion *bool
 }
 
 func (v *vmParams) SerialConsole() *bool {
@@ -1734,6 +2141,23 @@ func (v *vmParams) WithSoundcardEnabled(soundcardEnabled bool) BuildableVMParame
 	return v
 }
 
+func (v *vmParams) UseLatestTemplateVersion() *bool {
+	return v.useLatestTemplateVersion
+}
+
+func (v *vmParams) WithUseLatestTemplateVersion(useLatest bool) (BuildableVMParameters, error) {
+	v.useLatestTemplateVersion = &useLatest
+	return v, nil
+}
+
+func (v *vmParams) MustWithUseLatestTemplateVersion(useLatest bool) BuildableVMParameters {
+	builder, err := v.WithUseLatestTemplateVersion(useLatest)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
 func (v *vmParams) OS() (VMOSParameters, bool) {
 	return v.os, v.osSet
 }
@@ -2028,6 +2452,17 @@ type vm struct {
 	os               *vmOS
 	serialConsole    bool
 	soundcardEnabled bool
+
+	nextRunConfigurationExists bool
+	pendingNextRunFields       []VMUpdateField
+}
+
+func (v *vm) NextRunConfigurationExists() bool {
+	return v.nextRunConfigurationExists
+}
+
+func (v *vm) PendingNextRunFields() []VMUpdateField {
+	return v.pendingNextRunFields
 }
 
 func (v *vm) SoundcardEnabled() bool {
@@ -2042,6 +2477,30 @@ func (v *vm) ListGraphicsConsoles(retries ...RetryStrategy) ([]VMGraphicsConsole
 	return v.client.ListVMGraphicsConsoles(v.id, retries...)
 }
 
+func (v *vm) AddGraphicsConsole(protocol GraphicsConsoleProtocol, retries ...RetryStrategy) (VMGraphicsConsole, error) {
+	return v.client.AddVMGraphicsConsole(v.id, protocol, retries...)
+}
+
+func (v *vm) CreateSnapshot(
+	description string,
+	params CreateSnapshotOptionalParams,
+	retries ...RetryStrategy,
+) (Snapshot, error) {
+	return v.client.CreateVMSnapshot(v.id, description, params, retries...)
+}
+
+func (v *vm) ListSnapshots(retries ...RetryStrategy) ([]Snapshot, error) {
+	return v.client.ListVMSnapshots(v.id, retries...)
+}
+
+func (v *vm) Migrate(params MigrateVMParameters, retries ...RetryStrategy) error {
+	return v.client.MigrateVM(v.id, params, retries...)
+}
+
+func (v *vm) WaitForMigration(retries ...RetryStrategy) (VM, error) {
+	return v.client.WaitForVMMigration(v.id, retries...)
+}
+
 func (v *vm) OS() VMOS {
 	return v.os
 }
@@ -2159,6 +2618,8 @@ func (v *vm) withName(name string) *vm {
 		v.os,
 		v.serialConsole,
 		v.soundcardEnabled,
+		v.nextRunConfigurationExists,
+		v.pendingNextRunFields,
 	}
 }
 
@@ -2187,6 +2648,8 @@ func (v *vm) withComment(comment string) *vm {
 		v.os,
 		v.serialConsole,
 		v.soundcardEnabled,
+		v.nextRunConfigurationExists,
+		v.pendingNextRunFields,
 	}
 }
 
@@ -2215,9 +2678,18 @@ func (v *vm) withDescription(description string) *vm {
 		v.os,
 		v.serialConsole,
 		v.soundcardEnabled,
+		v.nextRunConfigurationExists,
+		v.pendingNextRunFields,
 	}
 }
 
+// clone returns a shallow copy of the VM. Use this before changing a VM stored in the mock to avoid shared state
+// issues with copies returned earlier.
+func (v *vm) clone() *vm {
+	newVM := *v
+	return &newVM
+}
+
 func (v *vm) Update(params UpdateVMParameters, retries ...RetryStrategy) (VM, error) {
 	return v.client.UpdateVM(v.id, params, retries...)
 }
@@ -2347,6 +2819,7 @@ func convertSDKVM(sdkObject *ovirtsdk.Vm, client Client) (VM, error) {
 		vmOSConverter,
 		vmSoundcardEnabledConverter,
 		vmSerialConsoleConverter,
+		vmNextRunConverter,
 	}
 	for _, converter := range vmConverters {
 		if err := converter(sdkObject, vmObject); err != nil {
@@ -2374,6 +2847,16 @@ func vmSerialConsoleConverter(object *ovirtsdk.Vm, v *vm) error {
 	return nil
 }
 
+func vmNextRunConverter(object *ovirtsdk.Vm, v *vm) error {
+	nextRunConfigurationExists, ok := object.NextRunConfigurationExists()
+	if !ok {
+		v.nextRunConfigurationExists = false
+		return nil
+	}
+	v.nextRunConfigurationExists = nextRunConfigurationExists
+	return nil
+}
+
 func vmSoundcardEnabledConverter(object *ovirtsdk.Vm, v *vm) error {
 	// soundcard_enabled is excluded from the response from oVirt engine by default. Therefore, using the default bool value as return value
 	// see: http://ovirt.github.io/ovirt-engine-api-model/master/#services/vm/methods/get/parameters/all_content
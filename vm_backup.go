@@ -0,0 +1,451 @@
+package ovirtclient
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// BackupID is the identifier for VM backups.
+type BackupID string
+
+// CheckpointID is the identifier for VM checkpoints. Checkpoints mark the state of the disks at the time of a backup
+// and are used as the starting point of incremental backups.
+type CheckpointID string
+
+// BackupClient contains the methods required for backing up VMs using the incremental backup API of the engine.
+type BackupClient interface {
+	// StartVMBackup starts a backup of the specified disks of a VM. If fromCheckpointID is empty, a full backup is
+	// taken. Otherwise, the backup is incremental and only contains the changes since the specified checkpoint.
+	// The backup starts out in the BackupPhaseInitializing phase. Use WaitForVMBackupPhase to wait for it to become
+	// BackupPhaseReady before downloading the disks, and call FinalizeVMBackup afterwards to unlock the disks. A new
+	// checkpoint is created for every backup.
+	//
+	// Incremental backups require the disks to be in the QCOW2 format.
+	StartVMBackup(
+		vmID VMID,
+		diskIDs []DiskID,
+		fromCheckpointID CheckpointID,
+		retries ...RetryStrategy,
+	) (Backup, error)
+	// GetVMBackup returns a single backup of a VM.
+	GetVMBackup(vmID VMID, backupID BackupID, retries ...RetryStrategy) (Backup, error)
+	// FinalizeVMBackup finalizes the backup. The backup must be in the BackupPhaseReady phase. The finalization
+	// takes place in the background, use WaitForVMBackupPhase to wait for BackupPhaseSucceeded.
+	FinalizeVMBackup(vmID VMID, backupID BackupID, retries ...RetryStrategy) error
+	// WaitForVMBackupPhase waits for the backup to reach the desired phase. It returns an error if the backup fails.
+	WaitForVMBackupPhase(
+		vmID VMID,
+		backupID BackupID,
+		phase BackupPhase,
+		retries ...RetryStrategy,
+	) (Backup, error)
+	// StartDownloadVMBackupDisk starts the download of a disk in a backup that is in the BackupPhaseReady phase. The
+	// download is sparse-aware as described in StartSparseDownloadDisk. For incremental backups only the extents
+	// changed since the checkpoint are marked dirty and downloaded. Incremental backups can only be downloaded with
+	// WriteTo into an io.WriterAt or io.WriteSeeker containing the previous backup.
+	//
+	// The caller MUST close the returned reader, otherwise the image transfer will remain open.
+	StartDownloadVMBackupDisk(
+		vmID VMID,
+		backupID BackupID,
+		diskID DiskID,
+		params SparseDownloadParameters,
+		retries ...RetryStrategy,
+	) (ImageDownload, error)
+	// DownloadVMBackup downloads all disks of a backup in the BackupPhaseReady phase one after the other. The target
+	// function is called for each disk and must return the writer the disk is written to. For incremental backups
+	// the writer must be an io.WriterAt or io.WriteSeeker containing the previous backup of the disk, otherwise an
+	// EBadArgument error is returned. The backup is not finalized.
+	DownloadVMBackup(
+		vmID VMID,
+		backupID BackupID,
+		params SparseDownloadParameters,
+		target func(diskID DiskID) (io.Writer, error),
+		retries ...RetryStrategy,
+	) error
+	// ListVMCheckpoints lists the checkpoints of a VM, ordered from the oldest to the newest.
+	ListVMCheckpoints(vmID VMID, retries ...RetryStrategy) ([]Checkpoint, error)
+	// RemoveVMCheckpoint removes a checkpoint from a VM. Only the oldest checkpoint in the chain can be removed.
+	RemoveVMCheckpoint(vmID VMID, checkpointID CheckpointID, retries ...RetryStrategy) error
+}
+
+// BackupData contains the data access functions of a VM backup.
+type BackupData interface {
+	// ID returns the identifier of the backup.
+	ID() BackupID
+	// VMID returns the identifier of the VM the backup belongs to.
+	VMID() VMID
+	// Phase returns the current phase of the backup.
+	Phase() BackupPhase
+	// FromCheckpointID returns the checkpoint the backup was started from, or an empty string for full backups.
+	FromCheckpointID() CheckpointID
+	// ToCheckpointID returns the checkpoint created by the backup. This is only available once the backup is ready.
+	ToCheckpointID() CheckpointID
+	// DiskIDs returns the list of disks included in the backup.
+	DiskIDs() []DiskID
+	// CreationDate returns the time the backup was started.
+	CreationDate() time.Time
+}
+
+// Backup is a backup of the disks of a VM using the incremental backup API.
+type Backup interface {
+	BackupData
+
+	// Finalize finalizes the backup. See FinalizeVMBackup for details.
+	Finalize(retries ...RetryStrategy) error
+	// WaitForPhase waits for the backup to reach the desired phase and returns the updated backup.
+	WaitForPhase(phase BackupPhase, retries ...RetryStrategy) (Backup, error)
+	// StartDownloadDisk starts the download of a disk in the backup. See StartDownloadVMBackupDisk for details.
+	StartDownloadDisk(diskID DiskID, params SparseDownloadParameters, retries ...RetryStrategy) (ImageDownload, error)
+	// Download downloads all disks in the backup. See DownloadVMBackup for details.
+	Download(
+		params SparseDownloadParameters,
+		target func(diskID DiskID) (io.Writer, error),
+		retries ...RetryStrategy,
+	) error
+}
+
+// BackupPhase is the phase of a VM backup.
+type BackupPhase string
+
+const (
+	// BackupPhaseInitializing indicates that the backup is being prepared.
+	BackupPhaseInitializing BackupPhase = "initializing"
+	// BackupPhaseStarting indicates that the backup is starting on the host.
+	BackupPhaseStarting BackupPhase = "starting"
+	// BackupPhaseReady indicates that the disks of the backup can be downloaded.
+	BackupPhaseReady BackupPhase = "ready"
+	// BackupPhaseFinalizing indicates that the backup is being finalized.
+	BackupPhaseFinalizing BackupPhase = "finalizing"
+	// BackupPhaseSucceeded indicates that the backup has been finalized successfully.
+	BackupPhaseSucceeded BackupPhase = "succeeded"
+	// BackupPhaseFailed indicates that the backup has failed.
+	BackupPhaseFailed BackupPhase = "failed"
+)
+
+// BackupPhaseList is a list of BackupPhase.
+type BackupPhaseList []BackupPhase
+
+// BackupPhaseValues returns all possible BackupPhase values.
+func BackupPhaseValues() BackupPhaseList {
+	return []BackupPhase{
+		BackupPhaseInitializing,
+		BackupPhaseStarting,
+		BackupPhaseReady,
+		BackupPhaseFinalizing,
+		BackupPhaseSucceeded,
+		BackupPhaseFailed,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l BackupPhaseList) Strings() []string {
+	result := make([]string, len(l))
+	for i, phase := range l {
+		result[i] = string(phase)
+	}
+	return result
+}
+
+// Validate returns an error if the backup phase doesn't have a valid value.
+func (p BackupPhase) Validate() error {
+	for _, phase := range BackupPhaseValues() {
+		if phase == p {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid backup phase: %s must be one of: %s",
+		p,
+		strings.Join(BackupPhaseValues().Strings(), ", "),
+	)
+}
+
+// CheckpointData contains the data access functions of a VM checkpoint.
+type CheckpointData interface {
+	// ID returns the identifier of the checkpoint.
+	ID() CheckpointID
+	// VMID returns the identifier of the VM the checkpoint belongs to.
+	VMID() VMID
+	// ParentID returns the identifier of the previous checkpoint in the chain, or an empty string for the first
+	// checkpoint.
+	ParentID() CheckpointID
+	// State returns the state of the checkpoint.
+	State() CheckpointState
+	// CreationDate returns the time the checkpoint was created.
+	CreationDate() time.Time
+	// DiskIDs returns the list of disks included in the checkpoint.
+	DiskIDs() []DiskID
+}
+
+// Checkpoint marks the state of the disks of a VM at the time of a backup.
+type Checkpoint interface {
+	CheckpointData
+
+	// Remove removes the checkpoint. See RemoveVMCheckpoint for details.
+	Remove(retries ...RetryStrategy) error
+}
+
+// CheckpointState is the state of a VM checkpoint.
+type CheckpointState string
+
+const (
+	// CheckpointStateCreated indicates that the checkpoint can be used for incremental backups.
+	CheckpointStateCreated CheckpointState = "created"
+	// CheckpointStateInvalid indicates that the checkpoint can no longer be used for incremental backups, for example
+	// because the dirty bitmaps of the disks have been lost.
+	CheckpointStateInvalid CheckpointState = "invalid"
+)
+
+// CheckpointStateList is a list of CheckpointState.
+type CheckpointStateList []CheckpointState
+
+// CheckpointStateValues returns all possible CheckpointState values.
+func CheckpointStateValues() CheckpointStateList {
+	return []CheckpointState{
+		CheckpointStateCreated,
+		CheckpointStateInvalid,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l CheckpointStateList) Strings() []string {
+	result := make([]string, len(l))
+	for i, state := range l {
+		result[i] = string(state)
+	}
+	return result
+}
+
+// Validate returns an error if the checkpoint state doesn't have a valid value.
+func (s CheckpointState) Validate() error {
+	for _, state := range CheckpointStateValues() {
+		if state == s {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid checkpoint state: %s must be one of: %s",
+		s,
+		strings.Join(CheckpointStateValues().Strings(), ", "),
+	)
+}
+
+type backup struct {
+	client Client
+
+	id               BackupID
+	vmID             VMID
+	phase            BackupPhase
+	fromCheckpointID CheckpointID
+	toCheckpointID   CheckpointID
+	diskIDs          []DiskID
+	creationDate     time.Time
+}
+
+func (b *backup) ID() BackupID {
+	return b.id
+}
+
+func (b *backup) VMID() VMID {
+	return b.vmID
+}
+
+func (b *backup) Phase() BackupPhase {
+	return b.phase
+}
+
+func (b *backup) FromCheckpointID() CheckpointID {
+	return b.fromCheckpointID
+}
+
+func (b *backup) ToCheckpointID() CheckpointID {
+	return b.toCheckpointID
+}
+
+func (b *backup) DiskIDs() []DiskID {
+	return b.diskIDs
+}
+
+func (b *backup) CreationDate() time.Time {
+	return b.creationDate
+}
+
+func (b *backup) Finalize(retries ...RetryStrategy) error {
+	return b.client.FinalizeVMBackup(b.vmID, b.id, retries...)
+}
+
+func (b *backup) WaitForPhase(phase BackupPhase, retries ...RetryStrategy) (Backup, error) {
+	return b.client.WaitForVMBackupPhase(b.vmID, b.id, phase, retries...)
+}
+
+func (b *backup) StartDownloadDisk(
+	diskID DiskID,
+	params SparseDownloadParameters,
+	retries ...RetryStrategy,
+) (ImageDownload, error) {
+	return b.client.StartDownloadVMBackupDisk(b.vmID, b.id, diskID, params, retries...)
+}
+
+func (b *backup) Download(
+	params SparseDownloadParameters,
+	target func(diskID DiskID) (io.Writer, error),
+	retries ...RetryStrategy,
+) error {
+	return b.client.DownloadVMBackup(b.vmID, b.id, params, target, retries...)
+}
+
+// clone creates a copy of the backup so the mock can hand out objects that don't change under the caller.
+func (b *backup) clone() *backup {
+	diskIDs := make([]DiskID, len(b.diskIDs))
+	copy(diskIDs, b.diskIDs)
+	return &backup{
+		client:           b.client,
+		id:               b.id,
+		vmID:             b.vmID,
+		phase:            b.phase,
+		fromCheckpointID: b.fromCheckpointID,
+		toCheckpointID:   b.toCheckpointID,
+		diskIDs:          diskIDs,
+		creationDate:     b.creationDate,
+	}
+}
+
+func convertSDKBackup(sdkObject *ovirtsdk.Backup, vmID VMID, client Client) (Backup, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("backup", "id")
+	}
+	phase, ok := sdkObject.Phase()
+	if !ok {
+		return nil, newFieldNotFound("backup", "phase")
+	}
+	if sdkVM, ok := sdkObject.Vm(); ok {
+		if sdkVMID, ok := sdkVM.Id(); ok {
+			vmID = VMID(sdkVMID)
+		}
+	}
+	fromCheckpointID, _ := sdkObject.FromCheckpointId()
+	toCheckpointID, _ := sdkObject.ToCheckpointId()
+	creationDate, _ := sdkObject.CreationDate()
+	diskIDs, err := convertSDKDiskIDs(sdkObject.Disks())
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to convert disks of backup %s", id)
+	}
+	return &backup{
+		client:           client,
+		id:               BackupID(id),
+		vmID:             vmID,
+		phase:            BackupPhase(phase),
+		fromCheckpointID: CheckpointID(fromCheckpointID),
+		toCheckpointID:   CheckpointID(toCheckpointID),
+		diskIDs:          diskIDs,
+		creationDate:     creationDate,
+	}, nil
+}
+
+type checkpoint struct {
+	client Client
+
+	id           CheckpointID
+	vmID         VMID
+	parentID     CheckpointID
+	state        CheckpointState
+	creationDate time.Time
+	diskIDs      []DiskID
+}
+
+func (c *checkpoint) ID() CheckpointID {
+	return c.id
+}
+
+func (c *checkpoint) VMID() VMID {
+	return c.vmID
+}
+
+func (c *checkpoint) ParentID() CheckpointID {
+	return c.parentID
+}
+
+func (c *checkpoint) State() CheckpointState {
+	return c.state
+}
+
+func (c *checkpoint) CreationDate() time.Time {
+	return c.creationDate
+}
+
+func (c *checkpoint) DiskIDs() []DiskID {
+	return c.diskIDs
+}
+
+func (c *checkpoint) Remove(retries ...RetryStrategy) error {
+	return c.client.RemoveVMCheckpoint(c.vmID, c.id, retries...)
+}
+
+// clone creates a copy of the checkpoint so the mock can hand out objects that don't change under the caller.
+func (c *checkpoint) clone() *checkpoint {
+	diskIDs := make([]DiskID, len(c.diskIDs))
+	copy(diskIDs, c.diskIDs)
+	return &checkpoint{
+		client:       c.client,
+		id:           c.id,
+		vmID:         c.vmID,
+		parentID:     c.parentID,
+		state:        c.state,
+		creationDate: c.creationDate,
+		diskIDs:      diskIDs,
+	}
+}
+
+func convertSDKCheckpoint(sdkObject *ovirtsdk.Checkpoint, vmID VMID, client Client) (Checkpoint, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("checkpoint", "id")
+	}
+	state, ok := sdkObject.State()
+	if !ok {
+		return nil, newFieldNotFound("checkpoint", "state")
+	}
+	if sdkVM, ok := sdkObject.Vm(); ok {
+		if sdkVMID, ok := sdkVM.Id(); ok {
+			vmID = VMID(sdkVMID)
+		}
+	}
+	parentID, _ := sdkObject.ParentId()
+	creationDate, _ := sdkObject.CreationDate()
+	diskIDs, err := convertSDKDiskIDs(sdkObject.Disks())
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to convert disks of checkpoint %s", id)
+	}
+	return &checkpoint{
+		client:       client,
+		id:           CheckpointID(id),
+		vmID:         vmID,
+		parentID:     CheckpointID(parentID),
+		state:        CheckpointState(state),
+		creationDate: creationDate,
+		diskIDs:      diskIDs,
+	}, nil
+}
+
+// convertSDKDiskIDs extracts the disk IDs from an optional SDK disk list.
+func convertSDKDiskIDs(sdkDisks *ovirtsdk.DiskSlice, ok bool) ([]DiskID, error) {
+	if !ok {
+		return nil, nil
+	}
+	var diskIDs []DiskID
+	for _, sdkDisk := range sdkDisks.Slice() {
+		diskID, ok := sdkDisk.Id()
+		if !ok {
+			return nil, newFieldNotFound("disk", "id")
+		}
+		diskIDs = append(diskIDs, DiskID(diskID))
+	}
+	return diskIDs, nil
+}
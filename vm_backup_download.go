@@ -0,0 +1,168 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"io"
+)
+
+func (o *oVirtClient) StartDownloadVMBackupDisk(
+	vmID VMID,
+	backupID BackupID,
+	diskID DiskID,
+	params SparseDownloadParameters,
+	retries ...RetryStrategy,
+) (ImageDownload, error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	if err := validateSparseDownload(ImageFormatRaw, params); err != nil {
+		return nil, err
+	}
+	b, err := o.GetVMBackup(vmID, backupID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateBackupDiskDownload(b, diskID); err != nil {
+		return nil, err
+	}
+
+	o.logger.Infof("Starting download of disk %s from backup %s of VM %s...", diskID, backupID, vmID)
+	disk, err := o.GetDisk(diskID, retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to fetch disk for backup download")
+	}
+
+	source := &oVirtSparseDownloadSource{
+		cli:         o,
+		logger:      o.logger,
+		retries:     retries,
+		httpClient:  o.httpClient,
+		disk:        disk,
+		format:      ImageFormatRaw,
+		backupID:    backupID,
+		incremental: b.FromCheckpointID() != "",
+	}
+	return newSparseImageDownload(source, params, source.incremental), nil
+}
+
+func (o *oVirtClient) DownloadVMBackup(
+	vmID VMID,
+	backupID BackupID,
+	params SparseDownloadParameters,
+	target func(diskID DiskID) (io.Writer, error),
+	retries ...RetryStrategy,
+) error {
+	return downloadVMBackup(o, vmID, backupID, params, target, retries)
+}
+
+func (m *mockClient) StartDownloadVMBackupDisk(
+	vmID VMID,
+	backupID BackupID,
+	diskID DiskID,
+	params SparseDownloadParameters,
+	retries ...RetryStrategy,
+) (result ImageDownload, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if err := validateSparseDownload(ImageFormatRaw, params); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("starting download of disk %s from backup %s of VM %s", diskID, backupID, vmID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			b, err := m.getVMBackup(vmID, backupID)
+			if err != nil {
+				return err
+			}
+			if err := validateBackupDiskDownload(b, diskID); err != nil {
+				return err
+			}
+
+			incremental := b.fromCheckpointID != ""
+			source := &mockSparseDownloadSource{
+				data:    b.diskData[diskID],
+				release: func() {},
+			}
+			if incremental {
+				source.changedBlocks = b.changedBlocks[diskID]
+			}
+			result = newSparseImageDownload(source, params, incremental)
+			return nil
+		})
+	return
+}
+
+func (m *mockClient) DownloadVMBackup(
+	vmID VMID,
+	backupID BackupID,
+	params SparseDownloadParameters,
+	target func(diskID DiskID) (io.Writer, error),
+	retries ...RetryStrategy,
+) error {
+	return downloadVMBackup(m, vmID, backupID, params, target, retries)
+}
+
+// validateBackupDiskDownload checks if the disk can be downloaded from the backup.
+func validateBackupDiskDownload(b BackupData, diskID DiskID) error {
+	if b.Phase() != BackupPhaseReady {
+		return newError(
+			EConflict,
+			"backup %s is in phase %s, disks can only be downloaded in phase %s",
+			b.ID(),
+			b.Phase(),
+			BackupPhaseReady,
+		)
+	}
+	for _, backupDiskID := range b.DiskIDs() {
+		if backupDiskID == diskID {
+			return nil
+		}
+	}
+	return newError(EBadArgument, "disk %s is not part of backup %s", diskID, b.ID())
+}
+
+// downloadVMBackup downloads all disks of a backup one after the other using the client.
+func downloadVMBackup(
+	client Client,
+	vmID VMID,
+	backupID BackupID,
+	params SparseDownloadParameters,
+	target func(diskID DiskID) (io.Writer, error),
+	retries []RetryStrategy,
+) error {
+	b, err := client.GetVMBackup(vmID, backupID, retries...)
+	if err != nil {
+		return err
+	}
+	for _, diskID := range b.DiskIDs() {
+		writer, err := target(diskID)
+		if err != nil {
+			return wrap(err, ELocalIO, "failed to open download target for disk %s of backup %s", diskID, backupID)
+		}
+		if b.FromCheckpointID() != "" && !isSeekableWriter(writer) {
+			return newError(
+				EBadArgument,
+				"the download target for disk %s of incremental backup %s must be an io.WriterAt or io.WriteSeeker "+
+					"containing the previous backup",
+				diskID,
+				backupID,
+			)
+		}
+		download, err := client.StartDownloadVMBackupDisk(vmID, backupID, diskID, params, retries...)
+		if err != nil {
+			return err
+		}
+		<-download.Initialized()
+		if err := download.Err(); err != nil {
+			_ = download.Close()
+			return err
+		}
+		if _, err := download.WriteTo(writer); err != nil {
+			return wrap(err, EUnidentified, "failed to download disk %s of backup %s", diskID, backupID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+)
+
+func (o *oVirtClient) FinalizeVMBackup(vmID VMID, backupID BackupID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("finalizing backup %s of VM %s", backupID, vmID),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				BackupsService().
+				BackupService(string(backupID)).
+				Finalize().
+				Send()
+			return err
+		},
+	)
+	return err
+}
+
+func (m *mockClient) FinalizeVMBackup(vmID VMID, backupID BackupID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("finalizing backup %s of VM %s", backupID, vmID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			b, err := m.getVMBackup(vmID, backupID)
+			if err != nil {
+				return err
+			}
+			if b.phase != BackupPhaseReady {
+				return newError(EConflict, "backup %s is in phase %s, not %s", backupID, b.phase, BackupPhaseReady)
+			}
+			b.phase = BackupPhaseFinalizing
+
+			go func() {
+				time.Sleep(time.Second)
+				m.lock.Lock()
+				defer m.lock.Unlock()
+				b.phase = BackupPhaseSucceeded
+				b.diskData = nil
+				unlockSnapshotDisks(b.disks)
+			}()
+			return nil
+		})
+}
@@ -0,0 +1,63 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetVMBackup(vmID VMID, backupID BackupID, retries ...RetryStrategy) (result Backup, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting backup %s for VM %s", backupID, vmID),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				BackupsService().
+				BackupService(string(backupID)).
+				Get().
+				Follow("disks").
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkBackup, ok := response.Backup()
+			if !ok {
+				return newError(
+					ENotFound,
+					"no backup returned when getting backup %s for VM %s",
+					backupID,
+					vmID,
+				)
+			}
+			result, err = convertSDKBackup(sdkBackup, vmID, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert backup %s", backupID)
+			}
+			return nil
+		},
+	)
+	return result, err
+}
+
+func (m *mockClient) GetVMBackup(vmID VMID, backupID BackupID, retries ...RetryStrategy) (result Backup, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	err = retry(
+		fmt.Sprintf("getting backup %s of VM %s", backupID, vmID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			b, err := m.getVMBackup(vmID, backupID)
+			if err != nil {
+				return err
+			}
+			result = b.clone()
+			return nil
+		})
+	return
+}
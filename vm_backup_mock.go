@@ -0,0 +1,147 @@
+package ovirtclient
+
+import (
+	"time"
+)
+
+// backupWithData adds the disk contents at the time of the backup for mocking purposes.
+type backupWithData struct {
+	backup
+
+	// disks are the disks locked by the backup.
+	disks []*diskWithData
+	// diskData contains the contents of the disks at the time the backup was started.
+	diskData map[DiskID][]byte
+	// changedBlocks contains the blocks of each disk changed since the checkpoint the backup was started from.
+	changedBlocks map[DiskID]map[uint64]bool
+	// diskSequences contains the last write sequence of each disk at the time the backup was started. It is recorded
+	// in the checkpoint created by the backup.
+	diskSequences map[DiskID]uint64
+}
+
+// checkpointWithData adds the write sequence of each disk at the time of the checkpoint for mocking purposes.
+type checkpointWithData struct {
+	checkpoint
+
+	diskSequences map[DiskID]uint64
+}
+
+// newMockBackup creates a backup of the disks in the initializing phase. If fromCheckpoint is passed, the blocks
+// changed since the checkpoint are recorded for incremental downloads.
+func newMockBackup(
+	client Client,
+	vmID VMID,
+	id BackupID,
+	disks []*diskWithData,
+	fromCheckpoint *checkpointWithData,
+) *backupWithData {
+	b := &backupWithData{
+		backup: backup{
+			client:       client,
+			id:           id,
+			vmID:         vmID,
+			phase:        BackupPhaseInitializing,
+			creationDate: time.Now(),
+		},
+		disks:         disks,
+		diskData:      copyDiskData(disks),
+		changedBlocks: map[DiskID]map[uint64]bool{},
+		diskSequences: map[DiskID]uint64{},
+	}
+	for _, d := range disks {
+		b.diskIDs = append(b.diskIDs, d.id)
+		b.diskSequences[d.id] = d.changeSequence()
+		if fromCheckpoint != nil {
+			b.changedBlocks[d.id] = d.changedBlocksSince(fromCheckpoint.diskSequences[d.id])
+		}
+	}
+	if fromCheckpoint != nil {
+		b.fromCheckpointID = fromCheckpoint.id
+	}
+	return b
+}
+
+// getVMBackup returns the stored backup for a VM. Must be called with the lock held.
+func (m *mockClient) getVMBackup(vmID VMID, backupID BackupID) (*backupWithData, error) {
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	for _, b := range m.backupsByVM[vmID] {
+		if b.id == backupID {
+			return b, nil
+		}
+	}
+	return nil, newError(ENotFound, "backup with ID %s not found on VM %s", backupID, vmID)
+}
+
+// activeVMBackup returns the backup currently running on the VM, or nil. Must be called with the lock held.
+func (m *mockClient) activeVMBackup(vmID VMID) *backupWithData {
+	for _, b := range m.backupsByVM[vmID] {
+		if b.phase != BackupPhaseSucceeded && b.phase != BackupPhaseFailed {
+			return b
+		}
+	}
+	return nil
+}
+
+// getVMCheckpoint returns the stored checkpoint for a VM. Must be called with the lock held.
+func (m *mockClient) getVMCheckpoint(vmID VMID, checkpointID CheckpointID) (*checkpointWithData, error) {
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	for _, c := range m.checkpointsByVM[vmID] {
+		if c.id == checkpointID {
+			return c, nil
+		}
+	}
+	return nil, newError(ENotFound, "checkpoint with ID %s not found on VM %s", checkpointID, vmID)
+}
+
+// getIncrementalBackupCheckpoint returns the checkpoint an incremental backup of the disks can be started from. Must
+// be called with the lock held.
+func (m *mockClient) getIncrementalBackupCheckpoint(
+	vmID VMID,
+	checkpointID CheckpointID,
+	diskIDs []DiskID,
+) (*checkpointWithData, error) {
+	c, err := m.getVMCheckpoint(vmID, checkpointID)
+	if err != nil {
+		return nil, err
+	}
+	if c.state != CheckpointStateCreated {
+		return nil, newError(EConflict, "checkpoint %s is in state %s", checkpointID, c.state)
+	}
+	for _, diskID := range diskIDs {
+		if _, ok := c.diskSequences[diskID]; !ok {
+			return nil, newError(
+				EBadArgument,
+				"disk %s is not part of checkpoint %s, an incremental backup is not possible",
+				diskID,
+				checkpointID,
+			)
+		}
+	}
+	return c, nil
+}
+
+// createBackupCheckpoint adds a new checkpoint at the end of the checkpoint chain of the VM for the backup. Must be
+// called with the lock held.
+func (m *mockClient) createBackupCheckpoint(b *backupWithData) {
+	c := &checkpointWithData{
+		checkpoint: checkpoint{
+			client:       m,
+			id:           CheckpointID(m.GenerateUUID()),
+			vmID:         b.vmID,
+			state:        CheckpointStateCreated,
+			creationDate: time.Now(),
+			diskIDs:      b.diskIDs,
+		},
+		diskSequences: b.diskSequences,
+	}
+	checkpoints := m.checkpointsByVM[b.vmID]
+	if len(checkpoints) > 0 {
+		c.parentID = checkpoints[len(checkpoints)-1].id
+	}
+	m.checkpointsByVM[b.vmID] = append(checkpoints, c)
+	b.toCheckpointID = c.id
+}
@@ -0,0 +1,130 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) StartVMBackup(
+	vmID VMID,
+	diskIDs []DiskID,
+	fromCheckpointID CheckpointID,
+	retries ...RetryStrategy,
+) (result Backup, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if err := validateBackupDisks(diskIDs); err != nil {
+		return nil, err
+	}
+	disks := make([]ovirtsdk.DiskBuilder, len(diskIDs))
+	for i, diskID := range diskIDs {
+		disks[i] = *ovirtsdk.NewDiskBuilder().Id(string(diskID))
+	}
+	builder := ovirtsdk.NewBackupBuilder().DisksBuilderOfAny(disks...)
+	if fromCheckpointID != "" {
+		builder.FromCheckpointId(string(fromCheckpointID))
+	}
+	sdkBackup, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build backup")
+	}
+	err = retry(
+		fmt.Sprintf("starting backup for VM %s", vmID),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				BackupsService().
+				Add().
+				Backup(sdkBackup).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkBackup, ok := response.Backup()
+			if !ok {
+				return newFieldNotFound("backup start response", "backup")
+			}
+			result, err = convertSDKBackup(sdkBackup, vmID, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert backup")
+			}
+			return nil
+		},
+	)
+	return result, err
+}
+
+func validateBackupDisks(diskIDs []DiskID) error {
+	if len(diskIDs) == 0 {
+		return newError(EBadArgument, "at least one disk is required for a backup")
+	}
+	seen := map[DiskID]int{}
+	for i, diskID := range diskIDs {
+		if previous, ok := seen[diskID]; ok {
+			return newError(EBadArgument, "disk %s appears twice, in position %d and %d", diskID, previous, i)
+		}
+		seen[diskID] = i
+	}
+	return nil
+}
+
+func (m *mockClient) StartVMBackup(
+	vmID VMID,
+	diskIDs []DiskID,
+	fromCheckpointID CheckpointID,
+	retries ...RetryStrategy,
+) (result Backup, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if err := validateBackupDisks(diskIDs); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("starting backup of VM %s", vmID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, ok := m.vms[vmID]; !ok {
+				return newError(ENotFound, "VM with ID %s not found", vmID)
+			}
+			if active := m.activeVMBackup(vmID); active != nil {
+				return newError(EConflict, "VM %s already has a backup in phase %s", vmID, active.phase)
+			}
+			disks, err := m.vmSnapshotDisks(vmID, diskIDs)
+			if err != nil {
+				return err
+			}
+			var fromCheckpoint *checkpointWithData
+			if fromCheckpointID != "" {
+				if fromCheckpoint, err = m.getIncrementalBackupCheckpoint(vmID, fromCheckpointID, diskIDs); err != nil {
+					return err
+				}
+			}
+			if err := m.lockSnapshotDisks(disks); err != nil {
+				return err
+			}
+
+			b := newMockBackup(m, vmID, BackupID(m.GenerateUUID()), disks, fromCheckpoint)
+			m.backupsByVM[vmID] = append(m.backupsByVM[vmID], b)
+
+			go func() {
+				time.Sleep(time.Second)
+				m.lock.Lock()
+				defer m.lock.Unlock()
+				m.createBackupCheckpoint(b)
+				b.phase = BackupPhaseReady
+			}()
+
+			result = b.clone()
+			return nil
+		})
+	return
+}
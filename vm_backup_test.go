@@ -0,0 +1,200 @@
+package ovirtclient_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMBackupFullAndIncremental(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	if _, ok := helper.GetClient().(ovirtclient.MockClient); !ok {
+		t.Skip("Incremental backups require QCOW2 disks with incremental backup enabled on a running VM.")
+	}
+	client := helper.GetClient()
+	testImageData, _ := getTestImageData(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+	data := make([]byte, 4096)
+	copy(data, testImageData)
+	assertCanUploadBackupData(t, client, disk.ID(), data)
+
+	target := filepath.Join(t.TempDir(), "backup.raw")
+	full := assertCanBackupVM(t, client, vm.ID(), disk.ID(), "")
+	var targetFile *os.File
+	err := full.Download(
+		ovirtclient.SparseDownloadParams(),
+		func(diskID ovirtclient.DiskID) (io.Writer, error) {
+			var err error
+			targetFile, err = os.Create(target)
+			return targetFile, err
+		},
+	)
+	if targetFile != nil {
+		_ = targetFile.Close()
+	}
+	if err != nil {
+		t.Fatalf("Failed to download full backup (%v)", err)
+	}
+	assertCanFinalizeBackup(t, full)
+	assertFileContents(t, target, data)
+
+	changed := make([]byte, len(data))
+	copy(changed, data)
+	copy(changed[2048:], testImageData)
+	assertCanUploadBackupData(t, client, disk.ID(), changed)
+
+	incremental := assertCanBackupVM(t, client, vm.ID(), disk.ID(), full.ToCheckpointID())
+	download, err := incremental.StartDownloadDisk(disk.ID(), ovirtclient.SparseDownloadParams())
+	if err != nil {
+		t.Fatalf("Failed to start incremental backup download (%v)", err)
+	}
+	<-download.Initialized()
+	for _, extent := range download.Extents() {
+		if extent.Dirty && (extent.Start < 2048 || extent.Start+extent.Length > 2048+uint64(len(testImageData))) {
+			t.Fatalf("Extent at offset %d with length %d is dirty despite not being changed.", extent.Start, extent.Length)
+		}
+	}
+	assertIncrementalBackupRejectsByteStream(t, incremental, download)
+	fh, err := os.OpenFile(target, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatalf("Failed to open full backup file (%v)", err)
+	}
+	_, err = download.WriteTo(fh)
+	_ = fh.Close()
+	if err != nil {
+		t.Fatalf("Failed to download incremental backup (%v)", err)
+	}
+	assertCanFinalizeBackup(t, incremental)
+	assertFileContents(t, target, changed)
+
+	assertCheckpointChain(t, client, vm.ID(), full.ToCheckpointID(), incremental.ToCheckpointID())
+}
+
+func TestVMBackupRejectsUnknownCheckpoint(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+
+	_, err := client.StartVMBackup(
+		vm.ID(),
+		[]ovirtclient.DiskID{disk.ID()},
+		ovirtclient.CheckpointID(helper.GenerateRandomID(5)),
+	)
+	if err == nil {
+		t.Fatalf("Starting a backup from a non-existent checkpoint did not result in an error.")
+	}
+}
+
+func assertCanUploadBackupData(t *testing.T, client ovirtclient.Client, diskID ovirtclient.DiskID, data []byte) {
+	if err := client.UploadToDisk(diskID, uint64(len(data)), &nopReadCloser{bytes.NewReader(data)}); err != nil {
+		t.Fatalf("Failed to upload data to disk %s (%v)", diskID, err)
+	}
+}
+
+func assertCanBackupVM(
+	t *testing.T,
+	client ovirtclient.Client,
+	vmID ovirtclient.VMID,
+	diskID ovirtclient.DiskID,
+	fromCheckpointID ovirtclient.CheckpointID,
+) ovirtclient.Backup {
+	backup, err := client.StartVMBackup(vmID, []ovirtclient.DiskID{diskID}, fromCheckpointID)
+	if err != nil {
+		t.Fatalf("Failed to start backup of VM %s (%v)", vmID, err)
+	}
+	if backup.FromCheckpointID() != fromCheckpointID {
+		t.Fatalf(
+			"Incorrect checkpoint on backup (expected: %s, got: %s)",
+			fromCheckpointID,
+			backup.FromCheckpointID(),
+		)
+	}
+	backup, err = backup.WaitForPhase(ovirtclient.BackupPhaseReady)
+	if err != nil {
+		t.Fatalf("Backup of VM %s did not become ready (%v)", vmID, err)
+	}
+	if backup.ToCheckpointID() == "" {
+		t.Fatalf("No checkpoint was created for the backup of VM %s.", vmID)
+	}
+	return backup
+}
+
+// assertIncrementalBackupRejectsByteStream checks that an incremental backup cannot be written to a target that
+// cannot seek, since the unchanged regions would be overwritten with zeros.
+func assertIncrementalBackupRejectsByteStream(
+	t *testing.T,
+	backup ovirtclient.Backup,
+	download ovirtclient.ImageDownload,
+) {
+	if _, err := download.WriteTo(&bytes.Buffer{}); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Writing an incremental backup into a buffer did not result in an EBadArgument error (%v)", err)
+	}
+	if _, err := download.Read(make([]byte, 512)); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Reading an incremental backup as a byte stream did not result in an EBadArgument error (%v)", err)
+	}
+	err := backup.Download(
+		ovirtclient.SparseDownloadParams(),
+		func(diskID ovirtclient.DiskID) (io.Writer, error) {
+			return &bytes.Buffer{}, nil
+		},
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Downloading an incremental backup into a buffer did not result in an EBadArgument error (%v)", err)
+	}
+}
+
+func assertCanFinalizeBackup(t *testing.T, backup ovirtclient.Backup) {
+	if err := backup.Finalize(); err != nil {
+		t.Fatalf("Failed to finalize backup %s (%v)", backup.ID(), err)
+	}
+	if _, err := backup.WaitForPhase(ovirtclient.BackupPhaseSucceeded); err != nil {
+		t.Fatalf("Backup %s did not succeed (%v)", backup.ID(), err)
+	}
+}
+
+func assertFileContents(t *testing.T, file string, expected []byte) {
+	contents, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("Failed to read %s (%v)", file, err)
+	}
+	if !bytes.Equal(contents, expected) {
+		t.Fatalf("The contents of %s do not match the expected data.", file)
+	}
+}
+
+func assertCheckpointChain(
+	t *testing.T,
+	client ovirtclient.Client,
+	vmID ovirtclient.VMID,
+	first ovirtclient.CheckpointID,
+	second ovirtclient.CheckpointID,
+) {
+	checkpoints, err := client.ListVMCheckpoints(vmID)
+	if err != nil {
+		t.Fatalf("Failed to list checkpoints of VM %s (%v)", vmID, err)
+	}
+	if len(checkpoints) != 2 || checkpoints[0].ID() != first || checkpoints[1].ID() != second {
+		t.Fatalf("Incorrect checkpoint chain on VM %s.", vmID)
+	}
+	if checkpoints[1].ParentID() != first {
+		t.Fatalf("Incorrect parent of checkpoint (expected: %s, got: %s)", first, checkpoints[1].ParentID())
+	}
+	if err := checkpoints[1].Remove(ovirtclient.MaxTries(1)); err == nil {
+		t.Fatalf("Removing a checkpoint that is not the oldest did not result in an error.")
+	}
+	if err := checkpoints[0].Remove(); err != nil {
+		t.Fatalf("Failed to remove the oldest checkpoint (%v)", err)
+	}
+}
@@ -0,0 +1,57 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) WaitForVMBackupPhase(
+	vmID VMID,
+	backupID BackupID,
+	phase BackupPhase,
+	retries ...RetryStrategy,
+) (result Backup, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	return waitForVMBackupPhase(o, o.logger, vmID, backupID, phase, retries)
+}
+
+func (m *mockClient) WaitForVMBackupPhase(
+	vmID VMID,
+	backupID BackupID,
+	phase BackupPhase,
+	retries ...RetryStrategy,
+) (result Backup, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(m))
+	return waitForVMBackupPhase(m, m.logger, vmID, backupID, phase, retries)
+}
+
+func waitForVMBackupPhase(
+	client Client,
+	logger Logger,
+	vmID VMID,
+	backupID BackupID,
+	phase BackupPhase,
+	retries []RetryStrategy,
+) (result Backup, err error) {
+	if err := phase.Validate(); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("waiting for backup %s of VM %s to enter phase \"%s\"", backupID, vmID, phase),
+		logger,
+		retries,
+		func() error {
+			result, err = client.GetVMBackup(vmID, backupID, retries...)
+			if err != nil {
+				return err
+			}
+			if result.Phase() == BackupPhaseFailed && phase != BackupPhaseFailed {
+				return newError(EBackupFailed, "backup %s of VM %s failed", backupID, vmID)
+			}
+			if result.Phase() != phase {
+				return newError(EPending, "backup %s phase is \"%s\", not \"%s\"", backupID, result.Phase(), phase)
+			}
+			return nil
+		},
+	)
+	return result, err
+}
@@ -0,0 +1,63 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListVMCheckpoints(vmID VMID, retries ...RetryStrategy) (result []Checkpoint, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("listing checkpoints for VM %s", vmID),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				CheckpointsService().
+				List().
+				Follow("disks").
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkCheckpoints, ok := response.Checkpoints()
+			if !ok {
+				return nil
+			}
+			result = make([]Checkpoint, len(sdkCheckpoints.Slice()))
+			for i, sdkCheckpoint := range sdkCheckpoints.Slice() {
+				result[i], err = convertSDKCheckpoint(sdkCheckpoint, vmID, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert checkpoint during listing item #%d", i)
+				}
+			}
+			return nil
+		},
+	)
+	return result, err
+}
+
+func (m *mockClient) ListVMCheckpoints(vmID VMID, retries ...RetryStrategy) (result []Checkpoint, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	err = retry(
+		fmt.Sprintf("listing checkpoints of VM %s", vmID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, ok := m.vms[vmID]; !ok {
+				return newError(ENotFound, "VM with ID %s not found", vmID)
+			}
+			checkpoints := m.checkpointsByVM[vmID]
+			result = make([]Checkpoint, len(checkpoints))
+			for i, c := range checkpoints {
+				result[i] = c.clone()
+			}
+			return nil
+		})
+	return
+}
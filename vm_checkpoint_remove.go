@@ -0,0 +1,56 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) RemoveVMCheckpoint(vmID VMID, checkpointID CheckpointID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("removing checkpoint %s from VM %s", checkpointID, vmID),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				CheckpointsService().
+				CheckpointService(string(checkpointID)).
+				Remove().
+				Send()
+			return err
+		},
+	)
+	return err
+}
+
+func (m *mockClient) RemoveVMCheckpoint(vmID VMID, checkpointID CheckpointID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("removing checkpoint %s of VM %s", checkpointID, vmID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, err := m.getVMCheckpoint(vmID, checkpointID); err != nil {
+				return err
+			}
+			checkpoints := m.checkpointsByVM[vmID]
+			if checkpoints[0].id != checkpointID {
+				return newError(
+					EConflict,
+					"only the oldest checkpoint (%s) of VM %s can be removed",
+					checkpoints[0].id,
+					vmID,
+				)
+			}
+			m.checkpointsByVM[vmID] = checkpoints[1:]
+			if len(m.checkpointsByVM[vmID]) > 0 {
+				m.checkpointsByVM[vmID][0].parentID = ""
+			}
+			return nil
+		})
+}
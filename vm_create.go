@@ -26,38 +26,48 @@ func vmBuilderDescription(params OptionalVMParameters, builder *ovirtsdk.VmBuild
 
 func vmBuilderCPU(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
 	if cpu := params.CPU(); cpu != nil {
-		cpuBuilder := ovirtsdk.NewCpuBuilder()
-		if cpuTopo := cpu.Topo(); cpuTopo != nil {
-			cpuBuilder.TopologyBuilder(ovirtsdk.
-				NewCpuTopologyBuilder().
-				Cores(int64(cpu.Topo().Cores())).
-				Threads(int64(cpu.Topo().Threads())).
-				Sockets(int64(cpu.Topo().Sockets())))
-		}
-		if mode := cpu.Mode(); mode != nil {
-			cpuBuilder.Mode(ovirtsdk.CpuMode(*mode))
-		}
-		builder.CpuBuilder(cpuBuilder)
+		builder.CpuBuilder(sdkCPUBuilder(cpu))
+	}
+}
+
+// sdkCPUBuilder creates the SDK CPU builder from the CPU parameters. It is shared between VM creation and update.
+func sdkCPUBuilder(cpu VMCPUParams) *ovirtsdk.CpuBuilder {
+	cpuBuilder := ovirtsdk.NewCpuBuilder()
+	if cpuTopo := cpu.Topo(); cpuTopo != nil {
+		cpuBuilder.TopologyBuilder(ovirtsdk.
+			NewCpuTopologyBuilder().
+			Cores(int64(cpuTopo.Cores())).
+			Threads(int64(cpuTopo.Threads())).
+			Sockets(int64(cpuTopo.Sockets())))
+	}
+	if mode := cpu.Mode(); mode != nil {
+		cpuBuilder.Mode(ovirtsdk.CpuMode(*mode))
 	}
+	return cpuBuilder
 }
 
 func vmBuilderHugePages(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
 	var customProperties []*ovirtsdk.CustomProperty
 	if hugePages := params.HugePages(); hugePages != nil {
-		customProp, err := ovirtsdk.NewCustomPropertyBuilder().
-			Name("hugepages").
-			Value(strconv.FormatUint(uint64(*hugePages), 10)).
-			Build()
-		if err != nil {
-			panic(newError(EBug, "Failed to build 'hugepages' custom property from value %d", hugePages))
-		}
-		customProperties = append(customProperties, customProp)
+		customProperties = append(customProperties, sdkHugePagesProperty(*hugePages))
 	}
 	if len(customProperties) > 0 {
 		builder.CustomPropertiesOfAny(customProperties...)
 	}
 }
 
+// sdkHugePagesProperty creates the custom property the engine uses to store the hugepage setting.
+func sdkHugePagesProperty(hugePages VMHugePages) *ovirtsdk.CustomProperty {
+	customProp, err := ovirtsdk.NewCustomPropertyBuilder().
+		Name("hugepages").
+		Value(strconv.FormatUint(uint64(hugePages), 10)).
+		Build()
+	if err != nil {
+		panic(newError(EBug, "Failed to build 'hugepages' custom property from value %d", hugePages))
+	}
+	return customProp
+}
+
 func vmBuilderMemory(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
 	if memory := params.Memory(); memory != nil {
 		builder.Memory(*memory)
@@ -68,8 +78,11 @@ func vmBuilderInitialization(params OptionalVMParameters, builder *ovirtsdk.VmBu
 	if params.Initialization() == nil {
 		return
 	}
+	builder.InitializationBuilder(sdkInitializationBuilder(params.Initialization()))
+}
 
-	init := params.Initialization()
+// sdkInitializationBuilder creates the SDK initialization builder. It is shared between VM creation and update.
+func sdkInitializationBuilder(init Initialization) *ovirtsdk.InitializationBuilder {
 	initBuilder := ovirtsdk.NewInitializationBuilder()
 
 	if init.CustomScript() != "" {
@@ -104,23 +117,28 @@ func vmBuilderInitialization(params OptionalVMParameters, builder *ovirtsdk.VmBu
 
 		initBuilder.NicConfigurationsOfAny(nicBuilder.MustBuild())
 	}
-	builder.InitializationBuilder(initBuilder)
+	return initBuilder
 }
 
 func vmPlacementPolicyParameterConverter(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
 	if pp := params.PlacementPolicy(); pp != nil {
-		placementPolicyBuilder := ovirtsdk.NewVmPlacementPolicyBuilder()
-		if affinity := (*pp).Affinity(); affinity != nil {
-			placementPolicyBuilder.Affinity(ovirtsdk.VmAffinity(*affinity))
-		}
-		hosts := make([]ovirtsdk.HostBuilder, len((*pp).HostIDs()))
-		for i, hostID := range (*pp).HostIDs() {
-			hostBuilder := ovirtsdk.NewHostBuilder().Id(string(hostID))
-			hosts[i] = *hostBuilder
-		}
-		placementPolicyBuilder.HostsBuilderOfAny(hosts...)
-		builder.PlacementPolicyBuilder(placementPolicyBuilder)
+		builder.PlacementPolicyBuilder(sdkPlacementPolicyBuilder(*pp))
+	}
+}
+
+// sdkPlacementPolicyBuilder creates the SDK placement policy builder. It is shared between VM creation and update.
+func sdkPlacementPolicyBuilder(pp VMPlacementPolicyParameters) *ovirtsdk.VmPlacementPolicyBuilder {
+	placementPolicyBuilder := ovirtsdk.NewVmPlacementPolicyBuilder()
+	if affinity := pp.Affinity(); affinity != nil {
+		placementPolicyBuilder.Affinity(ovirtsdk.VmAffinity(*affinity))
 	}
+	hosts := make([]ovirtsdk.HostBuilder, len(pp.HostIDs()))
+	for i, hostID := range pp.HostIDs() {
+		hostBuilder := ovirtsdk.NewHostBuilder().Id(string(hostID))
+		hosts[i] = *hostBuilder
+	}
+	placementPolicyBuilder.HostsBuilderOfAny(hosts...)
+	return placementPolicyBuilder
 }
 
 func (o *oVirtClient) CreateVM(clusterID ClusterID, templateID TemplateID, name string, params OptionalVMParameters, retries ...RetryStrategy) (result VM, err error) {
@@ -195,6 +213,7 @@ func createSDKVM(
 		vmOSCreator,
 		vmSerialConsoleCreator,
 		vmSoundcardEnabledCreator,
+		vmUseLatestTemplateVersionCreator,
 	}
 
 	for _, part := range parts {
@@ -249,16 +268,27 @@ func vmSoundcardEnabledCreator(params OptionalVMParameters, builder *ovirtsdk.Vm
 	builder.SoundcardEnabled(*soundcardEnabled)
 }
 
+func vmUseLatestTemplateVersionCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	if useLatest := params.UseLatestTemplateVersion(); useLatest != nil {
+		builder.UseLatestTemplateVersion(*useLatest)
+	}
+}
+
 func vmOSCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
 	if os, ok := params.OS(); ok {
-		osBuilder := ovirtsdk.NewOperatingSystemBuilder()
-		if t := os.Type(); t != nil {
-			osBuilder.Type(*t)
-		}
-		builder.OsBuilder(osBuilder)
+		builder.OsBuilder(sdkOSBuilder(os))
 	}
 }
 
+// sdkOSBuilder creates the SDK operating system builder. It is shared between VM creation and update.
+func sdkOSBuilder(os VMOSParameters) *ovirtsdk.OperatingSystemBuilder {
+	osBuilder := ovirtsdk.NewOperatingSystemBuilder()
+	if t := os.Type(); t != nil {
+		osBuilder.Type(*t)
+	}
+	return osBuilder
+}
+
 func vmTypeCreator(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
 	if vmType := params.VMType(); vmType != nil {
 		builder.Type(ovirtsdk.VmType(*vmType))
@@ -273,18 +303,23 @@ func vmInstanceTypeID(params OptionalVMParameters, builder *ovirtsdk.VmBuilder)
 
 func vmBuilderMemoryPolicy(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
 	if memPolicyParams := params.MemoryPolicy(); memPolicyParams != nil {
-		memoryPolicyBuilder := ovirtsdk.NewMemoryPolicyBuilder()
-		if guaranteed := (*memPolicyParams).Guaranteed(); guaranteed != nil {
-			memoryPolicyBuilder.Guaranteed(*guaranteed)
-		}
-		if max := (*memPolicyParams).Max(); max != nil {
-			memoryPolicyBuilder.Max(*max)
-		}
-		if ballooning := (*memPolicyParams).Ballooning(); ballooning != nil {
-			memoryPolicyBuilder.Ballooning(*ballooning)
-		}
-		builder.MemoryPolicyBuilder(memoryPolicyBuilder)
+		builder.MemoryPolicyBuilder(sdkMemoryPolicyBuilder(*memPolicyParams))
+	}
+}
+
+// sdkMemoryPolicyBuilder creates the SDK memory policy builder. It is shared between VM creation and update.
+func sdkMemoryPolicyBuilder(memPolicyParams MemoryPolicyParameters) *ovirtsdk.MemoryPolicyBuilder {
+	memoryPolicyBuilder := ovirtsdk.NewMemoryPolicyBuilder()
+	if guaranteed := memPolicyParams.Guaranteed(); guaranteed != nil {
+		memoryPolicyBuilder.Guaranteed(*guaranteed)
+	}
+	if max := memPolicyParams.Max(); max != nil {
+		memoryPolicyBuilder.Max(*max)
 	}
+	if ballooning := memPolicyParams.Ballooning(); ballooning != nil {
+		memoryPolicyBuilder.Ballooning(*ballooning)
+	}
+	return memoryPolicyBuilder
 }
 
 func validateVMCreationParameters(clusterID ClusterID, templateID TemplateID, name string, params OptionalVMParameters) error {
@@ -306,20 +341,8 @@ func validateVMCreationParameters(clusterID ClusterID, templateID TemplateID, na
 		mem := int64(1024 * 1024 * 1024)
 		memory = &mem
 	}
-	guaranteedMemory := memory
-	if memPolicy := params.MemoryPolicy(); memPolicy != nil {
-		guaranteed := (*memPolicy).Guaranteed()
-		if guaranteed != nil {
-			guaranteedMemory = guaranteed
-		}
-	}
-	if *guaranteedMemory > *memory {
-		return newError(
-			EBadArgument,
-			"guaranteed memory is larger than the VM memory (%d > %d)",
-			*guaranteedMemory,
-			*memory,
-		)
+	if err := validateVMMemory(*memory, params.MemoryPolicy()); err != nil {
+		return err
 	}
 
 	disks := params.Disks()
@@ -345,6 +368,27 @@ func validateVMCreationParameters(clusterID ClusterID, templateID TemplateID, na
 	return nil
 }
 
+// validateVMMemory checks that the guaranteed memory in the memory policy does not exceed the VM memory. It is
+// shared between VM creation and update.
+func validateVMMemory(memory int64, memPolicy *MemoryPolicyParameters) error {
+	if memPolicy == nil {
+		return nil
+	}
+	guaranteed := (*memPolicy).Guaranteed()
+	if guaranteed == nil {
+		return nil
+	}
+	if *guaranteed > memory {
+		return newError(
+			EBadArgument,
+			"guaranteed memory is larger than the VM memory (%d > %d)",
+			*guaranteed,
+			memory,
+		)
+	}
+	return nil
+}
+
 func (m *mockClient) CreateVM(
 	clusterID ClusterID,
 	templateID TemplateID,
@@ -377,6 +421,10 @@ func (m *mockClient) CreateVM(
 			if !ok {
 				return newError(ENotFound, "template with ID %s not found", templateID)
 			}
+			if useLatest := params.UseLatestTemplateVersion(); useLatest != nil && *useLatest {
+				tpl = m.getLatestTemplateVersion(tpl.baseTemplateID)
+				templateID = tpl.id
+			}
 			if tpl.status != TemplateStatusOK {
 				return newError(EConflict, "template in status \"%s\"", tpl.status)
 			}
@@ -386,6 +434,11 @@ func (m *mockClient) CreateVM(
 					return newError(EConflict, "A VM with the name \"%s\" already exists.", name)
 				}
 			}
+			if instanceTypeID := params.InstanceTypeID(); instanceTypeID != nil {
+				if _, ok := m.instanceTypes[*instanceTypeID]; !ok {
+					return newError(ENotFound, "instance type %s not found", *instanceTypeID)
+				}
+			}
 
 			cpu := m.createVMCPU(params, tpl)
 
@@ -399,6 +452,10 @@ func (m *mockClient) CreateVM(
 
 			m.vmIPs[vm.id] = map[string][]net.IP{}
 			m.addGraphicsConsoles(vm)
+			m.snapshotsByVM[vm.id] = []*snapshotWithData{
+				newActiveSnapshot(m, vm.id, SnapshotID(m.GenerateUUID())),
+			}
+			m.addVMEvent(vm, mockEventCodeVMCreated, "VM %s was created.", vm.name)
 
 			result = vm
 			return nil
@@ -409,18 +466,9 @@ func (m *mockClient) CreateVM(
 }
 
 func (m *mockClient) addGraphicsConsoles(vm *vm) {
-	m.graphicsConsolesByVM[vm.id] = []*vmGraphicsConsole{
-		{
-			client: m,
-			id:     VMGraphicsConsoleID(m.GenerateUUID()),
-			vmID:   vm.id,
-		},
-		{
-			client: m,
-			id:     VMGraphicsConsoleID(m.GenerateUUID()),
-			vmID:   vm.id,
-		},
-	}
+	m.graphicsConsolesByVM[vm.id] = []*mockGraphicsConsole{}
+	m.addMockGraphicsConsole(vm.id, GraphicsConsoleProtocolSPICE)
+	m.addMockGraphicsConsole(vm.id, GraphicsConsoleProtocolVNC)
 }
 
 func (m *mockClient) createVM(
@@ -437,9 +485,16 @@ func (m *mockClient) createVM(
 	}
 
 	vmType := m.createVMType(params)
+	it := m.vmInstanceType(params)
 	console := false
 	if serialConsole := params.SerialConsole(); serialConsole != nil {
 		console = *serialConsole
+	} else if it != nil {
+		console = it.serialConsole
+	}
+	hugePages := params.HugePages()
+	if hugePages == nil && it != nil {
+		hugePages = it.hugePages
 	}
 
 	soundcardEnabled := true
@@ -457,47 +512,80 @@ func (m *mockClient) createVM(
 		templateID,
 		VMStatusDown,
 		cpu,
-		m.createVMMemory(params),
+		m.createVMMemory(params, m.templates[templateID], it),
 		nil,
-		params.HugePages(),
+		hugePages,
 		init,
 		nil,
 		m.createPlacementPolicy(params),
-		m.createVMMemoryPolicy(params),
+		m.createVMMemoryPolicy(params, it),
 		params.InstanceTypeID(),
 		vmType,
 		m.createVMOS(params),
 		console,
 		soundcardEnabled,
+		false,
+		nil,
 	}
 	m.vms[VMID(id)] = vm
 	return vm
 }
 
-func (m *mockClient) createVMMemory(params OptionalVMParameters) int64 {
+// vmInstanceType returns the instance type referenced in the VM parameters, or nil if none is set. The caller must
+// hold the lock of the mock client.
+func (m *mockClient) vmInstanceType(params OptionalVMParameters) *instanceType {
+	if instanceTypeID := params.InstanceTypeID(); instanceTypeID != nil {
+		return m.instanceTypes[*instanceTypeID]
+	}
+	return nil
+}
+
+func (m *mockClient) createVMMemory(params OptionalVMParameters, tpl *template, it *instanceType) int64 {
 	memory := int64(1073741824)
-	if params.Memory() != nil {
+	switch {
+	case params.Memory() != nil:
 		memory = *params.Memory()
+	case it != nil:
+		memory = it.memory
+	case tpl.memory != 0:
+		memory = tpl.memory
 	}
 	return memory
 }
 
-func (m *mockClient) createVMMemoryPolicy(params OptionalVMParameters) *memoryPolicy {
+func (m *mockClient) createVMMemoryPolicy(params OptionalVMParameters, it *instanceType) *memoryPolicy {
 	memPolicy := &memoryPolicy{
 		ballooning: true,
 	}
-	if memoryPolicyParams := params.MemoryPolicy(); memoryPolicyParams != nil {
-		if guaranteedMemory := (*memoryPolicyParams).Guaranteed(); guaranteedMemory != nil {
-			memPolicy.guaranteed = guaranteedMemory
-		}
-
-		if maxMemory := (*memoryPolicyParams).Max(); maxMemory != nil {
-			memPolicy.max = maxMemory
+	if it != nil && params.Memory() == nil {
+		// The guaranteed and maximum memory of the instance type only make sense with its memory size.
+		memPolicy = &memoryPolicy{
+			guaranteed: it.memoryPolicy.guaranteed,
+			max:        it.memoryPolicy.max,
+			ballooning: it.memoryPolicy.ballooning,
 		}
+	}
+	if memoryPolicyParams := params.MemoryPolicy(); memoryPolicyParams != nil {
+		memPolicy = memPolicy.withParams(*memoryPolicyParams)
+	}
+	return memPolicy
+}
 
-		if memBallooning := (*memoryPolicyParams).Ballooning(); memBallooning != nil {
-			memPolicy.ballooning = *memBallooning
-		}
+// withParams returns a copy of the memory policy with the values set in the parameters applied.
+func (m *memoryPolicy) withParams(params MemoryPolicyParameters) *memoryPolicy {
+	memPolicy := &memoryPolicy{
+		guaranteed: m.guaranteed,
+		max:        m.max,
+		ballooning: m.ballooning,
+	}
+	if guaranteedMemory := params.Guaranteed(); guaranteedMemory != nil {
+		memPolicy.guaranteed = guaranteedMemory
+	}
+	if maxMemory := params.Max(); maxMemory != nil {
+		memPolicy.max = maxMemory
+	}
+	if memBallooning := params.Ballooning(); memBallooning != nil {
+		memPolicy.ballooning = *memBallooning
 	}
 	return memPolicy
 }
@@ -525,15 +613,18 @@ func (m *mockClient) createVMType(params OptionalVMParameters) VMType {
 func (m *mockClient) createPlacementPolicy(params OptionalVMParameters) *vmPlacementPolicy {
 	var pp *vmPlacementPolicy
 	if params.PlacementPolicy() != nil {
-		placementPolicyParams := *params.PlacementPolicy()
-		pp = &vmPlacementPolicy{
-			placementPolicyParams.Affinity(),
-			placementPolicyParams.HostIDs(),
-		}
+		pp = newVMPlacementPolicyFromParams(*params.PlacementPolicy())
 	}
 	return pp
 }
 
+func newVMPlacementPolicyFromParams(placementPolicyParams VMPlacementPolicyParameters) *vmPlacementPolicy {
+	return &vmPlacementPolicy{
+		placementPolicyParams.Affinity(),
+		placementPolicyParams.HostIDs(),
+	}
+}
+
 func (m *mockClient) attachVMDisksFromTemplate(tpl *template, vm *vm, params OptionalVMParameters) {
 	m.vmDiskAttachmentsByVM[vm.id] = make(
 		map[DiskAttachmentID]*diskAttachment,
@@ -567,8 +658,7 @@ func (m *mockClient) attachVMDisksFromTemplate(tpl *template, vm *vm, params Opt
 			bootable:      attachment.bootable,
 			active:        attachment.active,
 		}
-		m.vmDiskAttachmentsByVM[vm.id][diskAttachment.id] = diskAttachment
-		m.vmDiskAttachmentsByDisk[disk.id] = diskAttachment
+		m.addVMDiskAttachment(diskAttachment)
 	}
 }
 
@@ -607,19 +697,12 @@ func (m *mockClient) updateDiskParams(
 func (m *mockClient) createVMCPU(params OptionalVMParameters, tpl *template) *vmCPU {
 	var cpu *vmCPU
 	cpuParams := params.CPU()
+	it := m.vmInstanceType(params)
 	switch {
 	case cpuParams != nil:
-		cpu = &vmCPU{}
-		if topo := cpuParams.Topo(); topo != nil {
-			cpu.topo = &vmCPUTopo{
-				cores:   topo.Cores(),
-				sockets: topo.Sockets(),
-				threads: topo.Threads(),
-			}
-		}
-		if mode := cpuParams.Mode(); mode != nil {
-			cpu.mode = mode
-		}
+		cpu = newVMCPUFromParams(cpuParams)
+	case it != nil:
+		cpu = it.cpu.clone()
 	case tpl.cpu != nil:
 		cpu = tpl.cpu.clone()
 	default:
@@ -633,3 +716,18 @@ func (m *mockClient) createVMCPU(params OptionalVMParameters, tpl *template) *vm
 	}
 	return cpu
 }
+
+func newVMCPUFromParams(cpuParams VMCPUParams) *vmCPU {
+	cpu := &vmCPU{}
+	if topo := cpuParams.Topo(); topo != nil {
+		cpu.topo = &vmCPUTopo{
+			cores:   topo.Cores(),
+			sockets: topo.Sockets(),
+			threads: topo.Threads(),
+		}
+	}
+	if mode := cpuParams.Mode(); mode != nil {
+		cpu.mode = mode
+	}
+	return cpu
+}
@@ -1,35 +1,169 @@
 package ovirtclient
 
-import ovirtsdk "github.com/ovirt/go-ovirt"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
 
 // VMGraphicsConsoleID is the identifier for graphics consoles on a VM.
 type VMGraphicsConsoleID string
 
 // GraphicsConsoleClient lists the methods to access and manipulate graphics consoles on VMs.
 type GraphicsConsoleClient interface {
+	// ListVMGraphicsConsoles lists the graphics consoles of a VM. The address and ports of the consoles are only
+	// filled if the VM is running.
 	ListVMGraphicsConsoles(vmID VMID, retries ...RetryStrategy) ([]VMGraphicsConsole, error)
+	// AddVMGraphicsConsole adds a graphics console with the specified protocol to a VM. A VM can have at most one
+	// console per protocol.
+	AddVMGraphicsConsole(
+		vmID VMID,
+		protocol GraphicsConsoleProtocol,
+		retries ...RetryStrategy,
+	) (VMGraphicsConsole, error)
 	RemoveVMGraphicsConsole(vmID VMID, graphicsConsoleID VMGraphicsConsoleID, retries ...RetryStrategy) error
+	// TicketVMGraphicsConsole sets a one-time password on a graphics console of a running VM. The password is valid
+	// for the specified expiry time. If the expiry is 0, the engine default of 120 seconds is used.
+	TicketVMGraphicsConsole(
+		vmID VMID,
+		graphicsConsoleID VMGraphicsConsoleID,
+		expiry time.Duration,
+		retries ...RetryStrategy,
+	) (GraphicsConsoleTicket, error)
 }
 
 // VMGraphicsConsoleData contains the data for VMGraphicsConsole objects.
 type VMGraphicsConsoleData interface {
 	ID() VMGraphicsConsoleID
 	VMID() VMID
+	// Protocol returns the protocol used by the console.
+	Protocol() GraphicsConsoleProtocol
+	// Address returns the address to connect to. This is empty if the VM is not running.
+	Address() string
+	// Port returns the plain text port to connect to, or nil if the VM is not running or the console only listens
+	// on a TLS port.
+	Port() *uint
+	// TLSPort returns the TLS port to connect to, or nil if the VM is not running or the console does not support
+	// TLS.
+	TLSPort() *uint
 }
 
 // VMGraphicsConsole is an object representing a graphics console on a virtual machine.
 type VMGraphicsConsole interface {
 	VMGraphicsConsoleData
 
+	// Ticket sets a one-time password on the graphics console. See TicketVMGraphicsConsole for details.
+	Ticket(expiry time.Duration, retries ...RetryStrategy) (GraphicsConsoleTicket, error)
+	// RemoteViewerFile renders a remote-viewer connection file for this console. See RenderRemoteViewerFile for
+	// details.
+	RemoteViewerFile(ticket GraphicsConsoleTicket, params OptionalRemoteViewerFileParameters) ([]byte, error)
 	// Remove removes the graphics console.
 	Remove(retries ...RetryStrategy) error
 }
 
+// GraphicsConsoleProtocol is the protocol used by a graphics console.
+type GraphicsConsoleProtocol string
+
+const (
+	// GraphicsConsoleProtocolSPICE is the SPICE protocol.
+	GraphicsConsoleProtocolSPICE GraphicsConsoleProtocol = "spice"
+	// GraphicsConsoleProtocolVNC is the VNC protocol.
+	GraphicsConsoleProtocolVNC GraphicsConsoleProtocol = "vnc"
+)
+
+// GraphicsConsoleProtocolList is a list of GraphicsConsoleProtocol.
+type GraphicsConsoleProtocolList []GraphicsConsoleProtocol
+
+// GraphicsConsoleProtocolValues returns all possible GraphicsConsoleProtocol values.
+func GraphicsConsoleProtocolValues() GraphicsConsoleProtocolList {
+	return []GraphicsConsoleProtocol{
+		GraphicsConsoleProtocolSPICE,
+		GraphicsConsoleProtocolVNC,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l GraphicsConsoleProtocolList) Strings() []string {
+	result := make([]string, len(l))
+	for i, protocol := range l {
+		result[i] = string(protocol)
+	}
+	return result
+}
+
+// Validate returns an error if the graphics console protocol is not one of the supported values.
+func (p GraphicsConsoleProtocol) Validate() error {
+	for _, protocol := range GraphicsConsoleProtocolValues() {
+		if protocol == p {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid graphics console protocol: %s must be one of: %s",
+		p,
+		strings.Join(GraphicsConsoleProtocolValues().Strings(), ", "),
+	)
+}
+
+// GraphicsConsoleTicket is a one-time password for connecting to a graphics console.
+type GraphicsConsoleTicket interface {
+	// Value returns the password.
+	Value() string
+	// Expiry returns how long the password is valid after it has been set.
+	Expiry() time.Duration
+}
+
+type graphicsConsoleTicket struct {
+	value  string
+	expiry time.Duration
+}
+
+func (g *graphicsConsoleTicket) Value() string {
+	return g.value
+}
+
+func (g *graphicsConsoleTicket) Expiry() time.Duration {
+	return g.expiry
+}
+
+// defaultGraphicsConsoleTicketExpiry is the ticket validity the engine uses if no expiry is passed.
+const defaultGraphicsConsoleTicketExpiry = 120 * time.Second
+
+func validateGraphicsConsoleTicketExpiry(expiry time.Duration) error {
+	if expiry < 0 {
+		return newError(EBadArgument, "the ticket expiry must not be negative (%s given)", expiry)
+	}
+	if expiry%time.Second != 0 {
+		return newError(EBadArgument, "the ticket expiry must be a whole number of seconds (%s given)", expiry)
+	}
+	return nil
+}
+
 type vmGraphicsConsole struct {
 	client Client
 
-	id   VMGraphicsConsoleID
-	vmID VMID
+	id       VMGraphicsConsoleID
+	vmID     VMID
+	protocol GraphicsConsoleProtocol
+	address  string
+	port     *uint
+	tlsPort  *uint
+}
+
+func (v *vmGraphicsConsole) Ticket(expiry time.Duration, retries ...RetryStrategy) (GraphicsConsoleTicket, error) {
+	return v.client.TicketVMGraphicsConsole(v.vmID, v.id, expiry, retries...)
+}
+
+func (v *vmGraphicsConsole) RemoteViewerFile(
+	ticket GraphicsConsoleTicket,
+	params OptionalRemoteViewerFileParameters,
+) ([]byte, error) {
+	return RenderRemoteViewerFile(v, ticket, params)
 }
 
 func (v *vmGraphicsConsole) Remove(retries ...RetryStrategy) error {
@@ -44,6 +178,22 @@ func (v *vmGraphicsConsole) VMID() VMID {
 	return v.vmID
 }
 
+func (v *vmGraphicsConsole) Protocol() GraphicsConsoleProtocol {
+	return v.protocol
+}
+
+func (v *vmGraphicsConsole) Address() string {
+	return v.address
+}
+
+func (v *vmGraphicsConsole) Port() *uint {
+	return v.port
+}
+
+func (v *vmGraphicsConsole) TLSPort() *uint {
+	return v.tlsPort
+}
+
 func convertSDKGraphicsConsole(sdkObject *ovirtsdk.GraphicsConsole, client Client) (VMGraphicsConsole, error) {
 	id, ok := sdkObject.Id()
 	if !ok {
@@ -57,10 +207,105 @@ func convertSDKGraphicsConsole(sdkObject *ovirtsdk.GraphicsConsole, client Clien
 	if !ok {
 		return nil, newFieldNotFound("vm on graphics console", "id")
 	}
+	protocol, ok := sdkObject.Protocol()
+	if !ok {
+		return nil, newFieldNotFound("graphics console", "protocol")
+	}
+
+	result := &vmGraphicsConsole{
+		client:   client,
+		id:       VMGraphicsConsoleID(id),
+		vmID:     VMID(vmID),
+		protocol: GraphicsConsoleProtocol(protocol),
+	}
+	result.address, _ = sdkObject.Address()
+	if port, ok := sdkObject.Port(); ok {
+		p := uint(port)
+		result.port = &p
+	}
+	if tlsPort, ok := sdkObject.TlsPort(); ok {
+		p := uint(tlsPort)
+		result.tlsPort = &p
+	}
+	return result, nil
+}
+
+func convertSDKGraphicsConsoleTicket(sdkObject *ovirtsdk.Ticket) (GraphicsConsoleTicket, error) {
+	value, ok := sdkObject.Value()
+	if !ok {
+		return nil, newFieldNotFound("ticket", "value")
+	}
+	result := &graphicsConsoleTicket{
+		value:  value,
+		expiry: defaultGraphicsConsoleTicketExpiry,
+	}
+	if expiry, ok := sdkObject.Expiry(); ok {
+		result.expiry = time.Duration(expiry) * time.Second
+	}
+	return result, nil
+}
+
+// mockGraphicsConsole is the stored state of a graphics console in the mock client. The address and ports are only
+// reported while the VM is running.
+type mockGraphicsConsole struct {
+	vmGraphicsConsole
+
+	ticketCount uint
+}
+
+// graphicsConsoleWithConnectionDetails returns a copy of the console with the address and ports filled if the VM is
+// running on a host. The caller must hold the lock of the mock client.
+func (m *mockClient) graphicsConsoleWithConnectionDetails(console *mockGraphicsConsole) *vmGraphicsConsole {
+	result := &vmGraphicsConsole{
+		client:   m,
+		id:       console.id,
+		vmID:     console.vmID,
+		protocol: console.protocol,
+	}
+	vm := m.vms[console.vmID]
+	if vm == nil || vm.status == VMStatusDown || vm.hostID == nil {
+		return result
+	}
+	if host, ok := m.hosts[*vm.hostID]; ok {
+		result.address = host.address
+	}
+	result.port = console.port
+	result.tlsPort = console.tlsPort
+	return result
+}
+
+// addMockGraphicsConsole adds a graphics console to the VM and assigns it the next free display ports. The caller
+// must hold the lock of the mock client.
+func (m *mockClient) addMockGraphicsConsole(vmID VMID, protocol GraphicsConsoleProtocol) *mockGraphicsConsole {
+	nextPort := uint(5900)
+	for _, console := range m.graphicsConsolesByVM[vmID] {
+		for _, port := range []*uint{console.port, console.tlsPort} {
+			if port != nil && *port >= nextPort {
+				nextPort = *port + 1
+			}
+		}
+	}
+	console := &mockGraphicsConsole{
+		vmGraphicsConsole: vmGraphicsConsole{
+			client:   m,
+			id:       VMGraphicsConsoleID(m.GenerateUUID()),
+			vmID:     vmID,
+			protocol: protocol,
+			port:     &nextPort,
+		},
+	}
+	if protocol == GraphicsConsoleProtocolSPICE {
+		tlsPort := nextPort + 1
+		console.tlsPort = &tlsPort
+	}
+	m.graphicsConsolesByVM[vmID] = append(m.graphicsConsolesByVM[vmID], console)
+	return console
+}
 
-	return &vmGraphicsConsole{
-		client,
-		VMGraphicsConsoleID(id),
-		VMID(vmID),
-	}, nil
+// nextMockGraphicsConsoleTicket generates a deterministic ticket value from the VM ID, the console ID and the number
+// of tickets already issued for the console.
+func nextMockGraphicsConsoleTicket(console *mockGraphicsConsole) string {
+	console.ticketCount++
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%d", console.vmID, console.id, console.ticketCount)))
+	return hex.EncodeToString(hash[:])[:12]
 }
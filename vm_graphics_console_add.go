@@ -0,0 +1,87 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) AddVMGraphicsConsole(
+	vmID VMID,
+	protocol GraphicsConsoleProtocol,
+	retries ...RetryStrategy,
+) (result VMGraphicsConsole, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if err := protocol.Validate(); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("adding %s graphics console to VM %s", protocol, vmID),
+		o.logger,
+		retries,
+		func() error {
+			console, err := ovirtsdk.NewGraphicsConsoleBuilder().Protocol(ovirtsdk.GraphicsType(protocol)).Build()
+			if err != nil {
+				return wrap(err, EBug, "failed to build graphics console")
+			}
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				GraphicsConsolesService().
+				Add().
+				Console(console).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Console()
+			if !ok {
+				return newFieldNotFound("graphics console add response", "console")
+			}
+			result, err = convertSDKGraphicsConsole(sdkObject, o)
+			return err
+		},
+	)
+	return result, err
+}
+
+func (m *mockClient) AddVMGraphicsConsole(
+	vmID VMID,
+	protocol GraphicsConsoleProtocol,
+	retries ...RetryStrategy,
+) (result VMGraphicsConsole, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if err := protocol.Validate(); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("adding %s graphics console to VM %s", protocol, vmID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			graphicsConsoles, ok := m.graphicsConsolesByVM[vmID]
+			if !ok {
+				return newError(ENotFound, "VM with ID %s not found", vmID)
+			}
+			for _, graphicsConsole := range graphicsConsoles {
+				if graphicsConsole.protocol == protocol {
+					return newError(
+						EConflict,
+						"VM %s already has a %s graphics console (%s)",
+						vmID,
+						protocol,
+						graphicsConsole.id,
+					)
+				}
+			}
+
+			result = m.graphicsConsoleWithConnectionDetails(m.addMockGraphicsConsole(vmID, protocol))
+			return nil
+		})
+	return
+}
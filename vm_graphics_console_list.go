@@ -9,7 +9,14 @@ func (o *oVirtClient) ListVMGraphicsConsoles(vmID VMID, retries ...RetryStrategy
 		o.logger,
 		retries,
 		func() error {
-			resp, err := o.conn.SystemService().VmsService().VmService(string(vmID)).GraphicsConsolesService().List().Send()
+			resp, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				GraphicsConsolesService().
+				List().
+				Current(true).
+				Send()
 			if err != nil {
 				return err
 			}
@@ -39,7 +46,7 @@ func (m *mockClient) ListVMGraphicsConsoles(vmID VMID, retries ...RetryStrategy)
 	}
 	result := make([]VMGraphicsConsole, len(graphicsConsoles))
 	for i, graphicsConsole := range graphicsConsoles {
-		result[i] = graphicsConsole
+		result[i] = m.graphicsConsoleWithConnectionDetails(graphicsConsole)
 	}
 	return result, nil
 }
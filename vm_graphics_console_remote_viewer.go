@@ -0,0 +1,130 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OptionalRemoteViewerFileParameters contains the optional parameters for rendering a remote-viewer connection file.
+type OptionalRemoteViewerFileParameters interface {
+	// Title returns the window title remote-viewer should display.
+	Title() *string
+	// CACertificate returns the PEM-encoded CA certificate remote-viewer should use to verify the TLS connection.
+	// This is typically the engine CA certificate.
+	CACertificate() *string
+}
+
+// BuildableRemoteViewerFileParameters is a buildable version of OptionalRemoteViewerFileParameters.
+type BuildableRemoteViewerFileParameters interface {
+	OptionalRemoteViewerFileParameters
+
+	// WithTitle sets the window title remote-viewer should display.
+	WithTitle(title string) (BuildableRemoteViewerFileParameters, error)
+	// MustWithTitle is identical to WithTitle, but panics instead of returning an error.
+	MustWithTitle(title string) BuildableRemoteViewerFileParameters
+
+	// WithCACertificate sets the PEM-encoded CA certificate to verify the TLS connection with.
+	WithCACertificate(caCertificate string) (BuildableRemoteViewerFileParameters, error)
+	// MustWithCACertificate is identical to WithCACertificate, but panics instead of returning an error.
+	MustWithCACertificate(caCertificate string) BuildableRemoteViewerFileParameters
+}
+
+// RemoteViewerFileParams creates a buildable set of parameters for RenderRemoteViewerFile.
+func RemoteViewerFileParams() BuildableRemoteViewerFileParameters {
+	return &remoteViewerFileParams{}
+}
+
+type remoteViewerFileParams struct {
+	title         *string
+	caCertificate *string
+}
+
+func (r *remoteViewerFileParams) Title() *string {
+	return r.title
+}
+
+func (r *remoteViewerFileParams) CACertificate() *string {
+	return r.caCertificate
+}
+
+func (r *remoteViewerFileParams) WithTitle(title string) (BuildableRemoteViewerFileParameters, error) {
+	if strings.ContainsAny(title, "\r\n") {
+		return nil, newError(EBadArgument, "the remote-viewer title must not contain line breaks")
+	}
+	r.title = &title
+	return r, nil
+}
+
+func (r *remoteViewerFileParams) MustWithTitle(title string) BuildableRemoteViewerFileParameters {
+	builder, err := r.WithTitle(title)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (r *remoteViewerFileParams) WithCACertificate(caCertificate string) (BuildableRemoteViewerFileParameters, error) {
+	if !strings.Contains(caCertificate, "-----BEGIN CERTIFICATE-----") {
+		return nil, newError(EBadArgument, "the CA certificate must be PEM-encoded")
+	}
+	r.caCertificate = &caCertificate
+	return r, nil
+}
+
+func (r *remoteViewerFileParams) MustWithCACertificate(caCertificate string) BuildableRemoteViewerFileParameters {
+	builder, err := r.WithCACertificate(caCertificate)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// RenderRemoteViewerFile renders a .vv connection file for remote-viewer from a graphics console and a ticket
+// obtained via TicketVMGraphicsConsole. The console must have been listed while the VM was running, otherwise it has
+// no address to connect to. The params parameter may be nil.
+//
+// The file instructs remote-viewer to delete it after reading, since it contains the one-time password.
+func RenderRemoteViewerFile(
+	console VMGraphicsConsoleData,
+	ticket GraphicsConsoleTicket,
+	params OptionalRemoteViewerFileParameters,
+) ([]byte, error) {
+	if ticket == nil {
+		return nil, newError(EBadArgument, "a ticket is required to render a remote-viewer file")
+	}
+	if err := console.Protocol().Validate(); err != nil {
+		return nil, err
+	}
+	if console.Address() == "" || (console.Port() == nil && console.TLSPort() == nil) {
+		return nil, newError(
+			EBadArgument,
+			"graphics console %s of VM %s has no address or port, make sure the VM is running",
+			console.ID(),
+			console.VMID(),
+		)
+	}
+	if params == nil {
+		params = &remoteViewerFileParams{}
+	}
+
+	lines := []string{
+		"[virt-viewer]",
+		fmt.Sprintf("type=%s", console.Protocol()),
+		fmt.Sprintf("host=%s", console.Address()),
+	}
+	if port := console.Port(); port != nil {
+		lines = append(lines, fmt.Sprintf("port=%d", *port))
+	}
+	if tlsPort := console.TLSPort(); tlsPort != nil {
+		lines = append(lines, fmt.Sprintf("tls-port=%d", *tlsPort))
+	}
+	lines = append(lines, fmt.Sprintf("password=%s", ticket.Value()), "delete-this-file=1")
+	if title := params.Title(); title != nil {
+		lines = append(lines, fmt.Sprintf("title=%s", *title))
+	}
+	if ca := params.CACertificate(); ca != nil && console.TLSPort() != nil {
+		// The certificate must be on a single line, with the line breaks escaped.
+		lines = append(lines, fmt.Sprintf("ca=%s", strings.ReplaceAll(strings.TrimSpace(*ca), "\n", "\\n")))
+	}
+	return []byte(strings.Join(lines, "\n") + "\n"), nil
+}
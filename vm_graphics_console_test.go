@@ -1,7 +1,10 @@
 package ovirtclient_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
 )
@@ -34,3 +37,104 @@ func TestListAndRemoveGraphicsConsoles(t *testing.T) {
 		t.Fatalf("Still found graphics consoles after removing them.")
 	}
 }
+
+func TestAddGraphicsConsole(t *testing.T) {
+	helper := getHelper(t)
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithVMType(ovirtclient.VMTypeDesktop),
+	)
+	graphicsConsoles, err := vm.ListGraphicsConsoles()
+	if err != nil {
+		t.Fatalf("Failed to list graphics consoles on VM %s (%v)", vm.ID(), err)
+	}
+	for _, graphicsConsole := range graphicsConsoles {
+		if graphicsConsole.Protocol() != ovirtclient.GraphicsConsoleProtocolVNC {
+			continue
+		}
+		if err := graphicsConsole.Remove(); err != nil {
+			t.Fatalf("Failed to remove graphics console %s from VM %s (%v)", graphicsConsole.ID(), vm.ID(), err)
+		}
+	}
+
+	graphicsConsole, err := vm.AddGraphicsConsole(ovirtclient.GraphicsConsoleProtocolVNC)
+	if err != nil {
+		t.Fatalf("Failed to add VNC graphics console to VM %s (%v)", vm.ID(), err)
+	}
+	if graphicsConsole.Protocol() != ovirtclient.GraphicsConsoleProtocolVNC {
+		t.Fatalf("Incorrect protocol on the added graphics console (%s).", graphicsConsole.Protocol())
+	}
+	if _, err := vm.AddGraphicsConsole(ovirtclient.GraphicsConsoleProtocolVNC, ovirtclient.MaxTries(1)); err == nil {
+		t.Fatalf("Adding a second VNC graphics console did not result in an error.")
+	}
+}
+
+func TestGraphicsConsoleTicketAndRemoteViewerFile(t *testing.T) {
+	helper := getHelper(t)
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().MustWithVMType(ovirtclient.VMTypeDesktop),
+	)
+	spiceConsole := findGraphicsConsole(t, vm, ovirtclient.GraphicsConsoleProtocolSPICE)
+	_, err := spiceConsole.Ticket(time.Minute, ovirtclient.MaxTries(1))
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Setting a ticket on a stopped VM did not result in an EConflict error (%v)", err)
+	}
+
+	assertCanStartVM(t, helper, vm)
+	assertVMWillStart(t, vm)
+
+	spiceConsole = findGraphicsConsole(t, vm, ovirtclient.GraphicsConsoleProtocolSPICE)
+	if spiceConsole.Address() == "" || spiceConsole.TLSPort() == nil {
+		t.Fatalf("The SPICE console of a running VM has no address or TLS port.")
+	}
+	ticket, err := spiceConsole.Ticket(time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to set ticket on graphics console %s (%v)", spiceConsole.ID(), err)
+	}
+	if ticket.Value() == "" {
+		t.Fatalf("The graphics console ticket is empty.")
+	}
+	if ticket.Expiry() != time.Minute {
+		t.Fatalf("Incorrect ticket expiry (%s instead of %s).", ticket.Expiry(), time.Minute)
+	}
+
+	file, err := spiceConsole.RemoteViewerFile(ticket, ovirtclient.RemoteViewerFileParams().MustWithTitle(vm.Name()))
+	if err != nil {
+		t.Fatalf("Failed to render remote-viewer file (%v)", err)
+	}
+	for _, expectedLine := range []string{
+		"[virt-viewer]",
+		"type=spice",
+		fmt.Sprintf("host=%s", spiceConsole.Address()),
+		fmt.Sprintf("tls-port=%d", *spiceConsole.TLSPort()),
+		fmt.Sprintf("password=%s", ticket.Value()),
+		fmt.Sprintf("title=%s", vm.Name()),
+	} {
+		if !strings.Contains(string(file), expectedLine+"\n") {
+			t.Fatalf("The remote-viewer file does not contain the line %s:\n%s", expectedLine, file)
+		}
+	}
+}
+
+func findGraphicsConsole(
+	t *testing.T,
+	vm ovirtclient.VM,
+	protocol ovirtclient.GraphicsConsoleProtocol,
+) ovirtclient.VMGraphicsConsole {
+	graphicsConsoles, err := vm.ListGraphicsConsoles()
+	if err != nil {
+		t.Fatalf("Failed to list graphics consoles on VM %s (%v)", vm.ID(), err)
+	}
+	for _, graphicsConsole := range graphicsConsoles {
+		if graphicsConsole.Protocol() == protocol {
+			return graphicsConsole
+		}
+	}
+	t.Skipf("VM %s has no %s graphics console.", vm.ID(), protocol)
+	return nil
+}
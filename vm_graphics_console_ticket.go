@@ -0,0 +1,103 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) TicketVMGraphicsConsole(
+	vmID VMID,
+	graphicsConsoleID VMGraphicsConsoleID,
+	expiry time.Duration,
+	retries ...RetryStrategy,
+) (result GraphicsConsoleTicket, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if err := validateGraphicsConsoleTicketExpiry(expiry); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("setting ticket on graphics console %s of VM %s", graphicsConsoleID, vmID),
+		o.logger,
+		retries,
+		func() error {
+			ticketBuilder := ovirtsdk.NewTicketBuilder()
+			if expiry != 0 {
+				ticketBuilder.Expiry(int64(expiry / time.Second))
+			}
+			ticket, err := ticketBuilder.Build()
+			if err != nil {
+				return wrap(err, EBug, "failed to build ticket")
+			}
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				GraphicsConsolesService().
+				ConsoleService(string(graphicsConsoleID)).
+				Ticket().
+				Ticket(ticket).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Ticket()
+			if !ok {
+				return newFieldNotFound("graphics console ticket response", "ticket")
+			}
+			result, err = convertSDKGraphicsConsoleTicket(sdkObject)
+			return err
+		},
+	)
+	return result, err
+}
+
+func (m *mockClient) TicketVMGraphicsConsole(
+	vmID VMID,
+	graphicsConsoleID VMGraphicsConsoleID,
+	expiry time.Duration,
+	retries ...RetryStrategy,
+) (result GraphicsConsoleTicket, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if err := validateGraphicsConsoleTicketExpiry(expiry); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("setting ticket on graphics console %s of VM %s", graphicsConsoleID, vmID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			graphicsConsoles, ok := m.graphicsConsolesByVM[vmID]
+			if !ok {
+				return newError(ENotFound, "VM with ID %s not found", vmID)
+			}
+			var console *mockGraphicsConsole
+			for _, graphicsConsole := range graphicsConsoles {
+				if graphicsConsole.id == graphicsConsoleID {
+					console = graphicsConsole
+					break
+				}
+			}
+			if console == nil {
+				return newError(ENotFound, "Graphics console with ID %s not found on VM %s", graphicsConsoleID, vmID)
+			}
+			if vm := m.vms[vmID]; vm.status == VMStatusDown {
+				return newError(EConflict, "cannot set a graphics console ticket, VM %s is not running", vmID)
+			}
+
+			if expiry == 0 {
+				expiry = defaultGraphicsConsoleTicketExpiry
+			}
+			result = &graphicsConsoleTicket{
+				value:  nextMockGraphicsConsoleTicket(console),
+				expiry: expiry,
+			}
+			return nil
+		})
+	return
+}
@@ -19,10 +19,27 @@ func TestVMIPAddressReporting(t *testing.T) {
 		ovirtclient.CreateVMParams().MustWithMemory(512*1024*1024),
 	)
 	assertCanAttachDisk(t, vm, disk)
-	assertCanCreateNIC(t, helper, vm, fmt.Sprintf("%s-%s", t.Name(), "eth0"), nil)
+	nic := assertCanCreateNIC(t, helper, vm, fmt.Sprintf("%s-%s", t.Name(), "eth0"), nil)
 	assertCanStartVM(t, helper, vm)
 	assertVMWillStart(t, vm)
 	assertVMGetsIPAddress(t, vm)
+	assertNICHasReportedIPAddress(t, vm, nic.ID())
+}
+
+func assertNICHasReportedIPAddress(t *testing.T, vm ovirtclient.VM, id ovirtclient.NICID) {
+	nic, err := vm.GetNIC(id)
+	if err != nil {
+		t.Fatalf("failed to fetch NIC %s (%v)", id, err)
+	}
+	for _, device := range nic.ReportedDevices() {
+		for _, ip := range device.IPs() {
+			if ip.IsGlobalUnicast() {
+				t.Logf("Found IP address %s on device %s of NIC %s", ip.String(), device.Name(), id)
+				return
+			}
+		}
+	}
+	t.Fatalf("NIC %s has no reported device with a valid IP address", id)
 }
 
 func assertVMGetsIPAddress(t *testing.T, vm ovirtclient.VM) {
@@ -0,0 +1,286 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// MigrateVMParameters contains the optional parameters for migrating a VM.
+type MigrateVMParameters interface {
+	// HostID returns the host the VM should be migrated to. If nil, the engine selects a suitable host.
+	HostID() *HostID
+	// ClusterID returns the cluster the VM should be migrated to. If nil, the VM stays in its current cluster.
+	ClusterID() *ClusterID
+	// Force indicates that the VM should be migrated even if it is marked as non-migratable.
+	Force() bool
+}
+
+// BuildableMigrateVMParameters is a buildable version of MigrateVMParameters.
+type BuildableMigrateVMParameters interface {
+	MigrateVMParameters
+
+	// WithHostID sets the host the VM should be migrated to.
+	WithHostID(hostID HostID) (BuildableMigrateVMParameters, error)
+	// MustWithHostID is identical to WithHostID, but panics instead of returning an error.
+	MustWithHostID(hostID HostID) BuildableMigrateVMParameters
+
+	// WithClusterID sets the cluster the VM should be migrated to.
+	WithClusterID(clusterID ClusterID) (BuildableMigrateVMParameters, error)
+	// MustWithClusterID is identical to WithClusterID, but panics instead of returning an error.
+	MustWithClusterID(clusterID ClusterID) BuildableMigrateVMParameters
+
+	// WithForce sets the flag to migrate the VM even if it is marked as non-migratable.
+	WithForce(force bool) (BuildableMigrateVMParameters, error)
+	// MustWithForce is identical to WithForce, but panics instead of returning an error.
+	MustWithForce(force bool) BuildableMigrateVMParameters
+}
+
+// MigrateVMParams returns a buildable set of parameters for VM migration.
+func MigrateVMParams() BuildableMigrateVMParameters {
+	return &migrateVMParams{}
+}
+
+type migrateVMParams struct {
+	hostID    *HostID
+	clusterID *ClusterID
+	force     bool
+}
+
+func (m *migrateVMParams) HostID() *HostID {
+	return m.hostID
+}
+
+func (m *migrateVMParams) ClusterID() *ClusterID {
+	return m.clusterID
+}
+
+func (m *migrateVMParams) Force() bool {
+	return m.force
+}
+
+func (m *migrateVMParams) WithHostID(hostID HostID) (BuildableMigrateVMParameters, error) {
+	if hostID == "" {
+		return nil, newError(EBadArgument, "host ID cannot be empty for VM migration")
+	}
+	m.hostID = &hostID
+	return m, nil
+}
+
+func (m *migrateVMParams) MustWithHostID(hostID HostID) BuildableMigrateVMParameters {
+	builder, err := m.WithHostID(hostID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (m *migrateVMParams) WithClusterID(clusterID ClusterID) (BuildableMigrateVMParameters, error) {
+	if clusterID == "" {
+		return nil, newError(EBadArgument, "cluster ID cannot be empty for VM migration")
+	}
+	m.clusterID = &clusterID
+	return m, nil
+}
+
+func (m *migrateVMParams) MustWithClusterID(clusterID ClusterID) BuildableMigrateVMParameters {
+	builder, err := m.WithClusterID(clusterID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (m *migrateVMParams) WithForce(force bool) (BuildableMigrateVMParameters, error) {
+	m.force = force
+	return m, nil
+}
+
+func (m *migrateVMParams) MustWithForce(force bool) BuildableMigrateVMParameters {
+	builder, err := m.WithForce(force)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (o *oVirtClient) MigrateVM(id VMID, params MigrateVMParameters, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if params == nil {
+		params = MigrateVMParams()
+	}
+	vm, err := o.GetVM(id, retries...)
+	if err != nil {
+		return err
+	}
+	if vm.Status() == VMStatusDown {
+		return o.coldMigrateVM(id, params, retries)
+	}
+	err = retry(
+		fmt.Sprintf("migrating VM %s", id),
+		o.logger,
+		retries,
+		func() error {
+			request := o.conn.SystemService().VmsService().VmService(string(id)).Migrate().Force(params.Force())
+			if hostID := params.HostID(); hostID != nil {
+				request.Host(ovirtsdk.NewHostBuilder().Id(string(*hostID)).MustBuild())
+			}
+			if clusterID := params.ClusterID(); clusterID != nil {
+				request.Cluster(ovirtsdk.NewClusterBuilder().Id(string(*clusterID)).MustBuild())
+			}
+			_, err := request.Send()
+			return err
+		})
+	return err
+}
+
+// coldMigrateVM moves a VM that is not running to a different cluster. The engine does not support the migrate action
+// on VMs that are down, so the cluster is changed via an update instead.
+func (o *oVirtClient) coldMigrateVM(id VMID, params MigrateVMParameters, retries []RetryStrategy) error {
+	clusterID, err := o.coldMigrationTargetCluster(params, retries)
+	if err != nil {
+		return err
+	}
+	return retry(
+		fmt.Sprintf("moving VM %s to cluster %s", id, clusterID),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().VmsService().VmService(string(id)).Update().Vm(
+				ovirtsdk.NewVmBuilder().Cluster(
+					ovirtsdk.NewClusterBuilder().Id(string(clusterID)).MustBuild(),
+				).MustBuild(),
+			).Send()
+			return err
+		})
+}
+
+func (o *oVirtClient) coldMigrationTargetCluster(params MigrateVMParameters, retries []RetryStrategy) (ClusterID, error) {
+	if clusterID := params.ClusterID(); clusterID != nil {
+		return *clusterID, nil
+	}
+	if hostID := params.HostID(); hostID != nil {
+		host, err := o.GetHost(*hostID, retries...)
+		if err != nil {
+			return "", err
+		}
+		return host.ClusterID(), nil
+	}
+	return "", newError(EBadArgument, "migrating a VM that is down requires a target cluster or host")
+}
+
+func (m *mockClient) MigrateVM(id VMID, params MigrateVMParameters, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if params == nil {
+		params = MigrateVMParams()
+	}
+	return retry(
+		fmt.Sprintf("migrating VM %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			item, ok := m.vms[id]
+			if !ok {
+				return newError(ENotFound, "VM with ID %s not found", id)
+			}
+			clusterID := item.clusterID
+			if params.ClusterID() != nil {
+				clusterID = *params.ClusterID()
+				if _, ok := m.clusters[clusterID]; !ok {
+					return newError(ENotFound, "cluster with ID %s not found", clusterID)
+				}
+			}
+
+			switch item.status {
+			case VMStatusDown:
+				return m.coldMigrateVM(id, clusterID, params)
+			case VMStatusUp:
+			default:
+				return newError(EConflict, "VM %s cannot be migrated in status %s", id, item.status)
+			}
+
+			if item.placementPolicy != nil && item.placementPolicy.affinity != nil &&
+				*item.placementPolicy.affinity == VMAffinityPinned && !params.Force() {
+				return newError(EConflict, "VM %s is pinned to its host and cannot be migrated", id)
+			}
+			targetHostID, err := m.findMigrationTarget(item, clusterID, params.HostID())
+			if err != nil {
+				return err
+			}
+
+			item = item.clone()
+			item.status = VMStatusMigrating
+			m.vms[id] = item
+			go func() {
+				time.Sleep(2 * time.Second)
+				m.lock.Lock()
+				defer m.lock.Unlock()
+				item, ok := m.vms[id]
+				if !ok || item.status != VMStatusMigrating {
+					return
+				}
+				item = item.clone()
+				item.hostID = &targetHostID
+				item.clusterID = clusterID
+				item.status = VMStatusUp
+				m.vms[id] = item
+				m.updateHostVMCounts()
+			}()
+			return nil
+		})
+}
+
+func (m *mockClient) coldMigrateVM(id VMID, clusterID ClusterID, params MigrateVMParameters) error {
+	if hostID := params.HostID(); hostID != nil {
+		host, ok := m.hosts[*hostID]
+		if !ok {
+			return newError(ENotFound, "host with ID %s not found", *hostID)
+		}
+		if params.ClusterID() != nil && host.clusterID != clusterID {
+			return newError(EBadArgument, "host %s is not in cluster %s", *hostID, clusterID)
+		}
+		clusterID = host.clusterID
+	} else if params.ClusterID() == nil {
+		return newError(EBadArgument, "migrating a VM that is down requires a target cluster or host")
+	}
+	item := m.vms[id].clone()
+	item.clusterID = clusterID
+	m.vms[id] = item
+	return nil
+}
+
+// findMigrationTarget returns the host a running VM should be migrated to. If no host is requested, the first
+// available host in the target cluster is selected.
+func (m *mockClient) findMigrationTarget(item *vm, clusterID ClusterID, hostID *HostID) (HostID, error) {
+	if hostID != nil {
+		host, ok := m.hosts[*hostID]
+		if !ok {
+			return "", newError(ENotFound, "host with ID %s not found", *hostID)
+		}
+		if host.clusterID != clusterID {
+			return "", newError(EBadArgument, "host %s is not in cluster %s", *hostID, clusterID)
+		}
+		if host.status != HostStatusUp {
+			return "", newError(EConflict, "host %s is in status %s and cannot receive VMs", *hostID, host.status)
+		}
+		if item.hostID != nil && *item.hostID == *hostID {
+			return "", newError(EConflict, "VM %s is already running on host %s", item.id, *hostID)
+		}
+		return *hostID, nil
+	}
+	for _, host := range m.hosts {
+		if host.clusterID != clusterID || host.status != HostStatusUp {
+			continue
+		}
+		if item.hostID != nil && *item.hostID == host.id {
+			continue
+		}
+		return host.id, nil
+	}
+	return "", newError(EConflict, "no suitable host found to migrate VM %s to", item.id)
+}
@@ -0,0 +1,73 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMLiveMigration(t *testing.T) {
+	helper := getHelper(t)
+
+	vm := assertCanCreateBootableVM(t, helper)
+	assertCanStartVM(t, helper, vm)
+	vm = assertVMWillStart(t, vm)
+	sourceHostID := *vm.HostID()
+
+	if err := vm.Migrate(nil); err != nil {
+		if ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+			t.Skipf("Cannot migrate VM, we assume there are not enough hosts available. (%v)", err)
+		}
+		t.Fatalf("Failed to migrate VM %s (%v)", vm.ID(), err)
+	}
+	vm, err := vm.WaitForMigration()
+	if err != nil {
+		t.Fatalf("Failed to wait for VM %s to migrate (%v)", vm.ID(), err)
+	}
+	if vm.Status() != ovirtclient.VMStatusUp {
+		t.Fatalf("VM %s is in status %s after migration.", vm.ID(), vm.Status())
+	}
+	if *vm.HostID() == sourceHostID {
+		t.Fatalf("VM %s is still on host %s after migration.", vm.ID(), sourceHostID)
+	}
+}
+
+func TestPinnedVMCannotBeMigrated(t *testing.T) {
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().WithPlacementPolicy(
+			ovirtclient.NewVMPlacementPolicyParameters().MustWithAffinity(ovirtclient.VMAffinityPinned),
+		),
+	)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanUploadDiskImage(t, helper, disk)
+	assertCanAttachDiskWithParams(
+		t,
+		vm,
+		disk,
+		ovirtclient.CreateDiskAttachmentParams().MustWithBootable(true).MustWithActive(true),
+	)
+	assertCanStartVM(t, helper, vm)
+	vm = assertVMWillStart(t, vm)
+
+	if err := vm.Migrate(nil, ovirtclient.MaxTries(1)); err == nil {
+		t.Fatalf("Migrating a pinned VM did not result in an error.")
+	}
+}
+
+func TestColdMigrationRequiresTarget(t *testing.T) {
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	err := vm.Migrate(ovirtclient.MigrateVMParams())
+	if err == nil {
+		t.Fatalf("Migrating a VM that is down without a target did not result in an error.")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Migrating a VM that is down without a target did not return an EBadArgument error (%v)", err)
+	}
+}
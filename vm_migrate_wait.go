@@ -0,0 +1,47 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) WaitForVMMigration(id VMID, retries ...RetryStrategy) (vm VM, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	err = retry(
+		fmt.Sprintf("waiting for VM %s migration to finish", id),
+		o.logger,
+		retries,
+		func() error {
+			vm, err = o.GetVM(id, retries...)
+			if err != nil {
+				return err
+			}
+			return checkVMMigrationFinished(vm)
+		})
+	return
+}
+
+func (m *mockClient) WaitForVMMigration(id VMID, retries ...RetryStrategy) (vm VM, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(m))
+	err = retry(
+		fmt.Sprintf("waiting for VM %s migration to finish", id),
+		m.logger,
+		retries,
+		func() error {
+			vm, err = m.GetVM(id, retries...)
+			if err != nil {
+				return err
+			}
+			return checkVMMigrationFinished(vm)
+		})
+	return
+}
+
+func checkVMMigrationFinished(vm VM) error {
+	if vm.Status() == VMStatusMigrating {
+		return newError(EPending, "VM %s is still migrating", vm.ID())
+	}
+	if vm.Status() == VMStatusUp && vm.HostID() == nil {
+		return newError(EPending, "VM %s does not report a host yet", vm.ID())
+	}
+	return nil
+}
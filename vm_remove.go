@@ -32,7 +32,8 @@ func (m *mockClient) RemoveVM(id VMID, retries ...RetryStrategy) error {
 			m.lock.Lock()
 			defer m.lock.Unlock()
 
-			if _, ok := m.vms[id]; !ok {
+			item, ok := m.vms[id]
+			if !ok {
 				return newError(ENotFound, "VM with ID %s not found", id)
 			}
 
@@ -40,8 +41,11 @@ func (m *mockClient) RemoveVM(id VMID, retries ...RetryStrategy) error {
 				if m.disks[diskAttachment.DiskID()].status == DiskStatusLocked {
 					return newError(EConflict, "Cannot delete VM, disk %s is locked.", diskAttachment.DiskID())
 				}
-				delete(m.disks, diskAttachment.DiskID())
-				delete(m.vmDiskAttachmentsByDisk, diskAttachment.DiskID())
+				m.removeVMDiskAttachment(diskAttachment)
+				// Shareable disks that are still attached to other VMs are only detached.
+				if _, ok := m.vmDiskAttachmentsByDisk[diskAttachment.DiskID()]; !ok {
+					delete(m.disks, diskAttachment.DiskID())
+				}
 			}
 			for nicID, nic := range m.nics {
 				if nic.VMID() == id {
@@ -51,7 +55,12 @@ func (m *mockClient) RemoveVM(id VMID, retries ...RetryStrategy) error {
 			delete(m.vmIPs, id)
 			delete(m.vmDiskAttachmentsByVM, id)
 			delete(m.graphicsConsolesByVM, id)
+			delete(m.snapshotsByVM, id)
+			delete(m.backupsByVM, id)
+			delete(m.checkpointsByVM, id)
 			delete(m.vms, id)
+			m.updateHostVMCounts()
+			m.addVMEvent(item, mockEventCodeVMRemoved, "VM %s was removed.", item.name)
 
 			return nil
 		})
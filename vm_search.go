@@ -123,6 +123,9 @@ func (m *mockClient) SearchVMs(params VMSearchParameters, _ ...RetryStrategy) ([
 		if name := params.Name(); name != nil && vm.name != *name {
 			continue
 		}
+		if tag := params.Tag(); tag != nil && !m.hasMockTagMatching(vm.tagIDs, *tag) {
+			continue
+		}
 		if statuses := params.Statuses(); statuses != nil {
 			foundStatus := false
 			for _, status := range *statuses {
@@ -31,7 +31,15 @@ func (m *mockClient) ShutdownVM(id VMID, force bool, _ ...RetryStrategy) error {
 				time.Sleep(2 * time.Second)
 				m.lock.Lock()
 				defer m.lock.Unlock()
+				item, ok := m.vms[id]
+				if !ok {
+					return
+				}
 				item.status = VMStatusDown
+				m.clearNICReportedDevices(id)
+				m.addVMEvent(item, mockEventCodeVMDown, "VM %s is down.", item.name)
+				m.applyVMNextRun(id)
+				m.updateHostVMCounts()
 			}()
 		}
 		return nil
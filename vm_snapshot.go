@@ -0,0 +1,351 @@
+package ovirtclient
+
+import (
+	"strings"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// SnapshotID is the identifier for VM snapshots.
+type SnapshotID string
+
+// SnapshotClient contains the methods required for handling VM snapshots.
+type SnapshotClient interface {
+	// CreateVMSnapshot creates a snapshot of the specified VM. The snapshot is created in the background and starts
+	// out in the SnapshotStatusLocked state. Use WaitForSnapshotStatus to wait for it to become SnapshotStatusOK.
+	// Optional parameters can be created using CreateSnapshotParams().
+	CreateVMSnapshot(
+		vmID VMID,
+		description string,
+		params CreateSnapshotOptionalParams,
+		retries ...RetryStrategy,
+	) (Snapshot, error)
+	// ListVMSnapshots lists all snapshots of a VM, including the snapshot representing the active VM.
+	ListVMSnapshots(vmID VMID, retries ...RetryStrategy) ([]Snapshot, error)
+	// GetVMSnapshot returns a single snapshot of a VM.
+	GetVMSnapshot(vmID VMID, snapshotID SnapshotID, retries ...RetryStrategy) (Snapshot, error)
+	// RemoveVMSnapshot removes the specified snapshot. The removal takes place in the background, the snapshot will
+	// be in the SnapshotStatusLocked state until the removal is complete.
+	RemoveVMSnapshot(vmID VMID, snapshotID SnapshotID, retries ...RetryStrategy) error
+	// PreviewVMSnapshot boots the VM into a temporary preview of the specified snapshot. The VM must be down. The
+	// preview must be ended with either CommitVMSnapshot or UndoVMSnapshot. If restoreMemory is true, and the snapshot
+	// contains the memory state, the memory state will be restored when the VM is started.
+	PreviewVMSnapshot(vmID VMID, snapshotID SnapshotID, restoreMemory bool, retries ...RetryStrategy) error
+	// CommitVMSnapshot permanently restores the VM to the snapshot currently in preview. Snapshots newer than the
+	// previewed snapshot are removed.
+	CommitVMSnapshot(vmID VMID, retries ...RetryStrategy) error
+	// UndoVMSnapshot ends the snapshot preview and returns the VM to its state before the preview was started.
+	UndoVMSnapshot(vmID VMID, retries ...RetryStrategy) error
+	// RestoreVMSnapshot restores the VM to the specified snapshot without a preview. This is equivalent to
+	// calling PreviewVMSnapshot and CommitVMSnapshot. The VM must be down.
+	RestoreVMSnapshot(vmID VMID, snapshotID SnapshotID, restoreMemory bool, retries ...RetryStrategy) error
+	// WaitForSnapshotStatus waits for the snapshot to reach the desired status.
+	WaitForSnapshotStatus(
+		vmID VMID,
+		snapshotID SnapshotID,
+		status SnapshotStatus,
+		retries ...RetryStrategy,
+	) (Snapshot, error)
+}
+
+// SnapshotData contains the data access functions of a VM snapshot.
+type SnapshotData interface {
+	// ID returns the identifier of the snapshot.
+	ID() SnapshotID
+	// VMID returns the identifier of the VM the snapshot belongs to.
+	VMID() VMID
+	// Description returns the user-provided description of the snapshot.
+	Description() string
+	// Status returns the current status of the snapshot.
+	Status() SnapshotStatus
+	// Type returns the type of the snapshot.
+	Type() SnapshotType
+	// PersistMemoryState returns true if the snapshot contains the memory state of the VM.
+	PersistMemoryState() bool
+	// Date returns the time the snapshot was taken.
+	Date() time.Time
+	// DiskIDs returns the list of disks included in the snapshot.
+	DiskIDs() []DiskID
+}
+
+// Snapshot is a point-in-time copy of a virtual machine's disks and configuration.
+type Snapshot interface {
+	SnapshotData
+
+	// Remove removes the current snapshot.
+	Remove(retries ...RetryStrategy) error
+	// Preview starts previewing the current snapshot on the VM. See PreviewVMSnapshot for details.
+	Preview(restoreMemory bool, retries ...RetryStrategy) error
+	// Restore restores the VM to the current snapshot. See RestoreVMSnapshot for details.
+	Restore(restoreMemory bool, retries ...RetryStrategy) error
+	// WaitForStatus waits for the snapshot to reach the desired status and returns the updated snapshot.
+	WaitForStatus(status SnapshotStatus, retries ...RetryStrategy) (Snapshot, error)
+}
+
+// SnapshotStatus is the status of a VM snapshot.
+type SnapshotStatus string
+
+const (
+	// SnapshotStatusOK indicates that the snapshot is ready to be used.
+	SnapshotStatusOK SnapshotStatus = "ok"
+	// SnapshotStatusLocked indicates that an operation is currently being performed on the snapshot, such as
+	// creation or removal.
+	SnapshotStatusLocked SnapshotStatus = "locked"
+	// SnapshotStatusInPreview indicates that the VM is currently running a preview of this snapshot.
+	SnapshotStatusInPreview SnapshotStatus = "in_preview"
+)
+
+// SnapshotStatusList is a list of SnapshotStatus.
+type SnapshotStatusList []SnapshotStatus
+
+// SnapshotStatusValues returns all possible SnapshotStatus values.
+func SnapshotStatusValues() SnapshotStatusList {
+	return []SnapshotStatus{
+		SnapshotStatusOK,
+		SnapshotStatusLocked,
+		SnapshotStatusInPreview,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l SnapshotStatusList) Strings() []string {
+	result := make([]string, len(l))
+	for i, status := range l {
+		result[i] = string(status)
+	}
+	return result
+}
+
+// Validate returns an error if the snapshot status doesn't have a valid value.
+func (s SnapshotStatus) Validate() error {
+	for _, status := range SnapshotStatusValues() {
+		if status == s {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid snapshot status: %s must be one of: %s",
+		s,
+		strings.Join(SnapshotStatusValues().Strings(), ", "),
+	)
+}
+
+// SnapshotType describes the role of a snapshot.
+type SnapshotType string
+
+const (
+	// SnapshotTypeActive is the snapshot representing the current, running state of the VM. Every VM has exactly one
+	// active snapshot and it cannot be removed.
+	SnapshotTypeActive SnapshotType = "active"
+	// SnapshotTypePreview is a snapshot holding the state of the VM before a preview was started.
+	SnapshotTypePreview SnapshotType = "preview"
+	// SnapshotTypeRegular is a snapshot created by the user.
+	SnapshotTypeRegular SnapshotType = "regular"
+	// SnapshotTypeStateless is a snapshot created for stateless VMs, which is discarded when the VM shuts down.
+	SnapshotTypeStateless SnapshotType = "stateless"
+)
+
+// CreateSnapshotOptionalParams contains the optional parameters for creating a VM snapshot.
+type CreateSnapshotOptionalParams interface {
+	// PersistMemoryState returns true if the memory state of a running VM should be saved with the snapshot. Nil
+	// means the engine default is used.
+	PersistMemoryState() *bool
+	// DiskIDs returns the list of disks to include in the snapshot. If empty, all disks are included.
+	DiskIDs() []DiskID
+}
+
+// BuildableCreateSnapshotParams is a buildable version of CreateSnapshotOptionalParams.
+type BuildableCreateSnapshotParams interface {
+	CreateSnapshotOptionalParams
+
+	// WithPersistMemoryState sets whether the memory state of a running VM should be saved.
+	WithPersistMemoryState(persistMemoryState bool) (BuildableCreateSnapshotParams, error)
+	// MustWithPersistMemoryState is identical to WithPersistMemoryState, but panics instead of returning an error.
+	MustWithPersistMemoryState(persistMemoryState bool) BuildableCreateSnapshotParams
+
+	// WithDiskIDs restricts the snapshot to the specified disks.
+	WithDiskIDs(diskIDs []DiskID) (BuildableCreateSnapshotParams, error)
+	// MustWithDiskIDs is identical to WithDiskIDs, but panics instead of returning an error.
+	MustWithDiskIDs(diskIDs []DiskID) BuildableCreateSnapshotParams
+}
+
+// CreateSnapshotParams creates a buildable set of parameters for creating a VM snapshot.
+func CreateSnapshotParams() BuildableCreateSnapshotParams {
+	return &createSnapshotParams{}
+}
+
+type createSnapshotParams struct {
+	persistMemoryState *bool
+	diskIDs            []DiskID
+}
+
+func (c *createSnapshotParams) PersistMemoryState() *bool {
+	return c.persistMemoryState
+}
+
+func (c *createSnapshotParams) DiskIDs() []DiskID {
+	return c.diskIDs
+}
+
+func (c *createSnapshotParams) WithPersistMemoryState(persistMemoryState bool) (BuildableCreateSnapshotParams, error) {
+	c.persistMemoryState = &persistMemoryState
+	return c, nil
+}
+
+func (c *createSnapshotParams) MustWithPersistMemoryState(persistMemoryState bool) BuildableCreateSnapshotParams {
+	builder, err := c.WithPersistMemoryState(persistMemoryState)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *createSnapshotParams) WithDiskIDs(diskIDs []DiskID) (BuildableCreateSnapshotParams, error) {
+	seen := map[DiskID]int{}
+	for i, diskID := range diskIDs {
+		if previous, ok := seen[diskID]; ok {
+			return nil, newError(
+				EBadArgument,
+				"disk %s appears twice, in position %d and %d",
+				diskID,
+				previous,
+				i,
+			)
+		}
+		seen[diskID] = i
+	}
+	c.diskIDs = diskIDs
+	return c, nil
+}
+
+func (c *createSnapshotParams) MustWithDiskIDs(diskIDs []DiskID) BuildableCreateSnapshotParams {
+	builder, err := c.WithDiskIDs(diskIDs)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+type snapshot struct {
+	client Client
+
+	id                 SnapshotID
+	vmID               VMID
+	description        string
+	status             SnapshotStatus
+	snapshotType       SnapshotType
+	persistMemoryState bool
+	date               time.Time
+	diskIDs            []DiskID
+}
+
+func (s *snapshot) ID() SnapshotID {
+	return s.id
+}
+
+func (s *snapshot) VMID() VMID {
+	return s.vmID
+}
+
+func (s *snapshot) Description() string {
+	return s.description
+}
+
+func (s *snapshot) Status() SnapshotStatus {
+	return s.status
+}
+
+func (s *snapshot) Type() SnapshotType {
+	return s.snapshotType
+}
+
+func (s *snapshot) PersistMemoryState() bool {
+	return s.persistMemoryState
+}
+
+func (s *snapshot) Date() time.Time {
+	return s.date
+}
+
+func (s *snapshot) DiskIDs() []DiskID {
+	return s.diskIDs
+}
+
+func (s *snapshot) Remove(retries ...RetryStrategy) error {
+	return s.client.RemoveVMSnapshot(s.vmID, s.id, retries...)
+}
+
+func (s *snapshot) Preview(restoreMemory bool, retries ...RetryStrategy) error {
+	return s.client.PreviewVMSnapshot(s.vmID, s.id, restoreMemory, retries...)
+}
+
+func (s *snapshot) Restore(restoreMemory bool, retries ...RetryStrategy) error {
+	return s.client.RestoreVMSnapshot(s.vmID, s.id, restoreMemory, retries...)
+}
+
+func (s *snapshot) WaitForStatus(status SnapshotStatus, retries ...RetryStrategy) (Snapshot, error) {
+	return s.client.WaitForSnapshotStatus(s.vmID, s.id, status, retries...)
+}
+
+// clone creates a copy of the snapshot so the mock can hand out objects that don't change under the caller.
+func (s *snapshot) clone() *snapshot {
+	diskIDs := make([]DiskID, len(s.diskIDs))
+	copy(diskIDs, s.diskIDs)
+	return &snapshot{
+		client:             s.client,
+		id:                 s.id,
+		vmID:               s.vmID,
+		description:        s.description,
+		status:             s.status,
+		snapshotType:       s.snapshotType,
+		persistMemoryState: s.persistMemoryState,
+		date:               s.date,
+		diskIDs:            diskIDs,
+	}
+}
+
+func convertSDKSnapshot(sdkObject *ovirtsdk.Snapshot, vmID VMID, client Client) (Snapshot, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("snapshot", "id")
+	}
+	status, ok := sdkObject.SnapshotStatus()
+	if !ok {
+		return nil, newFieldNotFound("snapshot", "snapshot status")
+	}
+	snapshotType, ok := sdkObject.SnapshotType()
+	if !ok {
+		return nil, newFieldNotFound("snapshot", "snapshot type")
+	}
+	if sdkVM, ok := sdkObject.Vm(); ok {
+		if sdkVMID, ok := sdkVM.Id(); ok {
+			vmID = VMID(sdkVMID)
+		}
+	}
+	description, _ := sdkObject.Description()
+	persistMemoryState, _ := sdkObject.PersistMemorystate()
+	date, _ := sdkObject.Date()
+	var diskIDs []DiskID
+	if sdkDisks, ok := sdkObject.Disks(); ok {
+		for _, sdkDisk := range sdkDisks.Slice() {
+			diskID, ok := sdkDisk.Id()
+			if !ok {
+				return nil, newFieldNotFound("disk on snapshot", "id")
+			}
+			diskIDs = append(diskIDs, DiskID(diskID))
+		}
+	}
+	return &snapshot{
+		client:             client,
+		id:                 SnapshotID(id),
+		vmID:               vmID,
+		description:        description,
+		status:             SnapshotStatus(status),
+		snapshotType:       SnapshotType(snapshotType),
+		persistMemoryState: persistMemoryState,
+		date:               date,
+		diskIDs:            diskIDs,
+	}, nil
+}
@@ -0,0 +1,151 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) CreateVMSnapshot(
+	vmID VMID,
+	description string,
+	params CreateSnapshotOptionalParams,
+	retries ...RetryStrategy,
+) (result Snapshot, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if err := validateSnapshotCreationParameters(description); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = CreateSnapshotParams()
+	}
+	builder := ovirtsdk.NewSnapshotBuilder().Description(description)
+	if persistMemoryState := params.PersistMemoryState(); persistMemoryState != nil {
+		builder.PersistMemorystate(*persistMemoryState)
+	}
+	if diskIDs := params.DiskIDs(); len(diskIDs) > 0 {
+		diskAttachments := make([]ovirtsdk.DiskAttachmentBuilder, len(diskIDs))
+		for i, diskID := range diskIDs {
+			diskAttachments[i] = *ovirtsdk.NewDiskAttachmentBuilder().DiskBuilder(
+				ovirtsdk.NewDiskBuilder().Id(string(diskID)),
+			)
+		}
+		builder.DiskAttachmentsBuilderOfAny(diskAttachments...)
+	}
+	sdkSnapshot, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build snapshot")
+	}
+	err = retry(
+		fmt.Sprintf("creating snapshot for VM %s", vmID),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				SnapshotsService().
+				Add().
+				Snapshot(sdkSnapshot).
+				Send()
+			if err != nil {
+				return err
+			}
+			snapshot, ok := response.Snapshot()
+			if !ok {
+				return newFieldNotFound("snapshot create response", "snapshot")
+			}
+			result, err = convertSDKSnapshot(snapshot, vmID, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert snapshot")
+			}
+			return nil
+		},
+	)
+	return result, err
+}
+
+func validateSnapshotCreationParameters(description string) error {
+	if description == "" {
+		return newError(EBadArgument, "description cannot be empty for snapshot creation")
+	}
+	return nil
+}
+
+func (m *mockClient) CreateVMSnapshot(
+	vmID VMID,
+	description string,
+	params CreateSnapshotOptionalParams,
+	retries ...RetryStrategy,
+) (result Snapshot, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if err := validateSnapshotCreationParameters(description); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = CreateSnapshotParams()
+	}
+	err = retry(
+		fmt.Sprintf("creating snapshot of VM %s", vmID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			vm, ok := m.vms[vmID]
+			if !ok {
+				return newError(ENotFound, "VM with ID %s not found", vmID)
+			}
+			if vm.status == VMStatusImageLocked {
+				return newError(EVMLocked, "VM %s is locked", vmID)
+			}
+			if m.vmSnapshotInPreview(vmID) != nil {
+				return newError(EConflict, "cannot create a snapshot of VM %s while a snapshot is in preview", vmID)
+			}
+			disks, err := m.vmSnapshotDisks(vmID, params.DiskIDs())
+			if err != nil {
+				return err
+			}
+			if err := m.lockSnapshotDisks(disks); err != nil {
+				return err
+			}
+
+			persistMemoryState := false
+			if p := params.PersistMemoryState(); p != nil {
+				persistMemoryState = *p && vm.status == VMStatusUp
+			}
+			snap := &snapshotWithData{
+				snapshot: snapshot{
+					client:             m,
+					id:                 SnapshotID(m.GenerateUUID()),
+					vmID:               vmID,
+					description:        description,
+					status:             SnapshotStatusLocked,
+					snapshotType:       SnapshotTypeRegular,
+					persistMemoryState: persistMemoryState,
+					date:               time.Now(),
+				},
+				diskData: copyDiskData(disks),
+			}
+			for _, d := range disks {
+				snap.diskIDs = append(snap.diskIDs, d.ID())
+			}
+			m.snapshotsByVM[vmID] = append(m.snapshotsByVM[vmID], snap)
+
+			go func() {
+				time.Sleep(time.Second)
+				m.lock.Lock()
+				defer m.lock.Unlock()
+				snap.status = SnapshotStatusOK
+				unlockSnapshotDisks(disks)
+			}()
+
+			result = snap.clone()
+			return nil
+		})
+	return
+}
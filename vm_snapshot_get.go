@@ -0,0 +1,67 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetVMSnapshot(vmID VMID, snapshotID SnapshotID, retries ...RetryStrategy) (result Snapshot, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("getting snapshot %s for VM %s", snapshotID, vmID),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				SnapshotsService().
+				SnapshotService(string(snapshotID)).
+				Get().
+				Follow("disks").
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkSnapshot, ok := response.Snapshot()
+			if !ok {
+				return newError(
+					ENotFound,
+					"no snapshot returned when getting snapshot %s for VM %s",
+					snapshotID,
+					vmID,
+				)
+			}
+			result, err = convertSDKSnapshot(sdkSnapshot, vmID, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert snapshot %s", snapshotID)
+			}
+			return nil
+		},
+	)
+	return result, err
+}
+
+func (m *mockClient) GetVMSnapshot(
+	vmID VMID,
+	snapshotID SnapshotID,
+	retries ...RetryStrategy,
+) (result Snapshot, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	err = retry(
+		fmt.Sprintf("getting snapshot %s of VM %s", snapshotID, vmID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			snap, err := m.getVMSnapshot(vmID, snapshotID)
+			if err != nil {
+				return err
+			}
+			result = snap.clone()
+			return nil
+		})
+	return
+}
@@ -0,0 +1,63 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListVMSnapshots(vmID VMID, retries ...RetryStrategy) (result []Snapshot, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(o))
+	err = retry(
+		fmt.Sprintf("listing snapshots for VM %s", vmID),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				SnapshotsService().
+				List().
+				Follow("disks").
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkSnapshots, ok := response.Snapshots()
+			if !ok {
+				return nil
+			}
+			result = make([]Snapshot, len(sdkSnapshots.Slice()))
+			for i, sdkSnapshot := range sdkSnapshots.Slice() {
+				result[i], err = convertSDKSnapshot(sdkSnapshot, vmID, o)
+				if err != nil {
+					return wrap(err, EBug, "failed to convert snapshot during listing item #%d", i)
+				}
+			}
+			return nil
+		},
+	)
+	return result, err
+}
+
+func (m *mockClient) ListVMSnapshots(vmID VMID, retries ...RetryStrategy) (result []Snapshot, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts(m))
+	err = retry(
+		fmt.Sprintf("listing snapshots of VM %s", vmID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, ok := m.vms[vmID]; !ok {
+				return newError(ENotFound, "VM with ID %s not found", vmID)
+			}
+			snapshots := m.snapshotsByVM[vmID]
+			result = make([]Snapshot, len(snapshots))
+			for i, snap := range snapshots {
+				result[i] = snap.clone()
+			}
+			return nil
+		})
+	return
+}
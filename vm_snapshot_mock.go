@@ -0,0 +1,220 @@
+package ovirtclient
+
+import (
+	"time"
+)
+
+// snapshotWithData adds the disk contents at the time of the snapshot for mocking purposes.
+type snapshotWithData struct {
+	snapshot
+
+	diskData map[DiskID][]byte
+}
+
+// newActiveSnapshot creates the snapshot representing the current state of a VM. The engine creates this snapshot
+// with every VM.
+func newActiveSnapshot(client Client, vmID VMID, id SnapshotID) *snapshotWithData {
+	return &snapshotWithData{
+		snapshot: snapshot{
+			client:       client,
+			id:           id,
+			vmID:         vmID,
+			description:  "Active VM",
+			status:       SnapshotStatusOK,
+			snapshotType: SnapshotTypeActive,
+			date:         time.Now(),
+		},
+		diskData: map[DiskID][]byte{},
+	}
+}
+
+// getVMSnapshot returns the stored snapshot for a VM. Must be called with the lock held.
+func (m *mockClient) getVMSnapshot(vmID VMID, snapshotID SnapshotID) (*snapshotWithData, error) {
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	for _, snap := range m.snapshotsByVM[vmID] {
+		if snap.id == snapshotID {
+			return snap, nil
+		}
+	}
+	return nil, newError(ENotFound, "snapshot with ID %s not found on VM %s", snapshotID, vmID)
+}
+
+// vmSnapshotInPreview returns the snapshot currently being previewed on the VM, or nil. Must be called with the lock
+// held.
+func (m *mockClient) vmSnapshotInPreview(vmID VMID) *snapshotWithData {
+	for _, snap := range m.snapshotsByVM[vmID] {
+		if snap.status == SnapshotStatusInPreview {
+			return snap
+		}
+	}
+	return nil
+}
+
+// vmSnapshotDisks returns the disks attached to the VM that should be part of a new snapshot. If diskIDs is empty
+// all attached disks are returned. Must be called with the lock held.
+func (m *mockClient) vmSnapshotDisks(vmID VMID, diskIDs []DiskID) ([]*diskWithData, error) {
+	attachedDisks := map[DiskID]*diskWithData{}
+	var result []*diskWithData
+	for _, attachment := range m.vmDiskAttachmentsByVM[vmID] {
+		if d, ok := m.disks[attachment.diskID]; ok {
+			attachedDisks[d.id] = d
+			if len(diskIDs) == 0 {
+				result = append(result, d)
+			}
+		}
+	}
+	for _, diskID := range diskIDs {
+		d, ok := attachedDisks[diskID]
+		if !ok {
+			return nil, newError(EBadArgument, "disk %s is not attached to VM %s", diskID, vmID)
+		}
+		result = append(result, d)
+	}
+	return result, nil
+}
+
+// existingSnapshotDisks returns the disks of a snapshot that still exist. Must be called with the lock held.
+func (m *mockClient) existingSnapshotDisks(snap *snapshotWithData) []*diskWithData {
+	var result []*diskWithData
+	for _, diskID := range snap.diskIDs {
+		if d, ok := m.disks[diskID]; ok {
+			result = append(result, d)
+		}
+	}
+	return result
+}
+
+// lockSnapshotDisks locks all disks involved in a snapshot operation. If one of the disks is already locked, the
+// disks locked so far are unlocked and an error is returned.
+func (m *mockClient) lockSnapshotDisks(disks []*diskWithData) error {
+	for i, d := range disks {
+		if err := d.Lock(); err != nil {
+			unlockSnapshotDisks(disks[:i])
+			return err
+		}
+	}
+	return nil
+}
+
+func unlockSnapshotDisks(disks []*diskWithData) {
+	for _, d := range disks {
+		d.Unlock()
+	}
+}
+
+func copyDiskData(disks []*diskWithData) map[DiskID][]byte {
+	result := make(map[DiskID][]byte, len(disks))
+	for _, d := range disks {
+		d.lock.Lock()
+		data := make([]byte, len(d.data))
+		copy(data, d.data)
+		d.lock.Unlock()
+		result[d.id] = data
+	}
+	return result
+}
+
+func restoreDiskData(disks []*diskWithData, diskData map[DiskID][]byte) {
+	for _, d := range disks {
+		data, ok := diskData[d.id]
+		if !ok {
+			continue
+		}
+		newData := make([]byte, len(data))
+		copy(newData, data)
+		d.setData(newData)
+	}
+}
+
+// prepareSnapshotRestore validates that the snapshot can be restored or previewed on the VM. Must be called with the
+// lock held.
+func (m *mockClient) prepareSnapshotRestore(
+	vmID VMID,
+	snapshotID SnapshotID,
+) (*snapshotWithData, []*diskWithData, error) {
+	snap, err := m.getVMSnapshot(vmID, snapshotID)
+	if err != nil {
+		return nil, nil, err
+	}
+	vm := m.vms[vmID]
+	if vm.status != VMStatusDown {
+		return nil, nil, newError(
+			EConflict,
+			"VM %s must be down to restore a snapshot (currently %s)",
+			vmID,
+			vm.status,
+		)
+	}
+	if snap.snapshotType != SnapshotTypeRegular {
+		return nil, nil, newError(EConflict, "snapshot %s of type %s cannot be restored", snapshotID, snap.snapshotType)
+	}
+	if m.vmSnapshotInPreview(vmID) != nil {
+		return nil, nil, newError(EConflict, "VM %s already has a snapshot in preview", vmID)
+	}
+	if snap.status != SnapshotStatusOK {
+		return nil, nil, newError(EConflict, "snapshot %s is in status %s", snapshotID, snap.status)
+	}
+	return snap, m.existingSnapshotDisks(snap), nil
+}
+
+// runVMSnapshotOperation simulates the asynchronous snapshot operations of the engine. The VM is locked until the
+// finish function has run in the background. Must be called with the lock held.
+func (m *mockClient) runVMSnapshotOperation(vmID VMID, disks []*diskWithData, finish func()) {
+	m.setMockVMStatus(vmID, VMStatusImageLocked)
+	go func() {
+		time.Sleep(time.Second)
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		finish()
+		unlockSnapshotDisks(disks)
+		m.setMockVMStatus(vmID, VMStatusDown)
+	}()
+}
+
+// setMockVMStatus replaces the VM with a copy in the specified status, so VMs returned earlier do not change. Must be
+// called with the lock held.
+func (m *mockClient) setMockVMStatus(vmID VMID, status VMStatus) {
+	if vm, ok := m.vms[vmID]; ok {
+		vm = vm.clone()
+		vm.status = status
+		m.vms[vmID] = vm
+	}
+}
+
+// deleteVMSnapshot removes a snapshot from the VM. Must be called with the lock held.
+func (m *mockClient) deleteVMSnapshot(vmID VMID, snapshotID SnapshotID) {
+	snapshots := m.snapshotsByVM[vmID]
+	for i, snap := range snapshots {
+		if snap.id == snapshotID {
+			m.snapshotsByVM[vmID] = append(snapshots[:i:i], snapshots[i+1:]...)
+			return
+		}
+	}
+}
+
+// removeVMPreviewSnapshots removes the snapshots holding the state before a preview. Must be called with the lock
+// held.
+func (m *mockClient) removeVMPreviewSnapshots(vmID VMID) {
+	var remaining []*snapshotWithData
+	for _, snap := range m.snapshotsByVM[vmID] {
+		if snap.snapshotType != SnapshotTypePreview {
+			remaining = append(remaining, snap)
+		}
+	}
+	m.snapshotsByVM[vmID] = remaining
+}
+
+// removeVMSnapshotsAfter removes all regular snapshots taken after the specified snapshot, as the engine does when
+// a snapshot is restored. Must be called with the lock held.
+func (m *mockClient) removeVMSnapshotsAfter(vmID VMID, after *snapshotWithData) {
+	var remaining []*snapshotWithData
+	for _, snap := range m.snapshotsByVM[vmID] {
+		if snap.snapshotType == SnapshotTypeRegular && snap.date.After(after.date) {
+			continue
+		}
+		remaining = append(remaining, snap)
+	}
+	m.snapshotsByVM[vmID] = remaining
+}
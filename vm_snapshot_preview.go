@@ -0,0 +1,191 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) PreviewVMSnapshot(
+	vmID VMID,
+	snapshotID SnapshotID,
+	restoreMemory bool,
+	retries ...RetryStrategy,
+) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	sdkSnapshot, err := ovirtsdk.NewSnapshotBuilder().Id(string(snapshotID)).Build()
+	if err != nil {
+		return wrap(err, EBug, "failed to build snapshot")
+	}
+	err = retry(
+		fmt.Sprintf("previewing snapshot %s on VM %s", snapshotID, vmID),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				PreviewSnapshot().
+				Snapshot(sdkSnapshot).
+				RestoreMemory(restoreMemory).
+				Send()
+			return err
+		},
+	)
+	return err
+}
+
+func (o *oVirtClient) CommitVMSnapshot(vmID VMID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("committing snapshot preview on VM %s", vmID),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().VmsService().VmService(string(vmID)).CommitSnapshot().Send()
+			return err
+		},
+	)
+	return err
+}
+
+func (o *oVirtClient) UndoVMSnapshot(vmID VMID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("undoing snapshot preview on VM %s", vmID),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.SystemService().VmsService().VmService(string(vmID)).UndoSnapshot().Send()
+			return err
+		},
+	)
+	return err
+}
+
+func (m *mockClient) PreviewVMSnapshot(
+	vmID VMID,
+	snapshotID SnapshotID,
+	_ bool,
+	retries ...RetryStrategy,
+) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("previewing snapshot %s of VM %s", snapshotID, vmID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			snap, disks, err := m.prepareSnapshotRestore(vmID, snapshotID)
+			if err != nil {
+				return err
+			}
+			if err := m.lockSnapshotDisks(disks); err != nil {
+				return err
+			}
+
+			// The engine keeps the state from before the preview in a separate snapshot so the preview can be undone.
+			previewSnapshot := &snapshotWithData{
+				snapshot: snapshot{
+					client:       m,
+					id:           SnapshotID(m.GenerateUUID()),
+					vmID:         vmID,
+					description:  "Active VM before the preview",
+					status:       SnapshotStatusOK,
+					snapshotType: SnapshotTypePreview,
+					date:         time.Now(),
+					diskIDs:      snap.diskIDs,
+				},
+				diskData: copyDiskData(disks),
+			}
+			m.snapshotsByVM[vmID] = append(m.snapshotsByVM[vmID], previewSnapshot)
+			snap.status = SnapshotStatusLocked
+
+			m.runVMSnapshotOperation(vmID, disks, func() {
+				restoreDiskData(disks, snap.diskData)
+				snap.status = SnapshotStatusInPreview
+			})
+			return nil
+		})
+}
+
+func (m *mockClient) CommitVMSnapshot(vmID VMID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("committing the snapshot preview of VM %s", vmID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			snap, disks, err := m.prepareSnapshotPreviewEnd(vmID)
+			if err != nil {
+				return err
+			}
+			if err := m.lockSnapshotDisks(disks); err != nil {
+				return err
+			}
+			snap.status = SnapshotStatusLocked
+
+			m.runVMSnapshotOperation(vmID, disks, func() {
+				m.removeVMPreviewSnapshots(vmID)
+				m.removeVMSnapshotsAfter(vmID, snap)
+				snap.status = SnapshotStatusOK
+			})
+			return nil
+		})
+}
+
+func (m *mockClient) UndoVMSnapshot(vmID VMID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("undoing the snapshot preview of VM %s", vmID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			snap, disks, err := m.prepareSnapshotPreviewEnd(vmID)
+			if err != nil {
+				return err
+			}
+			if err := m.lockSnapshotDisks(disks); err != nil {
+				return err
+			}
+			snap.status = SnapshotStatusLocked
+
+			m.runVMSnapshotOperation(vmID, disks, func() {
+				for _, s := range m.snapshotsByVM[vmID] {
+					if s.snapshotType == SnapshotTypePreview {
+						restoreDiskData(disks, s.diskData)
+					}
+				}
+				m.removeVMPreviewSnapshots(vmID)
+				snap.status = SnapshotStatusOK
+			})
+			return nil
+		})
+}
+
+// prepareSnapshotPreviewEnd validates that the VM is in a state where a preview can be committed or undone. It
+// returns the snapshot in preview and the disks affected. Must be called with the lock held.
+func (m *mockClient) prepareSnapshotPreviewEnd(vmID VMID) (*snapshotWithData, []*diskWithData, error) {
+	vm, ok := m.vms[vmID]
+	if !ok {
+		return nil, nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	if vm.status != VMStatusDown {
+		return nil, nil, newError(EConflict, "VM %s must be down to end a snapshot preview (currently %s)", vmID, vm.status)
+	}
+	snap := m.vmSnapshotInPreview(vmID)
+	if snap == nil {
+		return nil, nil, newError(EConflict, "VM %s has no snapshot in preview", vmID)
+	}
+	return snap, m.existingSnapshotDisks(snap), nil
+}
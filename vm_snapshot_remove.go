@@ -0,0 +1,67 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+)
+
+func (o *oVirtClient) RemoveVMSnapshot(vmID VMID, snapshotID SnapshotID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("removing snapshot %s from VM %s", snapshotID, vmID),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				SnapshotsService().
+				SnapshotService(string(snapshotID)).
+				Remove().
+				Send()
+			return err
+		},
+	)
+	return err
+}
+
+func (m *mockClient) RemoveVMSnapshot(vmID VMID, snapshotID SnapshotID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("removing snapshot %s of VM %s", snapshotID, vmID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			snap, err := m.getVMSnapshot(vmID, snapshotID)
+			if err != nil {
+				return err
+			}
+			if snap.snapshotType == SnapshotTypeActive {
+				return newError(EConflict, "the active snapshot of VM %s cannot be removed", vmID)
+			}
+			if m.vmSnapshotInPreview(vmID) != nil {
+				return newError(EConflict, "cannot remove snapshots of VM %s while a snapshot is in preview", vmID)
+			}
+			if snap.status != SnapshotStatusOK {
+				return newError(EConflict, "snapshot %s is in status %s", snapshotID, snap.status)
+			}
+			disks := m.existingSnapshotDisks(snap)
+			if err := m.lockSnapshotDisks(disks); err != nil {
+				return err
+			}
+			snap.status = SnapshotStatusLocked
+
+			go func() {
+				time.Sleep(time.Second)
+				m.lock.Lock()
+				defer m.lock.Unlock()
+				m.deleteVMSnapshot(vmID, snapshotID)
+				unlockSnapshotDisks(disks)
+			}()
+			return nil
+		})
+}
@@ -0,0 +1,65 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) RestoreVMSnapshot(
+	vmID VMID,
+	snapshotID SnapshotID,
+	restoreMemory bool,
+	retries ...RetryStrategy,
+) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	err = retry(
+		fmt.Sprintf("restoring snapshot %s on VM %s", snapshotID, vmID),
+		o.logger,
+		retries,
+		func() error {
+			_, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				SnapshotsService().
+				SnapshotService(string(snapshotID)).
+				Restore().
+				RestoreMemory(restoreMemory).
+				Send()
+			return err
+		},
+	)
+	return err
+}
+
+func (m *mockClient) RestoreVMSnapshot(
+	vmID VMID,
+	snapshotID SnapshotID,
+	_ bool,
+	retries ...RetryStrategy,
+) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	return retry(
+		fmt.Sprintf("restoring snapshot %s of VM %s", snapshotID, vmID),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			snap, disks, err := m.prepareSnapshotRestore(vmID, snapshotID)
+			if err != nil {
+				return err
+			}
+			if err := m.lockSnapshotDisks(disks); err != nil {
+				return err
+			}
+			snap.status = SnapshotStatusLocked
+
+			m.runVMSnapshotOperation(vmID, disks, func() {
+				restoreDiskData(disks, snap.diskData)
+				m.removeVMSnapshotsAfter(vmID, snap)
+				snap.status = SnapshotStatusOK
+			})
+			return nil
+		})
+}
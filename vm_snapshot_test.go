@@ -0,0 +1,161 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMSnapshotLifecycle(t *testing.T) {
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+
+	snapshot := assertCanCreateSnapshot(t, vm, ovirtclient.CreateSnapshotParams())
+	if snapshot.VMID() != vm.ID() {
+		t.Fatalf("Incorrect VM ID on snapshot (expected: %s, got: %s)", vm.ID(), snapshot.VMID())
+	}
+	assertSnapshotHasDisk(t, snapshot, disk.ID())
+
+	snapshots, err := vm.ListSnapshots()
+	if err != nil {
+		t.Fatalf("Failed to list snapshots of VM %s (%v)", vm.ID(), err)
+	}
+	foundActive := false
+	foundSnapshot := false
+	for _, s := range snapshots {
+		if s.Type() == ovirtclient.SnapshotTypeActive {
+			foundActive = true
+		}
+		if s.ID() == snapshot.ID() {
+			foundSnapshot = true
+		}
+	}
+	if !foundActive {
+		t.Fatalf("The active snapshot was not found in the snapshot list of VM %s.", vm.ID())
+	}
+	if !foundSnapshot {
+		t.Fatalf("Snapshot %s was not found in the snapshot list of VM %s.", snapshot.ID(), vm.ID())
+	}
+
+	fetchedSnapshot, err := helper.GetClient().GetVMSnapshot(vm.ID(), snapshot.ID())
+	if err != nil {
+		t.Fatalf("Failed to get snapshot %s of VM %s (%v)", snapshot.ID(), vm.ID(), err)
+	}
+	if fetchedSnapshot.Description() != snapshot.Description() {
+		t.Fatalf(
+			"Incorrect snapshot description (expected: %s, got: %s)",
+			snapshot.Description(),
+			fetchedSnapshot.Description(),
+		)
+	}
+
+	assertCanRemoveSnapshot(t, helper, snapshot)
+}
+
+func TestVMSnapshotPreviewAndUndo(t *testing.T) {
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+	snapshot := assertCanCreateSnapshot(t, vm, nil)
+
+	if err := snapshot.Preview(false); err != nil {
+		t.Fatalf("Failed to preview snapshot %s (%v)", snapshot.ID(), err)
+	}
+	if _, err := snapshot.WaitForStatus(ovirtclient.SnapshotStatusInPreview); err != nil {
+		t.Fatalf("Snapshot %s did not enter the preview (%v)", snapshot.ID(), err)
+	}
+	if _, err := vm.CreateSnapshot(helper.GenerateTestResourceName(t), nil, ovirtclient.MaxTries(1)); err == nil {
+		t.Fatalf("Creating a snapshot while another snapshot is in preview did not result in an error.")
+	}
+	if err := helper.GetClient().UndoVMSnapshot(vm.ID()); err != nil {
+		t.Fatalf("Failed to undo the snapshot preview on VM %s (%v)", vm.ID(), err)
+	}
+	if _, err := snapshot.WaitForStatus(ovirtclient.SnapshotStatusOK); err != nil {
+		t.Fatalf("Snapshot %s did not return to the OK status after undoing the preview (%v)", snapshot.ID(), err)
+	}
+}
+
+func TestVMSnapshotRestore(t *testing.T) {
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+	snapshot := assertCanCreateSnapshot(t, vm, nil)
+	newerSnapshot := assertCanCreateSnapshot(t, vm, nil)
+
+	if err := snapshot.Restore(false); err != nil {
+		t.Fatalf("Failed to restore snapshot %s (%v)", snapshot.ID(), err)
+	}
+	if _, err := helper.GetClient().WaitForVMStatus(vm.ID(), ovirtclient.VMStatusDown); err != nil {
+		t.Fatalf("VM %s did not return to the down status after restoring a snapshot (%v)", vm.ID(), err)
+	}
+	if _, err := snapshot.WaitForStatus(ovirtclient.SnapshotStatusOK); err != nil {
+		t.Fatalf("Snapshot %s did not return to the OK status after restore (%v)", snapshot.ID(), err)
+	}
+	_, err := helper.GetClient().GetVMSnapshot(vm.ID(), newerSnapshot.ID())
+	if err == nil {
+		t.Fatalf("Snapshot %s still exists after restoring an older snapshot.", newerSnapshot.ID())
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Unexpected error when fetching removed snapshot %s (%v)", newerSnapshot.ID(), err)
+	}
+}
+
+func TestVMSnapshotCreationRequiresDescription(t *testing.T) {
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+	_, err := vm.CreateSnapshot("", nil)
+	if err == nil {
+		t.Fatalf("Creating a snapshot without a description did not result in an error.")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Creating a snapshot without a description did not return an EBadArgument error (%v)", err)
+	}
+}
+
+func assertCanCreateSnapshot(
+	t *testing.T,
+	vm ovirtclient.VM,
+	params ovirtclient.CreateSnapshotOptionalParams,
+) ovirtclient.Snapshot {
+	snapshot, err := vm.CreateSnapshot(vm.Name()+"-snapshot", params)
+	if err != nil {
+		t.Fatalf("Failed to create snapshot of VM %s (%v)", vm.ID(), err)
+	}
+	snapshot, err = snapshot.WaitForStatus(ovirtclient.SnapshotStatusOK)
+	if err != nil {
+		t.Fatalf("Snapshot of VM %s did not reach the OK status (%v)", vm.ID(), err)
+	}
+	return snapshot
+}
+
+func assertSnapshotHasDisk(t *testing.T, snapshot ovirtclient.Snapshot, diskID ovirtclient.DiskID) {
+	for _, id := range snapshot.DiskIDs() {
+		if id == diskID {
+			return
+		}
+	}
+	t.Fatalf("Disk %s not found in snapshot %s.", diskID, snapshot.ID())
+}
+
+func assertCanRemoveSnapshot(t *testing.T, helper ovirtclient.TestHelper, snapshot ovirtclient.Snapshot) {
+	if err := snapshot.Remove(); err != nil {
+		t.Fatalf("Failed to remove snapshot %s (%v)", snapshot.ID(), err)
+	}
+	// The snapshot is locked while it is being removed, so waiting for it to become OK again will only end when it is
+	// gone.
+	_, err := helper.GetClient().WaitForSnapshotStatus(snapshot.VMID(), snapshot.ID(), ovirtclient.SnapshotStatusOK)
+	if err == nil {
+		t.Fatalf("Snapshot %s still exists after removal.", snapshot.ID())
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("Unexpected error after removing snapshot %s (%v)", snapshot.ID(), err)
+	}
+}
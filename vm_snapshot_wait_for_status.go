@@ -0,0 +1,61 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) WaitForSnapshotStatus(
+	vmID VMID,
+	snapshotID SnapshotID,
+	status SnapshotStatus,
+	retries ...RetryStrategy,
+) (result Snapshot, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(o))
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("waiting for snapshot %s of VM %s to enter status \"%s\"", snapshotID, vmID, status),
+		o.logger,
+		retries,
+		func() error {
+			result, err = o.GetVMSnapshot(vmID, snapshotID, retries...)
+			if err != nil {
+				return err
+			}
+			if result.Status() != status {
+				return newError(EPending, "snapshot %s status is \"%s\", not \"%s\"", snapshotID, result.Status(), status)
+			}
+			return nil
+		},
+	)
+	return result, err
+}
+
+func (m *mockClient) WaitForSnapshotStatus(
+	vmID VMID,
+	snapshotID SnapshotID,
+	status SnapshotStatus,
+	retries ...RetryStrategy,
+) (result Snapshot, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts(m))
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("waiting for snapshot %s of VM %s to enter status \"%s\"", snapshotID, vmID, status),
+		m.logger,
+		retries,
+		func() error {
+			result, err = m.GetVMSnapshot(vmID, snapshotID, retries...)
+			if err != nil {
+				return err
+			}
+			if result.Status() != status {
+				return newError(EPending, "snapshot %s status is \"%s\", not \"%s\"", snapshotID, result.Status(), status)
+			}
+			return nil
+		},
+	)
+	return result, err
+}
@@ -37,10 +37,13 @@ func (m *mockClient) StartVM(id VMID, _ ...RetryStrategy) error {
 	}
 	item.hostID = &hostID
 	item.status = VMStatusWaitForLaunch
+	m.updateHostVMCounts()
 	go func() {
 		time.Sleep(2 * time.Second)
 		m.lock.Lock()
-		if item.status != VMStatusWaitForLaunch {
+		// The VM may have been replaced by an update in the meantime, so we always look up the current copy.
+		item, ok := m.vms[id]
+		if !ok || item.status != VMStatusWaitForLaunch {
 			m.lock.Unlock()
 			return
 		}
@@ -48,15 +51,18 @@ func (m *mockClient) StartVM(id VMID, _ ...RetryStrategy) error {
 		m.lock.Unlock()
 		time.Sleep(2 * time.Second)
 		m.lock.Lock()
-		if item.status != VMStatusPoweringUp {
+		item, ok = m.vms[id]
+		if !ok || item.status != VMStatusPoweringUp {
 			m.lock.Unlock()
 			return
 		}
 		item.status = VMStatusUp
+		m.addVMEvent(item, mockEventCodeVMStarted, "VM %s started.", item.name)
 		m.lock.Unlock()
 		time.Sleep(10 * time.Second)
 		m.lock.Lock()
-		if item.status == VMStatusUp {
+		item, ok = m.vms[id]
+		if ok && item.status == VMStatusUp {
 			m.vmIPs[item.id] = map[string][]net.IP{
 				"lo": {
 					net.ParseIP("::1"),
@@ -64,12 +70,18 @@ func (m *mockClient) StartVM(id VMID, _ ...RetryStrategy) error {
 				},
 			}
 			i := 0
-			for _, nic := range m.nics {
-				if nic.vmid == item.id {
-					m.vmIPs[item.id][fmt.Sprintf("eth%d", i)] = []net.IP{
-						net.ParseIP("192.168.0.123"),
-						net.ParseIP("fe80::123"),
+			for nicID, nic := range m.nics {
+				if nic.vmid == item.id && nic.plugged {
+					device := &nicReportedDevice{
+						name: fmt.Sprintf("eth%d", i),
+						mac:  nic.mac,
+						ips: []net.IP{
+							net.ParseIP("192.168.0.123"),
+							net.ParseIP("fe80::123"),
+						},
 					}
+					m.vmIPs[item.id][device.name] = device.ips
+					m.nics[nicID] = nic.withReportedDevices([]*nicReportedDevice{device})
 					i++
 				}
 			}
@@ -91,6 +103,9 @@ func (m *mockClient) findSuitableHost(vmID VMID) (HostID, error) {
 	// Try to find a host that is suitable.
 	var foundHost *host
 	for _, host := range m.hosts {
+		if host.status != HostStatusUp {
+			continue
+		}
 		hostSuitable := true
 	loop:
 		for _, vm := range m.vms {
@@ -27,20 +27,35 @@ func (m *mockClient) StopVM(id VMID, force bool, _ ...RetryStrategy) error {
 			return newError(EConflict, "VM is currently backing up or restoring.")
 		}
 		m.vmIPs[id] = map[string][]net.IP{}
+		m.clearNICReportedDevices(id)
 		if item.status != VMStatusDown {
 			item.status = VMStatusPoweringDown
 			go func() {
 				time.Sleep(2 * time.Second)
 				m.lock.Lock()
 				defer m.lock.Unlock()
-				if item.status != VMStatusPoweringDown {
+				item, ok := m.vms[id]
+				if !ok || item.status != VMStatusPoweringDown {
 					return
 				}
 				item.status = VMStatusDown
+				m.addVMEvent(item, mockEventCodeVMStopped, "VM %s powered off.", item.name)
 				item.hostID = nil
+				m.applyVMNextRun(id)
+				m.updateHostVMCounts()
 			}()
 		}
 		return nil
 	}
 	return newError(ENotFound, "vm with ID %s not found", id)
 }
+
+// clearNICReportedDevices removes the guest-reported devices from the NICs of a VM once the guest is no longer
+// running. The caller must hold the lock of the mock client.
+func (m *mockClient) clearNICReportedDevices(vmID VMID) {
+	for nicID, n := range m.nics {
+		if n.vmid == vmID && len(n.reportedDevices) != 0 {
+			m.nics[nicID] = n.withReportedDevices(nil)
+		}
+	}
+}
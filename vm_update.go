@@ -2,6 +2,7 @@ package ovirtclient
 
 import (
 	"fmt"
+	"reflect"
 
 	ovirtsdk "github.com/ovirt/go-ovirt"
 )
@@ -13,19 +14,12 @@ func (o *oVirtClient) UpdateVM(
 ) (result VM, err error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts(o))
 
-	vm := &ovirtsdk.Vm{}
-	vm.SetId(string(id))
-	if name := params.Name(); name != nil {
-		if *name == "" {
-			return nil, newError(EBadArgument, "name must not be empty for VM update")
-		}
-		vm.SetName(*name)
-	}
-	if comment := params.Comment(); comment != nil {
-		vm.SetComment(*comment)
+	if err := validateVMUpdateParameters(params); err != nil {
+		return nil, err
 	}
-	if description := params.Description(); description != nil {
-		vm.SetDescription(*description)
+	sdkVM, err := buildSDKVMForUpdate(id, params)
+	if err != nil {
+		return nil, err
 	}
 
 	err = retry(
@@ -33,15 +27,22 @@ func (o *oVirtClient) UpdateVM(
 		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().VmsService().VmService(string(id)).Update().Vm(vm).Send()
+			response, err := o.conn.
+				SystemService().
+				VmsService().
+				VmService(string(id)).
+				Update().
+				Vm(sdkVM).
+				NextRun(params.NextRun()).
+				Send()
 			if err != nil {
 				return wrap(err, EUnidentified, "failed to update VM")
 			}
-			vm, ok := response.Vm()
+			responseVM, ok := response.Vm()
 			if !ok {
 				return newError(EFieldMissing, "missing VM in VM update response")
 			}
-			result, err = convertSDKVM(vm, o)
+			convertedVM, err := convertSDKVM(responseVM, o)
 			if err != nil {
 				return wrap(
 					err,
@@ -49,35 +50,417 @@ func (o *oVirtClient) UpdateVM(
 					"failed to convert VM",
 				)
 			}
+			if v, ok := convertedVM.(*vm); ok && v.nextRunConfigurationExists {
+				// The engine returns the current configuration, so we compare it to the next run configuration,
+				// which also contains the changes of earlier next run updates.
+				v.pendingNextRunFields, err = o.getPendingNextRunFields(v)
+				if err != nil {
+					return err
+				}
+			}
+			result = convertedVM
 			return nil
 		})
 	return result, err
 }
 
-func (m *mockClient) UpdateVM(id VMID, params UpdateVMParameters, _ ...RetryStrategy) (VM, error) {
-	m.lock.Lock()
-	defer m.lock.Unlock()
+func validateVMUpdateParameters(params UpdateVMParameters) error {
+	if name := params.Name(); name != nil && *name == "" {
+		return newError(EBadArgument, "name must not be empty for VM update")
+	}
+	if memory := params.Memory(); memory != nil {
+		if err := validateVMMemory(*memory, params.MemoryPolicy()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	if _, ok := m.vms[id]; !ok {
-		return nil, newError(ENotFound, "VM with ID %s not found", id)
+func buildSDKVMForUpdate(id VMID, params UpdateVMParameters) (*ovirtsdk.Vm, error) {
+	builder := ovirtsdk.NewVmBuilder().Id(string(id))
+	if name := params.Name(); name != nil {
+		builder.Name(*name)
+	}
+	if comment := params.Comment(); comment != nil {
+		builder.Comment(*comment)
+	}
+	if description := params.Description(); description != nil {
+		builder.Description(*description)
 	}
+	if cpu := params.CPU(); cpu != nil {
+		builder.CpuBuilder(sdkCPUBuilder(cpu))
+	}
+	if memory := params.Memory(); memory != nil {
+		builder.Memory(*memory)
+	}
+	if memoryPolicy := params.MemoryPolicy(); memoryPolicy != nil {
+		builder.MemoryPolicyBuilder(sdkMemoryPolicyBuilder(*memoryPolicy))
+	}
+	if hugePages := params.HugePages(); hugePages != nil {
+		builder.CustomPropertiesOfAny(sdkHugePagesProperty(*hugePages))
+	}
+	if placementPolicy := params.PlacementPolicy(); placementPolicy != nil {
+		builder.PlacementPolicyBuilder(sdkPlacementPolicyBuilder(*placementPolicy))
+	}
+	if instanceTypeID := params.InstanceTypeID(); instanceTypeID != nil {
+		builder.InstanceTypeBuilder(ovirtsdk.NewInstanceTypeBuilder().Id(string(*instanceTypeID)))
+	}
+	if os, ok := params.OS(); ok {
+		builder.OsBuilder(sdkOSBuilder(os))
+	}
+	if serialConsole := params.SerialConsole(); serialConsole != nil {
+		builder.ConsoleBuilder(ovirtsdk.NewConsoleBuilder().Enabled(*serialConsole))
+	}
+	if soundcardEnabled := params.SoundcardEnabled(); soundcardEnabled != nil {
+		builder.SoundcardEnabled(*soundcardEnabled)
+	}
+	if init := params.Initialization(); init != nil {
+		builder.InitializationBuilder(sdkInitializationBuilder(init))
+	}
+	vm, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build VM")
+	}
+	return vm, nil
+}
 
-	vm := m.vms[id]
+// getPendingNextRunFields fetches the next run configuration of the VM and returns the fields that differ from the
+// current configuration.
+func (o *oVirtClient) getPendingNextRunFields(current *vm) ([]VMUpdateField, error) {
+	response, err := o.conn.
+		SystemService().
+		VmsService().
+		VmService(string(current.id)).
+		Get().
+		NextRun(true).
+		Send()
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to fetch next run configuration of VM %s", current.id)
+	}
+	sdkVM, ok := response.Vm()
+	if !ok {
+		return nil, newError(EFieldMissing, "missing VM in next run configuration response")
+	}
+	convertedVM, err := convertSDKVM(sdkVM, o)
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to convert next run configuration of VM %s", current.id)
+	}
+	next, ok := convertedVM.(*vm)
+	if !ok {
+		return nil, newError(EBug, "unexpected VM type in next run configuration of VM %s", current.id)
+	}
+	var result []VMUpdateField
+	for _, field := range VMUpdateFieldValues() {
+		if !vmUpdateFieldEqual(current, next, field) {
+			result = append(result, field)
+		}
+	}
+	return result, nil
+}
+
+// vmUpdateFieldEqual returns true if the field has the same value in both VM configurations.
+func vmUpdateFieldEqual(a *vm, b *vm, field VMUpdateField) bool {
+	switch field {
+	case VMUpdateFieldCPU:
+		return reflect.DeepEqual(a.cpu, b.cpu)
+	case VMUpdateFieldMemory:
+		return a.memory == b.memory
+	case VMUpdateFieldMemoryPolicy:
+		return reflect.DeepEqual(a.memoryPolicy, b.memoryPolicy)
+	case VMUpdateFieldHugePages:
+		return reflect.DeepEqual(a.hugePages, b.hugePages)
+	case VMUpdateFieldPlacementPolicy:
+		return reflect.DeepEqual(a.placementPolicy, b.placementPolicy)
+	case VMUpdateFieldInstanceType:
+		return reflect.DeepEqual(a.instanceTypeID, b.instanceTypeID)
+	case VMUpdateFieldOS:
+		return reflect.DeepEqual(a.os, b.os)
+	case VMUpdateFieldSerialConsole:
+		return a.serialConsole == b.serialConsole
+	case VMUpdateFieldSoundcardEnabled:
+		return a.soundcardEnabled == b.soundcardEnabled
+	case VMUpdateFieldInitialization:
+		return reflect.DeepEqual(a.initialization, b.initialization)
+	default:
+		return true
+	}
+}
+
+// updateVMFieldApplied returns true if the field is either not set in the parameters, or the current VM already has
+// the requested value.
+func updateVMFieldApplied(current VM, params UpdateVMParameters, field VMUpdateField) bool {
+	switch field {
+	case VMUpdateFieldCPU:
+		return params.CPU() == nil || vmCPUMatches(current.CPU(), params.CPU())
+	case VMUpdateFieldMemory:
+		return params.Memory() == nil || *params.Memory() == current.Memory()
+	case VMUpdateFieldMemoryPolicy:
+		return params.MemoryPolicy() == nil || vmMemoryPolicyMatches(current.MemoryPolicy(), *params.MemoryPolicy())
+	case VMUpdateFieldHugePages:
+		return params.HugePages() == nil ||
+			(current.HugePages() != nil && *current.HugePages() == *params.HugePages())
+	case VMUpdateFieldPlacementPolicy:
+		return params.PlacementPolicy() == nil || vmPlacementPolicyMatches(current, *params.PlacementPolicy())
+	case VMUpdateFieldInstanceType:
+		return params.InstanceTypeID() == nil ||
+			(current.InstanceTypeID() != nil && *current.InstanceTypeID() == *params.InstanceTypeID())
+	case VMUpdateFieldOS:
+		os, ok := params.OS()
+		return !ok || os.Type() == nil || *os.Type() == current.OS().Type()
+	case VMUpdateFieldSerialConsole:
+		return params.SerialConsole() == nil || *params.SerialConsole() == current.SerialConsole()
+	case VMUpdateFieldSoundcardEnabled:
+		return params.SoundcardEnabled() == nil || *params.SoundcardEnabled() == current.SoundcardEnabled()
+	case VMUpdateFieldInitialization:
+		init := params.Initialization()
+		return init == nil || (current.Initialization() != nil &&
+			init.CustomScript() == current.Initialization().CustomScript() &&
+			init.HostName() == current.Initialization().HostName())
+	default:
+		return true
+	}
+}
+
+func vmCPUMatches(current VMCPU, params VMCPUParams) bool {
+	if current == nil {
+		return false
+	}
+	if topo := params.Topo(); topo != nil {
+		currentTopo := current.Topo()
+		if currentTopo == nil ||
+			currentTopo.Cores() != topo.Cores() ||
+			currentTopo.Threads() != topo.Threads() ||
+			currentTopo.Sockets() != topo.Sockets() {
+			return false
+		}
+	}
+	if mode := params.Mode(); mode != nil {
+		if current.Mode() == nil || *current.Mode() != *mode {
+			return false
+		}
+	}
+	return true
+}
+
+func vmMemoryPolicyMatches(current MemoryPolicy, params MemoryPolicyParameters) bool {
+	if current == nil {
+		return false
+	}
+	if guaranteed := params.Guaranteed(); guaranteed != nil {
+		if current.Guaranteed() == nil || *current.Guaranteed() != *guaranteed {
+			return false
+		}
+	}
+	if max := params.Max(); max != nil {
+		if current.Max() == nil || *current.Max() != *max {
+			return false
+		}
+	}
+	if ballooning := params.Ballooning(); ballooning != nil && *ballooning != current.Ballooning() {
+		return false
+	}
+	return true
+}
+
+func vmPlacementPolicyMatches(current VM, params VMPlacementPolicyParameters) bool {
+	currentPolicy, ok := current.PlacementPolicy()
+	if !ok {
+		return false
+	}
+	if affinity := params.Affinity(); affinity != nil {
+		if currentPolicy.Affinity() == nil || *currentPolicy.Affinity() != *affinity {
+			return false
+		}
+	}
+	if len(currentPolicy.HostIDs()) != len(params.HostIDs()) {
+		return false
+	}
+	for i, hostID := range params.HostIDs() {
+		if currentPolicy.HostIDs()[i] != hostID {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *mockClient) UpdateVM(id VMID, params UpdateVMParameters, retries ...RetryStrategy) (result VM, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+	err = retry(
+		fmt.Sprintf("updating vm %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			if _, ok := m.vms[id]; !ok {
+				return newError(ENotFound, "VM with ID %s not found", id)
+			}
+
+			vm := m.vms[id]
+			if err := m.validateVMUpdate(vm, params); err != nil {
+				return err
+			}
+			if name := params.Name(); name != nil {
+				vm = vm.withName(*name)
+			}
+			if comment := params.Comment(); comment != nil {
+				vm = vm.withComment(*comment)
+			}
+			if description := params.Description(); description != nil {
+				vm = vm.withDescription(*description)
+			}
+
+			vm = vm.clone()
+			pending := m.applyVMUpdate(vm, params, vm.status != VMStatusDown)
+			if len(pending) > 0 {
+				m.vmNextRunParams[id] = append(m.vmNextRunParams[id], params)
+				vm.nextRunConfigurationExists = true
+			}
+			m.vms[id] = vm
+
+			if !vm.nextRunConfigurationExists {
+				result = vm
+				return nil
+			}
+			// Like the engine, we only report the pending fields on the VM returned from the update.
+			withPending := vm.clone()
+			withPending.pendingNextRunFields = m.getMockPendingNextRunFields(vm)
+			result = withPending
+			return nil
+		})
+	return
+}
+
+// getMockPendingNextRunFields returns the fields of all next run updates since the VM was last stopped that are not
+// yet reflected in the current configuration. Must be called with the lock held.
+func (m *mockClient) getMockPendingNextRunFields(vm *vm) []VMUpdateField {
+	var result []VMUpdateField
+	for _, field := range VMUpdateFieldValues() {
+		for _, params := range m.vmNextRunParams[vm.id] {
+			if !updateVMFieldApplied(vm, params, field) {
+				result = append(result, field)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// validateVMUpdate applies the same validation as VM creation to the update parameters, taking the current VM
+// configuration into account. Must be called with the lock held.
+func (m *mockClient) validateVMUpdate(vm *vm, params UpdateVMParameters) error {
+	if err := validateVMUpdateParameters(params); err != nil {
+		return err
+	}
 	if name := params.Name(); name != nil {
 		for _, otherVM := range m.vms {
 			if otherVM.name == *name && otherVM.ID() != vm.ID() {
-				return nil, newError(EConflict, "A VM with the name \"%s\" already exists.", *name)
+				return newError(EConflict, "A VM with the name \"%s\" already exists.", *name)
 			}
 		}
-		vm = vm.withName(*name)
 	}
-	if comment := params.Comment(); comment != nil {
-		vm = vm.withComment(*comment)
+	memory := vm.memory
+	if params.Memory() != nil {
+		memory = *params.Memory()
 	}
-	if description := params.Description(); description != nil {
-		vm = vm.withDescription(*description)
+	memPolicy := params.MemoryPolicy()
+	if memPolicy == nil && vm.memoryPolicy != nil && vm.memoryPolicy.guaranteed != nil {
+		currentPolicy, err := NewMemoryPolicyParameters().WithGuaranteed(*vm.memoryPolicy.guaranteed)
+		if err != nil {
+			return wrap(err, EBug, "failed to convert current memory policy")
+		}
+		var currentPolicyParams MemoryPolicyParameters = currentPolicy
+		memPolicy = &currentPolicyParams
 	}
-	m.vms[id] = vm
+	if err := validateVMMemory(memory, memPolicy); err != nil {
+		return err
+	}
+	if instanceTypeID := params.InstanceTypeID(); instanceTypeID != nil {
+		if _, ok := m.instanceTypes[*instanceTypeID]; !ok {
+			return newError(ENotFound, "instance type %s not found", *instanceTypeID)
+		}
+	}
+	if placementPolicy := params.PlacementPolicy(); placementPolicy != nil {
+		for _, hostID := range (*placementPolicy).HostIDs() {
+			if _, ok := m.hosts[hostID]; !ok {
+				return newError(ENotFound, "host %s not found", hostID)
+			}
+		}
+	}
+	return nil
+}
 
-	return vm, nil
+// applyVMUpdate applies the changes in the parameters to the VM and returns the fields that have been deferred to
+// the next run. If running is true, only the fields the engine can change on a running VM are applied, and only if
+// the parameters do not explicitly request a next run update. Must be called with the lock held.
+func (m *mockClient) applyVMUpdate(vm *vm, params UpdateVMParameters, running bool) []VMUpdateField {
+	var pending []VMUpdateField
+	for _, field := range VMUpdateFieldValues() {
+		if updateVMFieldApplied(vm, params, field) {
+			continue
+		}
+		if running && (params.NextRun() || !vmUpdateFieldHotPluggable(field)) {
+			pending = append(pending, field)
+			continue
+		}
+		m.applyVMUpdateField(vm, params, field)
+	}
+	return pending
+}
+
+// vmUpdateFieldHotPluggable returns true if the engine can apply the field to a running VM.
+func vmUpdateFieldHotPluggable(field VMUpdateField) bool {
+	switch field {
+	case VMUpdateFieldMemory, VMUpdateFieldMemoryPolicy, VMUpdateFieldPlacementPolicy, VMUpdateFieldInitialization:
+		return true
+	default:
+		return false
+	}
+}
+
+func (m *mockClient) applyVMUpdateField(vm *vm, params UpdateVMParameters, field VMUpdateField) {
+	switch field {
+	case VMUpdateFieldCPU:
+		vm.cpu = newVMCPUFromParams(params.CPU())
+	case VMUpdateFieldMemory:
+		vm.memory = *params.Memory()
+	case VMUpdateFieldMemoryPolicy:
+		currentPolicy := vm.memoryPolicy
+		if currentPolicy == nil {
+			currentPolicy = &memoryPolicy{ballooning: true}
+		}
+		vm.memoryPolicy = currentPolicy.withParams(*params.MemoryPolicy())
+	case VMUpdateFieldHugePages:
+		vm.hugePages = params.HugePages()
+	case VMUpdateFieldPlacementPolicy:
+		vm.placementPolicy = newVMPlacementPolicyFromParams(*params.PlacementPolicy())
+	case VMUpdateFieldInstanceType:
+		vm.instanceTypeID = params.InstanceTypeID()
+	case VMUpdateFieldOS:
+		os, _ := params.OS()
+		vm.os = &vmOS{t: *os.Type()}
+	case VMUpdateFieldSerialConsole:
+		vm.serialConsole = *params.SerialConsole()
+	case VMUpdateFieldSoundcardEnabled:
+		vm.soundcardEnabled = *params.SoundcardEnabled()
+	case VMUpdateFieldInitialization:
+		vm.initialization = params.Initialization()
+	}
+}
+
+// applyVMNextRun applies all pending next run changes to a VM that has just been stopped. Must be called with the
+// lock held.
+func (m *mockClient) applyVMNextRun(id VMID) {
+	vm, ok := m.vms[id]
+	if !ok || !vm.nextRunConfigurationExists {
+		return
+	}
+	vm = vm.clone()
+	for _, params := range m.vmNextRunParams[id] {
+		m.applyVMUpdate(vm, params, false)
+	}
+	delete(m.vmNextRunParams, id)
+	vm.nextRunConfigurationExists = false
+	m.vms[id] = vm
 }
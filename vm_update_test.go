@@ -0,0 +1,116 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestVMUpdateCPUAndMemory(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+
+	memory := int64(2 * 1024 * 1024 * 1024)
+	guaranteed := int64(1024 * 1024 * 1024)
+	updatedVM, err := vm.Update(
+		ovirtclient.UpdateVMParams().
+			MustWithCPU(ovirtclient.NewVMCPUParams().MustWithTopo(ovirtclient.MustNewVMCPUTopo(2, 1, 2))).
+			MustWithMemory(memory).
+			MustWithMemoryPolicy(ovirtclient.NewMemoryPolicyParameters().MustWithGuaranteed(guaranteed)),
+	)
+	if err != nil {
+		t.Fatalf("Failed to update VM CPU and memory (%v)", err)
+	}
+	if updatedVM.Memory() != memory {
+		t.Fatalf("Incorrect memory after update (expected: %d, got: %d)", memory, updatedVM.Memory())
+	}
+	if g := updatedVM.MemoryPolicy().Guaranteed(); g == nil || *g != guaranteed {
+		t.Fatalf("Incorrect guaranteed memory after update (expected: %d, got: %v)", guaranteed, g)
+	}
+	topo := updatedVM.CPU().Topo()
+	if topo.Cores() != 2 || topo.Threads() != 1 || topo.Sockets() != 2 {
+		t.Fatalf(
+			"Incorrect CPU topology after update (cores: %d, threads: %d, sockets: %d)",
+			topo.Cores(),
+			topo.Threads(),
+			topo.Sockets(),
+		)
+	}
+	if updatedVM.NextRunConfigurationExists() {
+		t.Fatalf("Next run configuration exists after updating a VM that is down.")
+	}
+}
+
+func TestVMUpdateRejectsGuaranteedMemoryLargerThanMemory(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateTestResourceName(t), nil)
+
+	_, err := vm.Update(
+		ovirtclient.UpdateVMParams().
+			MustWithMemory(1024 * 1024 * 1024).
+			MustWithMemoryPolicy(ovirtclient.NewMemoryPolicyParameters().MustWithGuaranteed(2 * 1024 * 1024 * 1024)),
+	)
+	if err == nil {
+		t.Fatalf("Updating a VM with a guaranteed memory larger than the memory did not result in an error.")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Updating a VM with invalid memory returned an incorrect error code (%v)", err)
+	}
+}
+
+func TestVMUpdateNextRun(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateBootableVM(t, helper)
+	assertCanStartVM(t, helper, vm)
+	vm = assertVMWillStart(t, vm)
+
+	memory := vm.Memory() * 2
+	updatedVM, err := vm.Update(ovirtclient.UpdateVMParams().MustWithMemory(memory).MustWithNextRun(true))
+	if err != nil {
+		t.Fatalf("Failed to update running VM for next run (%v)", err)
+	}
+	if !updatedVM.NextRunConfigurationExists() {
+		t.Fatalf("No next run configuration exists after a next run update.")
+	}
+	if updatedVM.Memory() == memory {
+		t.Fatalf("Memory was changed on a running VM despite the next run flag.")
+	}
+	pending := updatedVM.PendingNextRunFields()
+	if len(pending) != 1 || pending[0] != ovirtclient.VMUpdateFieldMemory {
+		t.Fatalf("Incorrect pending next run fields: %v", pending)
+	}
+
+	// A second next run update must keep the fields of the first one pending.
+	updatedVM, err = vm.Update(
+		ovirtclient.UpdateVMParams().MustWithSerialConsole(!vm.SerialConsole()).MustWithNextRun(true),
+	)
+	if err != nil {
+		t.Fatalf("Failed to update running VM for next run (%v)", err)
+	}
+	pending = updatedVM.PendingNextRunFields()
+	if len(pending) != 2 ||
+		pending[0] != ovirtclient.VMUpdateFieldMemory ||
+		pending[1] != ovirtclient.VMUpdateFieldSerialConsole {
+		t.Fatalf("Incorrect pending next run fields after a second update: %v", pending)
+	}
+
+	assertCanStopVM(t, updatedVM)
+	assertVMWillStop(t, updatedVM)
+
+	stoppedVM, err := helper.GetClient().GetVM(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to fetch VM after stop (%v)", err)
+	}
+	if stoppedVM.Memory() != memory {
+		t.Fatalf("Next run memory was not applied after stop (expected: %d, got: %d)", memory, stoppedVM.Memory())
+	}
+	if stoppedVM.NextRunConfigurationExists() {
+		t.Fatalf("Next run configuration still exists after the VM was stopped.")
+	}
+}
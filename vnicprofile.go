@@ -1,6 +1,9 @@
 package ovirtclient
 
 import (
+	"sort"
+	"strings"
+
 	ovirtsdk "github.com/ovirt/go-ovirt"
 )
 
@@ -17,16 +20,110 @@ type VNICProfileClient interface {
 	GetVNICProfile(id VNICProfileID, retries ...RetryStrategy) (VNICProfile, error)
 	// ListVNICProfiles lists all VNIC Profiles.
 	ListVNICProfiles(retries ...RetryStrategy) ([]VNICProfile, error)
+	// UpdateVNICProfile updates the fields of a VNIC profile set in params.
+	UpdateVNICProfile(id VNICProfileID, params UpdateVNICProfileParameters, retries ...RetryStrategy) (VNICProfile, error)
 	// RemoveVNICProfile removes a VNIC profile
 	RemoveVNICProfile(id VNICProfileID, retries ...RetryStrategy) error
 }
 
+// VNICProfilePassThroughMode describes if a VNIC profile passes an SR-IOV virtual function directly to the VM.
+type VNICProfilePassThroughMode string
+
+const (
+	// VNICProfilePassThroughModeEnabled connects the VM directly to an SR-IOV virtual function of the host NIC.
+	// Port mirroring, network filters and QoS are not available in this mode.
+	VNICProfilePassThroughModeEnabled VNICProfilePassThroughMode = "enabled"
+	// VNICProfilePassThroughModeDisabled connects the VM through the software bridge of the host.
+	VNICProfilePassThroughModeDisabled VNICProfilePassThroughMode = "disabled"
+)
+
+// VNICProfilePassThroughModeList is a list of VNICProfilePassThroughMode.
+type VNICProfilePassThroughModeList []VNICProfilePassThroughMode
+
+// VNICProfilePassThroughModeValues returns all possible VNICProfilePassThroughMode values.
+func VNICProfilePassThroughModeValues() VNICProfilePassThroughModeList {
+	return []VNICProfilePassThroughMode{
+		VNICProfilePassThroughModeEnabled,
+		VNICProfilePassThroughModeDisabled,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l VNICProfilePassThroughModeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, mode := range l {
+		result[i] = string(mode)
+	}
+	return result
+}
+
+// Validate returns an error if the pass-through mode doesn't have a valid value.
+func (m VNICProfilePassThroughMode) Validate() error {
+	for _, mode := range VNICProfilePassThroughModeValues() {
+		if mode == m {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid VNIC profile pass-through mode: %s must be one of: %s",
+		m,
+		strings.Join(VNICProfilePassThroughModeValues().Strings(), ", "),
+	)
+}
+
 // OptionalVNICProfileParameters is a set of parameters for creating VNICProfiles that are optional.
-type OptionalVNICProfileParameters interface{}
+type OptionalVNICProfileParameters interface {
+	// Description returns the description of the VNIC profile.
+	Description() *string
+	// PortMirroring returns if the traffic of the network should be mirrored to the VNICs using the profile.
+	PortMirroring() *bool
+	// PassThroughMode returns the SR-IOV pass-through mode of the profile.
+	PassThroughMode() *VNICProfilePassThroughMode
+	// NetworkFilterID returns the network filter applied to the VNICs. If nil, the engine applies the
+	// vdsm-no-mac-spoofing filter to profiles not in pass-through mode. An empty ID means no filter.
+	NetworkFilterID() *NetworkFilterID
+	// CustomProperties returns the device custom properties passed to the VNICs, such as a security group.
+	CustomProperties() map[string]string
+	// NetworkQoSID returns the network QoS entry limiting the VNICs. The QoS entry must be in the datacenter of the
+	// network. An empty ID means no QoS.
+	NetworkQoSID() *NetworkQoSID
+}
 
 // BuildableVNICProfileParameters is a buildable version of OptionalVNICProfileParameters.
 type BuildableVNICProfileParameters interface {
 	OptionalVNICProfileParameters
+
+	// WithDescription sets the description of the VNIC profile.
+	WithDescription(description string) (BuildableVNICProfileParameters, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableVNICProfileParameters
+
+	// WithPortMirroring sets if the traffic of the network should be mirrored to the VNICs using the profile.
+	WithPortMirroring(portMirroring bool) (BuildableVNICProfileParameters, error)
+	// MustWithPortMirroring is identical to WithPortMirroring, but panics instead of returning an error.
+	MustWithPortMirroring(portMirroring bool) BuildableVNICProfileParameters
+
+	// WithPassThroughMode sets the SR-IOV pass-through mode of the profile.
+	WithPassThroughMode(mode VNICProfilePassThroughMode) (BuildableVNICProfileParameters, error)
+	// MustWithPassThroughMode is identical to WithPassThroughMode, but panics instead of returning an error.
+	MustWithPassThroughMode(mode VNICProfilePassThroughMode) BuildableVNICProfileParameters
+
+	// WithNetworkFilterID sets the network filter applied to the VNICs. Pass an empty ID to apply no filter. Use
+	// GetNetworkFilterByName to look up the ID of a filter.
+	WithNetworkFilterID(id NetworkFilterID) (BuildableVNICProfileParameters, error)
+	// MustWithNetworkFilterID is identical to WithNetworkFilterID, but panics instead of returning an error.
+	MustWithNetworkFilterID(id NetworkFilterID) BuildableVNICProfileParameters
+
+	// WithCustomProperties sets the device custom properties passed to the VNICs.
+	WithCustomProperties(customProperties map[string]string) (BuildableVNICProfileParameters, error)
+	// MustWithCustomProperties is identical to WithCustomProperties, but panics instead of returning an error.
+	MustWithCustomProperties(customProperties map[string]string) BuildableVNICProfileParameters
+
+	// WithNetworkQoSID sets the network QoS entry limiting the VNICs. Pass an empty ID to remove the limits.
+	WithNetworkQoSID(id NetworkQoSID) (BuildableVNICProfileParameters, error)
+	// MustWithNetworkQoSID is identical to WithNetworkQoSID, but panics instead of returning an error.
+	MustWithNetworkQoSID(id NetworkQoSID) BuildableVNICProfileParameters
 }
 
 // CreateVNICProfileParams creats a buildable set of optional parameters for VNICProfile creation.
@@ -34,7 +131,407 @@ func CreateVNICProfileParams() BuildableVNICProfileParameters {
 	return &vnicProfileParams{}
 }
 
-type vnicProfileParams struct{}
+// UpdateVNICProfileParameters contains the fields of a VNIC profile to update. Each nil value leaves the field
+// unchanged.
+type UpdateVNICProfileParameters interface {
+	// Name returns the new name of the VNIC profile.
+	Name() *string
+	// Description returns the new description of the VNIC profile.
+	Description() *string
+	// PortMirroring returns if the traffic of the network should be mirrored to the VNICs using the profile.
+	PortMirroring() *bool
+	// PassThroughMode returns the new SR-IOV pass-through mode of the profile.
+	PassThroughMode() *VNICProfilePassThroughMode
+	// NetworkFilterID returns the new network filter. An empty ID removes the filter.
+	NetworkFilterID() *NetworkFilterID
+	// CustomProperties returns the new device custom properties. They replace all existing custom properties.
+	CustomProperties() map[string]string
+	// NetworkQoSID returns the new network QoS entry. An empty ID removes the QoS entry from the profile.
+	NetworkQoSID() *NetworkQoSID
+}
+
+// BuildableUpdateVNICProfileParameters is a buildable version of UpdateVNICProfileParameters.
+type BuildableUpdateVNICProfileParameters interface {
+	UpdateVNICProfileParameters
+
+	// WithName sets the new name of the VNIC profile.
+	WithName(name string) (BuildableUpdateVNICProfileParameters, error)
+	// MustWithName is identical to WithName, but panics instead of returning an error.
+	MustWithName(name string) BuildableUpdateVNICProfileParameters
+
+	// WithDescription sets the new description of the VNIC profile.
+	WithDescription(description string) (BuildableUpdateVNICProfileParameters, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableUpdateVNICProfileParameters
+
+	// WithPortMirroring sets if the traffic of the network should be mirrored to the VNICs using the profile.
+	WithPortMirroring(portMirroring bool) (BuildableUpdateVNICProfileParameters, error)
+	// MustWithPortMirroring is identical to WithPortMirroring, but panics instead of returning an error.
+	MustWithPortMirroring(portMirroring bool) BuildableUpdateVNICProfileParameters
+
+	// WithPassThroughMode sets the new SR-IOV pass-through mode of the profile.
+	WithPassThroughMode(mode VNICProfilePassThroughMode) (BuildableUpdateVNICProfileParameters, error)
+	// MustWithPassThroughMode is identical to WithPassThroughMode, but panics instead of returning an error.
+	MustWithPassThroughMode(mode VNICProfilePassThroughMode) BuildableUpdateVNICProfileParameters
+
+	// WithNetworkFilterID sets the new network filter. Pass an empty ID to remove the filter.
+	WithNetworkFilterID(id NetworkFilterID) (BuildableUpdateVNICProfileParameters, error)
+	// MustWithNetworkFilterID is identical to WithNetworkFilterID, but panics instead of returning an error.
+	MustWithNetworkFilterID(id NetworkFilterID) BuildableUpdateVNICProfileParameters
+
+	// WithCustomProperties replaces the device custom properties. Pass an empty map to remove all of them.
+	WithCustomProperties(customProperties map[string]string) (BuildableUpdateVNICProfileParameters, error)
+	// MustWithCustomProperties is identical to WithCustomProperties, but panics instead of returning an error.
+	MustWithCustomProperties(customProperties map[string]string) BuildableUpdateVNICProfileParameters
+
+	// WithNetworkQoSID sets the new network QoS entry. Pass an empty ID to remove the QoS entry from the profile.
+	WithNetworkQoSID(id NetworkQoSID) (BuildableUpdateVNICProfileParameters, error)
+	// MustWithNetworkQoSID is identical to WithNetworkQoSID, but panics instead of returning an error.
+	MustWithNetworkQoSID(id NetworkQoSID) BuildableUpdateVNICProfileParameters
+}
+
+// UpdateVNICProfileParams creates a buildable set of parameters for UpdateVNICProfile.
+func UpdateVNICProfileParams() BuildableUpdateVNICProfileParameters {
+	return &updateVNICProfileParams{}
+}
+
+// vnicProfileParams holds the fields shared by the create and update parameters.
+type vnicProfileParams struct {
+	description      *string
+	portMirroring    *bool
+	passThroughMode  *VNICProfilePassThroughMode
+	networkFilterID  *NetworkFilterID
+	customProperties map[string]string
+	networkQoSID     *NetworkQoSID
+}
+
+func (v *vnicProfileParams) Description() *string {
+	return v.description
+}
+
+func (v *vnicProfileParams) PortMirroring() *bool {
+	return v.portMirroring
+}
+
+func (v *vnicProfileParams) PassThroughMode() *VNICProfilePassThroughMode {
+	return v.passThroughMode
+}
+
+func (v *vnicProfileParams) NetworkFilterID() *NetworkFilterID {
+	return v.networkFilterID
+}
+
+func (v *vnicProfileParams) CustomProperties() map[string]string {
+	return v.customProperties
+}
+
+func (v *vnicProfileParams) NetworkQoSID() *NetworkQoSID {
+	return v.networkQoSID
+}
+
+func (v *vnicProfileParams) WithDescription(description string) (BuildableVNICProfileParameters, error) {
+	v.description = &description
+	return v, nil
+}
+
+func (v *vnicProfileParams) MustWithDescription(description string) BuildableVNICProfileParameters {
+	builder, err := v.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vnicProfileParams) WithPortMirroring(portMirroring bool) (BuildableVNICProfileParameters, error) {
+	v.portMirroring = &portMirroring
+	return v, nil
+}
+
+func (v *vnicProfileParams) MustWithPortMirroring(portMirroring bool) BuildableVNICProfileParameters {
+	builder, err := v.WithPortMirroring(portMirroring)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vnicProfileParams) WithPassThroughMode(
+	mode VNICProfilePassThroughMode,
+) (BuildableVNICProfileParameters, error) {
+	if err := mode.Validate(); err != nil {
+		return nil, err
+	}
+	v.passThroughMode = &mode
+	return v, nil
+}
+
+func (v *vnicProfileParams) MustWithPassThroughMode(mode VNICProfilePassThroughMode) BuildableVNICProfileParameters {
+	builder, err := v.WithPassThroughMode(mode)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vnicProfileParams) WithNetworkFilterID(id NetworkFilterID) (BuildableVNICProfileParameters, error) {
+	v.networkFilterID = &id
+	return v, nil
+}
+
+func (v *vnicProfileParams) MustWithNetworkFilterID(id NetworkFilterID) BuildableVNICProfileParameters {
+	builder, err := v.WithNetworkFilterID(id)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vnicProfileParams) WithCustomProperties(
+	customProperties map[string]string,
+) (BuildableVNICProfileParameters, error) {
+	result := make(map[string]string, len(customProperties))
+	for name, value := range customProperties {
+		if name == "" {
+			return nil, newError(EBadArgument, "custom property names must not be empty")
+		}
+		result[name] = value
+	}
+	v.customProperties = result
+	return v, nil
+}
+
+func (v *vnicProfileParams) MustWithCustomProperties(
+	customProperties map[string]string,
+) BuildableVNICProfileParameters {
+	builder, err := v.WithCustomProperties(customProperties)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vnicProfileParams) WithNetworkQoSID(id NetworkQoSID) (BuildableVNICProfileParameters, error) {
+	v.networkQoSID = &id
+	return v, nil
+}
+
+func (v *vnicProfileParams) MustWithNetworkQoSID(id NetworkQoSID) BuildableVNICProfileParameters {
+	builder, err := v.WithNetworkQoSID(id)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+type updateVNICProfileParams struct {
+	vnicProfileParams
+
+	name *string
+}
+
+func (u *updateVNICProfileParams) Name() *string {
+	return u.name
+}
+
+func (u *updateVNICProfileParams) WithName(name string) (BuildableUpdateVNICProfileParameters, error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "the VNIC profile name must not be empty")
+	}
+	u.name = &name
+	return u, nil
+}
+
+func (u *updateVNICProfileParams) MustWithName(name string) BuildableUpdateVNICProfileParameters {
+	builder, err := u.WithName(name)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVNICProfileParams) WithDescription(description string) (BuildableUpdateVNICProfileParameters, error) {
+	if _, err := u.vnicProfileParams.WithDescription(description); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateVNICProfileParams) MustWithDescription(description string) BuildableUpdateVNICProfileParameters {
+	builder, err := u.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVNICProfileParams) WithPortMirroring(portMirroring bool) (BuildableUpdateVNICProfileParameters, error) {
+	if _, err := u.vnicProfileParams.WithPortMirroring(portMirroring); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateVNICProfileParams) MustWithPortMirroring(portMirroring bool) BuildableUpdateVNICProfileParameters {
+	builder, err := u.WithPortMirroring(portMirroring)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVNICProfileParams) WithPassThroughMode(
+	mode VNICProfilePassThroughMode,
+) (BuildableUpdateVNICProfileParameters, error) {
+	if _, err := u.vnicProfileParams.WithPassThroughMode(mode); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateVNICProfileParams) MustWithPassThroughMode(
+	mode VNICProfilePassThroughMode,
+) BuildableUpdateVNICProfileParameters {
+	builder, err := u.WithPassThroughMode(mode)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVNICProfileParams) WithNetworkFilterID(id NetworkFilterID) (BuildableUpdateVNICProfileParameters, error) {
+	if _, err := u.vnicProfileParams.WithNetworkFilterID(id); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateVNICProfileParams) MustWithNetworkFilterID(id NetworkFilterID) BuildableUpdateVNICProfileParameters {
+	builder, err := u.WithNetworkFilterID(id)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVNICProfileParams) WithCustomProperties(
+	customProperties map[string]string,
+) (BuildableUpdateVNICProfileParameters, error) {
+	if _, err := u.vnicProfileParams.WithCustomProperties(customProperties); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateVNICProfileParams) MustWithCustomProperties(
+	customProperties map[string]string,
+) BuildableUpdateVNICProfileParameters {
+	builder, err := u.WithCustomProperties(customProperties)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVNICProfileParams) WithNetworkQoSID(id NetworkQoSID) (BuildableUpdateVNICProfileParameters, error) {
+	if _, err := u.vnicProfileParams.WithNetworkQoSID(id); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *updateVNICProfileParams) MustWithNetworkQoSID(id NetworkQoSID) BuildableUpdateVNICProfileParameters {
+	builder, err := u.WithNetworkQoSID(id)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// validateVNICProfilePassThrough returns an error if a profile in pass-through mode has features set that are only
+// available on the software bridge.
+func validateVNICProfilePassThrough(
+	passThroughMode VNICProfilePassThroughMode,
+	portMirroring bool,
+	networkFilterID *NetworkFilterID,
+	networkQoSID *NetworkQoSID,
+) error {
+	if passThroughMode != VNICProfilePassThroughModeEnabled {
+		return nil
+	}
+	if portMirroring {
+		return newError(EBadArgument, "port mirroring is not supported on VNIC profiles in pass-through mode")
+	}
+	if networkFilterID != nil && *networkFilterID != "" {
+		return newError(EBadArgument, "network filters are not supported on VNIC profiles in pass-through mode")
+	}
+	if networkQoSID != nil && *networkQoSID != "" {
+		return newError(EBadArgument, "network QoS is not supported on VNIC profiles in pass-through mode")
+	}
+	return nil
+}
+
+// validateVNICProfileParams checks the combination of the parameters shared by the create and update calls.
+func validateVNICProfileParams(params OptionalVNICProfileParameters) error {
+	if params.PassThroughMode() == nil {
+		return nil
+	}
+	portMirroring := false
+	if params.PortMirroring() != nil {
+		portMirroring = *params.PortMirroring()
+	}
+	return validateVNICProfilePassThrough(
+		*params.PassThroughMode(),
+		portMirroring,
+		params.NetworkFilterID(),
+		params.NetworkQoSID(),
+	)
+}
+
+// buildSDKVNICProfile fills the VNIC profile builder from the parameters shared by the create and update calls.
+func buildSDKVNICProfile(builder *ovirtsdk.VnicProfileBuilder, params OptionalVNICProfileParameters) {
+	if description := params.Description(); description != nil {
+		builder.Description(*description)
+	}
+	if portMirroring := params.PortMirroring(); portMirroring != nil {
+		builder.PortMirroring(*portMirroring)
+	}
+	if mode := params.PassThroughMode(); mode != nil {
+		builder.PassThrough(
+			ovirtsdk.NewVnicPassThroughBuilder().Mode(ovirtsdk.VnicPassThroughMode(*mode)).MustBuild(),
+		)
+	}
+	if filterID := params.NetworkFilterID(); filterID != nil {
+		// An empty network filter element removes the filter.
+		filterBuilder := ovirtsdk.NewNetworkFilterBuilder()
+		if *filterID != "" {
+			filterBuilder.Id(string(*filterID))
+		}
+		builder.NetworkFilter(filterBuilder.MustBuild())
+	}
+	if customProperties := params.CustomProperties(); customProperties != nil {
+		names := make([]string, 0, len(customProperties))
+		for name := range customProperties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		sdkProperties := make([]*ovirtsdk.CustomProperty, len(names))
+		for i, name := range names {
+			sdkProperties[i] = ovirtsdk.NewCustomPropertyBuilder().
+				Name(name).
+				Value(customProperties[name]).
+				MustBuild()
+		}
+		builder.CustomPropertiesOfAny(sdkProperties...)
+	}
+	if qosID := params.NetworkQoSID(); qosID != nil {
+		// An empty QoS element removes the QoS entry from the profile.
+		qosBuilder := ovirtsdk.NewQosBuilder()
+		if *qosID != "" {
+			qosBuilder.Id(string(*qosID))
+		}
+		builder.Qos(qosBuilder.MustBuild())
+	}
+}
 
 // VNICProfileData is the core of VNICProfile, providing only data access functions.
 type VNICProfileData interface {
@@ -44,6 +541,18 @@ type VNICProfileData interface {
 	Name() string
 	// NetworkID returns the network ID the VNICProfile is attached to.
 	NetworkID() NetworkID
+	// Description returns the description of the VNIC profile.
+	Description() string
+	// PortMirroring returns true if the traffic of the network is mirrored to the VNICs using the profile.
+	PortMirroring() bool
+	// PassThroughMode returns the SR-IOV pass-through mode of the profile.
+	PassThroughMode() VNICProfilePassThroughMode
+	// NetworkFilterID returns the network filter applied to the VNICs, or nil if there is none.
+	NetworkFilterID() *NetworkFilterID
+	// CustomProperties returns the device custom properties passed to the VNICs.
+	CustomProperties() map[string]string
+	// NetworkQoSID returns the network QoS entry limiting the VNICs, or nil if there is none.
+	NetworkQoSID() *NetworkQoSID
 }
 
 // VNICProfile is a collection of settings that can be applied to individual virtual network interface cards in the
@@ -53,6 +562,8 @@ type VNICProfile interface {
 
 	// Network fetches the network object from the oVirt engine. This is an API call and may be slow.
 	Network(retries ...RetryStrategy) (Network, error)
+	// Update updates the VNIC profile. See UpdateVNICProfile for details.
+	Update(params UpdateVNICProfileParameters, retries ...RetryStrategy) (VNICProfile, error)
 	// Remove removes the current VNIC profile.
 	Remove(retries ...RetryStrategy) error
 }
@@ -75,21 +586,62 @@ func convertSDKVNICProfile(sdkObject *ovirtsdk.VnicProfile, client Client) (VNIC
 		return nil, newFieldNotFound("Network on VNICProfile", "ID")
 	}
 
-	return &vnicProfile{
+	result := &vnicProfile{
 		client: client,
 
-		id:        VNICProfileID(id),
-		name:      name,
-		networkID: NetworkID(networkID),
-	}, nil
+		id:              VNICProfileID(id),
+		name:            name,
+		networkID:       NetworkID(networkID),
+		passThroughMode: VNICProfilePassThroughModeDisabled,
+	}
+	result.description, _ = sdkObject.Description()
+	result.portMirroring, _ = sdkObject.PortMirroring()
+	if passThrough, ok := sdkObject.PassThrough(); ok {
+		if mode, ok := passThrough.Mode(); ok {
+			result.passThroughMode = VNICProfilePassThroughMode(mode)
+		}
+	}
+	if filter, ok := sdkObject.NetworkFilter(); ok {
+		if filterID, ok := filter.Id(); ok {
+			result.networkFilterID = (*NetworkFilterID)(&filterID)
+		}
+	}
+	if customProperties, ok := sdkObject.CustomProperties(); ok {
+		for _, property := range customProperties.Slice() {
+			name, ok := property.Name()
+			if !ok {
+				continue
+			}
+			if result.customProperties == nil {
+				result.customProperties = map[string]string{}
+			}
+			result.customProperties[name], _ = property.Value()
+		}
+	}
+	if qos, ok := sdkObject.Qos(); ok {
+		if qosID, ok := qos.Id(); ok {
+			result.networkQoSID = (*NetworkQoSID)(&qosID)
+		}
+	}
+	return result, nil
 }
 
 type vnicProfile struct {
 	client Client
 
-	id        VNICProfileID
-	networkID NetworkID
-	name      string
+	id               VNICProfileID
+	networkID        NetworkID
+	name             string
+	description      string
+	portMirroring    bool
+	passThroughMode  VNICProfilePassThroughMode
+	networkFilterID  *NetworkFilterID
+	customProperties map[string]string
+	networkQoSID     *NetworkQoSID
+}
+
+func (v vnicProfile) Update(params UpdateVNICProfileParameters, retries ...RetryStrategy) (VNICProfile, error) {
+	return v.client.UpdateVNICProfile(v.id, params, retries...)
 }
 
 func (v vnicProfile) Remove(retries ...RetryStrategy) error {
@@ -111,3 +663,78 @@ func (v vnicProfile) NetworkID() NetworkID {
 func (v vnicProfile) ID() VNICProfileID {
 	return v.id
 }
+
+func (v vnicProfile) Description() string {
+	return v.description
+}
+
+func (v vnicProfile) PortMirroring() bool {
+	return v.portMirroring
+}
+
+func (v vnicProfile) PassThroughMode() VNICProfilePassThroughMode {
+	return v.passThroughMode
+}
+
+func (v vnicProfile) NetworkFilterID() *NetworkFilterID {
+	return v.networkFilterID
+}
+
+func (v vnicProfile) CustomProperties() map[string]string {
+	result := make(map[string]string, len(v.customProperties))
+	for name, value := range v.customProperties {
+		result[name] = value
+	}
+	return result
+}
+
+func (v vnicProfile) NetworkQoSID() *NetworkQoSID {
+	return v.networkQoSID
+}
+
+func (v vnicProfile) clone() *vnicProfile {
+	result := v
+	if v.networkFilterID != nil {
+		filterID := *v.networkFilterID
+		result.networkFilterID = &filterID
+	}
+	if v.networkQoSID != nil {
+		qosID := *v.networkQoSID
+		result.networkQoSID = &qosID
+	}
+	result.customProperties = v.CustomProperties()
+	return &result
+}
+
+// applyVNICProfileParams applies the parameters shared by the create and update calls to a mock VNIC profile.
+func applyVNICProfileParams(v *vnicProfile, params OptionalVNICProfileParameters) {
+	if description := params.Description(); description != nil {
+		v.description = *description
+	}
+	if portMirroring := params.PortMirroring(); portMirroring != nil {
+		v.portMirroring = *portMirroring
+	}
+	if mode := params.PassThroughMode(); mode != nil {
+		v.passThroughMode = *mode
+	}
+	if filterID := params.NetworkFilterID(); filterID != nil {
+		v.networkFilterID = nil
+		if *filterID != "" {
+			id := *filterID
+			v.networkFilterID = &id
+		}
+	}
+	if customProperties := params.CustomProperties(); customProperties != nil {
+		v.customProperties = map[string]string{}
+		for name, value := range customProperties {
+			v.customProperties[name] = value
+		}
+	}
+	if qosID := params.NetworkQoSID(); qosID != nil {
+		v.networkQoSID = nil
+		if *qosID != "" {
+			id := *qosID
+			v.networkQoSID = &id
+		}
+	}
+}
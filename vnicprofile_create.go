@@ -27,6 +27,9 @@ func (o *oVirtClient) CreateVNICProfile(
 			profileBuilder := ovirtsdk.NewVnicProfileBuilder()
 			profileBuilder.Name(name)
 			profileBuilder.Network(ovirtsdk.NewNetworkBuilder().Id(string(networkID)).MustBuild())
+			if params != nil {
+				buildSDKVNICProfile(profileBuilder, params)
+			}
 			req := o.conn.SystemService().VnicProfilesService().Add()
 			response, err := req.Profile(profileBuilder.MustBuild()).Send()
 			if err != nil {
@@ -55,7 +58,8 @@ func (m *mockClient) CreateVNICProfile(
 		return nil, err
 	}
 
-	if _, ok := m.networks[networkID]; !ok {
+	network, ok := m.networks[networkID]
+	if !ok {
 		return nil, newError(ENotFound, "network not found")
 	}
 
@@ -66,23 +70,66 @@ func (m *mockClient) CreateVNICProfile(
 	}
 
 	id := VNICProfileID(m.GenerateUUID())
-	m.vnicProfiles[id] = &vnicProfile{
+	result := &vnicProfile{
 		client: m,
 
-		id:        id,
-		networkID: networkID,
-		name:      name,
+		id:              id,
+		networkID:       networkID,
+		name:            name,
+		passThroughMode: VNICProfilePassThroughModeDisabled,
+	}
+	if params != nil {
+		applyVNICProfileParams(result, params)
+	}
+	if (params == nil || params.NetworkFilterID() == nil) && result.passThroughMode != VNICProfilePassThroughModeEnabled {
+		if filter := m.findNetworkFilterByName(defaultNetworkFilterName); filter != nil {
+			filterID := filter.id
+			result.networkFilterID = &filterID
+		}
 	}
+	if err := m.checkVNICProfileReferences(result, network.dcID); err != nil {
+		return nil, err
+	}
+	m.vnicProfiles[id] = result
 
-	return m.vnicProfiles[id], nil
+	return result, nil
 }
 
-func validateVNICProfileCreationParameters(name string, networkID NetworkID, _ OptionalVNICProfileParameters) error {
+// checkVNICProfileReferences checks that the network filter and QoS entry referenced by the profile exist. The caller
+// must hold the lock of the mock client.
+func (m *mockClient) checkVNICProfileReferences(profile *vnicProfile, datacenterID DatacenterID) error {
+	if profile.networkFilterID != nil {
+		if _, ok := m.networkFilters[*profile.networkFilterID]; !ok {
+			return newError(ENotFound, "network filter with ID %s not found", *profile.networkFilterID)
+		}
+	}
+	if profile.networkQoSID != nil {
+		qos, ok := m.networkQoS[*profile.networkQoSID]
+		if !ok {
+			return newError(ENotFound, "network QoS with ID %s not found", *profile.networkQoSID)
+		}
+		if qos.dcID != datacenterID {
+			return newError(
+				EBadArgument,
+				"network QoS %s belongs to datacenter %s, but the network is in datacenter %s",
+				qos.id,
+				qos.dcID,
+				datacenterID,
+			)
+		}
+	}
+	return nil
+}
+
+func validateVNICProfileCreationParameters(name string, networkID NetworkID, params OptionalVNICProfileParameters) error {
 	if name == "" {
 		return newError(EBadArgument, "name cannot be empty for VNIC profile creation")
 	}
 	if networkID == "" {
 		return newError(EBadArgument, "network ID cannot be empty for VNIC profile creation")
 	}
+	if params != nil {
+		return validateVNICProfileParams(params)
+	}
 	return nil
 }
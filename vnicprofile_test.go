@@ -33,6 +33,91 @@ func TestVNICProfile(t *testing.T) {
 	}
 }
 
+func TestVNICProfileWithParameters(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	datacenterID := assertCanGetTestDatacenterID(t, helper)
+	testProfile, err := client.GetVNICProfile(helper.GetVNICProfileID())
+	if err != nil {
+		t.Fatalf("failed to fetch test VNIC profile (%v)", err)
+	}
+	filter, err := client.GetNetworkFilterByName("clean-traffic")
+	if err != nil {
+		t.Fatalf("failed to fetch clean-traffic network filter (%v)", err)
+	}
+	qos := assertCanCreateNetworkQoS(
+		t,
+		helper,
+		datacenterID,
+		ovirtclient.CreateNetworkQoSParams().MustWithInbound(100, 100, 10),
+	)
+
+	vnicProfile, err := client.CreateVNICProfile(
+		helper.GenerateTestResourceName(t),
+		testProfile.NetworkID(),
+		ovirtclient.CreateVNICProfileParams().
+			MustWithPortMirroring(true).
+			MustWithNetworkFilterID(filter.ID()).
+			MustWithNetworkQoSID(qos.ID()),
+	)
+	if err != nil {
+		t.Fatalf("failed to create VNIC profile (%v)", err)
+	}
+	t.Cleanup(func() {
+		if err := vnicProfile.Remove(); err != nil {
+			t.Fatalf("failed to remove VNIC profile %s (%v)", vnicProfile.ID(), err)
+		}
+	})
+	if !vnicProfile.PortMirroring() {
+		t.Fatalf("port mirroring is not enabled on the created VNIC profile")
+	}
+	if vnicProfile.NetworkFilterID() == nil || *vnicProfile.NetworkFilterID() != filter.ID() {
+		t.Fatalf("incorrect network filter on the created VNIC profile")
+	}
+	if vnicProfile.NetworkQoSID() == nil || *vnicProfile.NetworkQoSID() != qos.ID() {
+		t.Fatalf("incorrect network QoS on the created VNIC profile")
+	}
+
+	updatedProfile, err := vnicProfile.Update(
+		ovirtclient.UpdateVNICProfileParams().
+			MustWithPortMirroring(false).
+			MustWithNetworkFilterID("").
+			MustWithNetworkQoSID("").
+			MustWithPassThroughMode(ovirtclient.VNICProfilePassThroughModeEnabled),
+	)
+	if err != nil {
+		t.Fatalf("failed to update VNIC profile %s (%v)", vnicProfile.ID(), err)
+	}
+	if updatedProfile.PassThroughMode() != ovirtclient.VNICProfilePassThroughModeEnabled {
+		t.Fatalf("pass-through mode was not enabled on the updated VNIC profile")
+	}
+	if updatedProfile.PortMirroring() || updatedProfile.NetworkFilterID() != nil || updatedProfile.NetworkQoSID() != nil {
+		t.Fatalf("the VNIC profile was not updated correctly")
+	}
+}
+
+func TestVNICProfilePassThroughRejectsPortMirroring(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	testProfile, err := client.GetVNICProfile(helper.GetVNICProfileID())
+	if err != nil {
+		t.Fatalf("failed to fetch test VNIC profile (%v)", err)
+	}
+
+	_, err = client.CreateVNICProfile(
+		helper.GenerateTestResourceName(t),
+		testProfile.NetworkID(),
+		ovirtclient.CreateVNICProfileParams().
+			MustWithPassThroughMode(ovirtclient.VNICProfilePassThroughModeEnabled).
+			MustWithPortMirroring(true),
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("creating a pass-through VNIC profile with port mirroring did not fail with EBadArgument (%v)", err)
+	}
+}
+
 func assertCanCreateVNICProfile(t *testing.T, helper ovirtclient.TestHelper) ovirtclient.VNICProfile {
 	client := helper.GetClient()
 	vnicProfile, err := client.GetVNICProfile(helper.GetVNICProfileID())
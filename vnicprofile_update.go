@@ -0,0 +1,107 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) UpdateVNICProfile(
+	id VNICProfileID,
+	params UpdateVNICProfileParameters,
+	retries ...RetryStrategy,
+) (result VNICProfile, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(o))
+	if params == nil {
+		return nil, newError(EBadArgument, "the update parameters must not be nil")
+	}
+	if err := validateVNICProfileParams(params); err != nil {
+		return nil, err
+	}
+	builder := ovirtsdk.NewVnicProfileBuilder().Id(string(id))
+	if name := params.Name(); name != nil {
+		builder.Name(*name)
+	}
+	buildSDKVNICProfile(builder, params)
+	sdkProfile, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build VNIC profile")
+	}
+
+	err = retry(
+		fmt.Sprintf("updating VNIC profile %s", id),
+		o.logger,
+		retries,
+		func() error {
+			response, err := o.conn.SystemService().VnicProfilesService().ProfileService(string(id)).Update().
+				Profile(sdkProfile).
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Profile()
+			if !ok {
+				return newFieldNotFound("VNIC profile update response", "profile")
+			}
+			result, err = convertSDKVNICProfile(sdkObject, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert VNIC profile %s", id)
+			}
+			return nil
+		})
+	return result, err
+}
+
+func (m *mockClient) UpdateVNICProfile(
+	id VNICProfileID,
+	params UpdateVNICProfileParameters,
+	retries ...RetryStrategy,
+) (result VNICProfile, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts(m))
+
+	if params == nil {
+		return nil, newError(EBadArgument, "the update parameters must not be nil")
+	}
+	if err := validateVNICProfileParams(params); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("updating VNIC profile %s", id),
+		m.logger,
+		retries,
+		func() error {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			item, ok := m.vnicProfiles[id]
+			if !ok {
+				return newError(ENotFound, "VNIC profile with ID %s not found", id)
+			}
+			// Profiles returned earlier must not change, so we update a copy.
+			updated := item.clone()
+			if name := params.Name(); name != nil {
+				for _, profile := range m.vnicProfiles {
+					if profile.name == *name && profile.id != id {
+						return newError(EConflict, "VNIC profile name is already in use")
+					}
+				}
+				updated.name = *name
+			}
+			applyVNICProfileParams(updated, params)
+			if err := validateVNICProfilePassThrough(
+				updated.passThroughMode,
+				updated.portMirroring,
+				updated.networkFilterID,
+				updated.networkQoSID,
+			); err != nil {
+				return err
+			}
+			if err := m.checkVNICProfileReferences(updated, m.networks[updated.networkID].dcID); err != nil {
+				return err
+			}
+			m.vnicProfiles[id] = updated
+			result = updated
+			return nil
+		})
+	return
+}